@@ -0,0 +1,147 @@
+// Package assert provides test assertions for [gofp.Option], [gofp.Result] and
+// [gofp.Either] values.
+//
+// Checking a container in a test usually takes several lines: one to check
+// that it holds the expected case, one to unwrap it and one to compare the
+// value. The assertions in this package do all three in a single call and
+// report the container itself on failure.
+//
+// Assertions that return a value, such as [Ok] and [Some], stop the test if the
+// container does not hold the expected case, since there is no value to
+// return. Assertions ending in Equal, and [ErrIs], report an error and return
+// whether they passed, so that a test may continue to check other values.
+// Values are compared using [reflect.DeepEqual].
+package assert
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// Ok returns the value of the [gofp.Result], stopping the test if it is an
+// Err.
+func Ok[T any](t testing.TB, r gofp.Result[T]) T {
+	t.Helper()
+	v, ok := r.TryUnwrap()
+	if !ok {
+		t.Fatalf("expected Ok, got %v", r)
+	}
+	return v
+}
+
+// Err returns the error of the [gofp.Result], stopping the test if it is an
+// Ok.
+func Err[T any](t testing.TB, r gofp.Result[T]) error {
+	t.Helper()
+	if r.IsOk() {
+		t.Fatalf("expected Err, got %v", r)
+	}
+	return r.UnwrapErr()
+}
+
+// OkEqual reports an error if the [gofp.Result] is not an Ok holding want. It
+// returns true if the assertion passed.
+func OkEqual[T any](t testing.TB, r gofp.Result[T], want T) bool {
+	t.Helper()
+	v, ok := r.TryUnwrap()
+	if !ok || !reflect.DeepEqual(v, want) {
+		t.Errorf("expected %v, got %v", gofp.Ok(want), r)
+		return false
+	}
+	return true
+}
+
+// ErrIs reports an error if the [gofp.Result] is not an Err whose error
+// matches target according to [errors.Is]. It returns true if the assertion
+// passed.
+func ErrIs[T any](t testing.TB, r gofp.Result[T], target error) bool {
+	t.Helper()
+	if r.IsOk() || !errors.Is(r.UnwrapErr(), target) {
+		t.Errorf("expected Err(%v), got %v", target, r)
+		return false
+	}
+	return true
+}
+
+// Some returns the value of the [gofp.Option], stopping the test if it is
+// None.
+func Some[T any](t testing.TB, o gofp.Option[T]) T {
+	t.Helper()
+	v, ok := o.TryUnwrap()
+	if !ok {
+		t.Fatalf("expected Some, got %v", o)
+	}
+	return v
+}
+
+// None reports an error if the [gofp.Option] is Some. It returns true if the
+// assertion passed.
+func None[T any](t testing.TB, o gofp.Option[T]) bool {
+	t.Helper()
+	if o.IsSome() {
+		t.Errorf("expected None, got %v", o)
+		return false
+	}
+	return true
+}
+
+// SomeEqual reports an error if the [gofp.Option] is not Some holding want. It
+// returns true if the assertion passed.
+func SomeEqual[T any](t testing.TB, o gofp.Option[T], want T) bool {
+	t.Helper()
+	v, ok := o.TryUnwrap()
+	if !ok || !reflect.DeepEqual(v, want) {
+		t.Errorf("expected %v, got %v", gofp.Some(want), o)
+		return false
+	}
+	return true
+}
+
+// Left returns the left value of the [gofp.Either], stopping the test if it is
+// Right.
+func Left[T, U any](t testing.TB, e gofp.Either[T, U]) T {
+	t.Helper()
+	v, ok := e.TryUnwrapLeft()
+	if !ok {
+		t.Fatalf("expected Left, got %v", e)
+	}
+	return v
+}
+
+// Right returns the right value of the [gofp.Either], stopping the test if it
+// is Left.
+func Right[T, U any](t testing.TB, e gofp.Either[T, U]) U {
+	t.Helper()
+	v, ok := e.TryUnwrap()
+	if !ok {
+		t.Fatalf("expected Right, got %v", e)
+	}
+	return v
+}
+
+// LeftEqual reports an error if the [gofp.Either] is not Left holding want. It
+// returns true if the assertion passed.
+func LeftEqual[T, U any](t testing.TB, e gofp.Either[T, U], want T) bool {
+	t.Helper()
+	v, ok := e.TryUnwrapLeft()
+	if !ok || !reflect.DeepEqual(v, want) {
+		t.Errorf("expected %v, got %v", gofp.Left[T, U](want), e)
+		return false
+	}
+	return true
+}
+
+// RightEqual reports an error if the [gofp.Either] is not Right holding want.
+// It returns true if the assertion passed.
+func RightEqual[T, U any](t testing.TB, e gofp.Either[T, U], want U) bool {
+	t.Helper()
+	v, ok := e.TryUnwrap()
+	if !ok || !reflect.DeepEqual(v, want) {
+		t.Errorf("expected %v, got %v", gofp.Right[T](want), e)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+package assert_test
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+)
+
+// recorder captures failures reported by the assertions under test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+// record calls f with a recorder in its own goroutine, so that a call to
+// Fatalf stops f without stopping the test, and returns the failures.
+func record(t *testing.T, f func(t testing.TB)) []string {
+	r := &recorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f(r)
+	}()
+	<-done
+	return r.failures
+}
+
+var errBoom = errors.New("boom")
+
+func TestResult(t *testing.T) {
+	t.Run("Ok returns the value", func(t *testing.T) {
+		if got := assert.Ok(t, gofp.Ok(1)); got != 1 {
+			t.Errorf("expected 1, got %v", got)
+		}
+	})
+
+	t.Run("Ok stops the test on Err", func(t *testing.T) {
+		reached := false
+		failures := record(t, func(t testing.TB) {
+			assert.Ok(t, gofp.Err[int](errBoom))
+			reached = true
+		})
+		if len(failures) != 1 || failures[0] != "expected Ok, got Err(boom)" {
+			t.Errorf("expected a single failure, got %v", failures)
+		}
+		if reached {
+			t.Error("expected the test to stop")
+		}
+	})
+
+	t.Run("Err returns the error", func(t *testing.T) {
+		if got := assert.Err(t, gofp.Err[int](errBoom)); got != errBoom {
+			t.Errorf("expected boom, got %v", got)
+		}
+		failures := record(t, func(t testing.TB) { assert.Err(t, gofp.Ok(1)) })
+		if len(failures) != 1 {
+			t.Errorf("expected a single failure, got %v", failures)
+		}
+	})
+
+	t.Run("OkEqual", func(t *testing.T) {
+		if !assert.OkEqual(t, gofp.Ok([]int{1, 2}), []int{1, 2}) {
+			t.Error("expected assertion to pass")
+		}
+		failures := record(t, func(t testing.TB) {
+			assert.OkEqual(t, gofp.Ok(1), 2)
+			assert.OkEqual(t, gofp.Err[int](errBoom), 2)
+		})
+		want := []string{"expected Ok(2), got Ok(1)", "expected Ok(2), got Err(boom)"}
+		if fmt.Sprint(failures) != fmt.Sprint(want) {
+			t.Errorf("expected %v, got %v", want, failures)
+		}
+	})
+
+	t.Run("ErrIs", func(t *testing.T) {
+		wrapped := gofp.Err[int](fmt.Errorf("wrapped: %w", errBoom))
+		if !assert.ErrIs(t, wrapped, errBoom) {
+			t.Error("expected assertion to pass")
+		}
+		failures := record(t, func(t testing.TB) {
+			assert.ErrIs(t, gofp.Ok(1), errBoom)
+			assert.ErrIs(t, gofp.Err[int](errors.New("other")), errBoom)
+		})
+		if len(failures) != 2 {
+			t.Errorf("expected two failures, got %v", failures)
+		}
+	})
+}
+
+func TestOption(t *testing.T) {
+	t.Run("Some returns the value", func(t *testing.T) {
+		if got := assert.Some(t, gofp.Some("a")); got != "a" {
+			t.Errorf("expected a, got %v", got)
+		}
+		failures := record(t, func(t testing.TB) { assert.Some(t, gofp.None[string]()) })
+		if len(failures) != 1 || failures[0] != "expected Some, got None" {
+			t.Errorf("expected a single failure, got %v", failures)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		if !assert.None(t, gofp.None[int]()) {
+			t.Error("expected assertion to pass")
+		}
+		failures := record(t, func(t testing.TB) { assert.None(t, gofp.Some(1)) })
+		if len(failures) != 1 {
+			t.Errorf("expected a single failure, got %v", failures)
+		}
+	})
+
+	t.Run("SomeEqual", func(t *testing.T) {
+		if !assert.SomeEqual(t, gofp.Some(1), 1) {
+			t.Error("expected assertion to pass")
+		}
+		failures := record(t, func(t testing.TB) {
+			assert.SomeEqual(t, gofp.Some(1), 2)
+			assert.SomeEqual(t, gofp.None[int](), 2)
+		})
+		want := []string{"expected Some(2), got Some(1)", "expected Some(2), got None"}
+		if fmt.Sprint(failures) != fmt.Sprint(want) {
+			t.Errorf("expected %v, got %v", want, failures)
+		}
+	})
+}
+
+func TestEither(t *testing.T) {
+	left := gofp.Left[string, int]("l")
+	right := gofp.Right[string](1)
+
+	t.Run("Left and Right return the value", func(t *testing.T) {
+		if got := assert.Left(t, left); got != "l" {
+			t.Errorf("expected l, got %v", got)
+		}
+		if got := assert.Right(t, right); got != 1 {
+			t.Errorf("expected 1, got %v", got)
+		}
+		failures := record(t, func(t testing.TB) { assert.Left(t, right) })
+		failures = append(failures, record(t, func(t testing.TB) { assert.Right(t, left) })...)
+		if len(failures) != 2 {
+			t.Errorf("expected two failures, got %v", failures)
+		}
+	})
+
+	t.Run("LeftEqual and RightEqual", func(t *testing.T) {
+		if !assert.LeftEqual(t, left, "l") || !assert.RightEqual(t, right, 1) {
+			t.Error("expected assertions to pass")
+		}
+		failures := record(t, func(t testing.TB) {
+			assert.LeftEqual(t, right, "l")
+			assert.RightEqual(t, right, 2)
+		})
+		want := []string{"expected Left(l), got Right(1)", "expected Right(2), got Right(1)"}
+		if fmt.Sprint(failures) != fmt.Sprint(want) {
+			t.Errorf("expected %v, got %v", want, failures)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package gofp
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrMissingKey is returned by [Batcher.Load] when the batch function does
+	// not return a [Result] for a requested key.
+	ErrMissingKey = errors.New("batch result missing for key")
+
+	// ErrFetchPanicked is wrapped by the error returned by [Batcher.Load] for
+	// every key of a batch whose batch function panicked.
+	ErrFetchPanicked = errors.New("batch function panicked")
+)
+
+// Batcher coalesces many individual loads into batched calls to a single
+// function. This is commonly known as the dataloader pattern and is useful for
+// avoiding N+1 query patterns, where many independent computations each fetch a
+// single value from the same backing store.
+//
+// Type parameter K represents the key type.
+// Type parameter V represents the value type.
+type Batcher[K comparable, V any] struct {
+	fetch    func([]K) map[K]Result[V]
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	pending *batch[K, V]
+}
+
+type batch[K comparable, V any] struct {
+	keys    []K
+	seen    map[K]struct{}
+	results map[K]Result[V]
+	timer   *time.Timer
+	once    sync.Once
+	done    chan struct{}
+}
+
+func (b *batch[K, V]) run(fetch func([]K) map[K]Result[V]) {
+	b.once.Do(func() {
+		defer close(b.done)
+		defer func() {
+			if p := recover(); p != nil {
+				err := fmt.Errorf("%w: %v", ErrFetchPanicked, p)
+				b.results = make(map[K]Result[V], len(b.keys))
+				for _, k := range b.keys {
+					b.results[k] = Err[V](err)
+				}
+			}
+		}()
+		b.results = fetch(b.keys)
+	})
+}
+
+// NewBatcher creates a [Batcher] that calls fetch with all keys requested
+// within the given wait duration. A batch is dispatched early once it reaches
+// maxBatch keys. A maxBatch less than or equal to zero places no limit on the
+// size of a batch.
+func NewBatcher[K comparable, V any](fetch func([]K) map[K]Result[V], wait time.Duration, maxBatch int) *Batcher[K, V] {
+	return &Batcher[K, V]{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+	}
+}
+
+// Load returns the [Result] for the given key, blocking until the batch
+// containing the key has been fetched. Duplicate keys within the same batch are
+// fetched only once. If the batch function does not return a [Result] for the
+// key, an Err holding [ErrMissingKey] is returned, and if it panics, an Err
+// wrapping [ErrFetchPanicked] is returned for every key in the batch.
+func (b *Batcher[K, V]) Load(key K) Result[V] {
+	b.mu.Lock()
+	bt := b.pending
+	if bt == nil {
+		bt = &batch[K, V]{
+			seen: make(map[K]struct{}),
+			done: make(chan struct{}),
+		}
+		bt.timer = time.AfterFunc(b.wait, func() {
+			b.mu.Lock()
+			if b.pending == bt {
+				b.pending = nil
+			}
+			b.mu.Unlock()
+			bt.run(b.fetch)
+		})
+		b.pending = bt
+	}
+
+	if _, ok := bt.seen[key]; !ok {
+		bt.seen[key] = struct{}{}
+		bt.keys = append(bt.keys, key)
+	}
+
+	full := b.maxBatch > 0 && len(bt.keys) >= b.maxBatch
+	if full {
+		b.pending = nil
+		bt.timer.Stop()
+	}
+	b.mu.Unlock()
+
+	if full {
+		bt.run(b.fetch)
+	}
+
+	<-bt.done
+	if r, ok := bt.results[key]; ok {
+		return r
+	}
+	return Err[V](ErrMissingKey)
+}
+
+// LoadMany returns the [Result] for each of the given keys, preserving order.
+// All keys are requested concurrently so that they may share a batch.
+func (b *Batcher[K, V]) LoadMany(keys []K) []Result[V] {
+	results := make([]Result[V], len(keys))
+
+	var wg sync.WaitGroup
+	wg.Add(len(keys))
+	for i, k := range keys {
+		go func(i int, k K) {
+			defer wg.Done()
+			results[i] = b.Load(k)
+		}(i, k)
+	}
+	wg.Wait()
+
+	return results
+}
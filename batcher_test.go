@@ -0,0 +1,89 @@
+package gofp_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestBatcher_Load(t *testing.T) {
+	t.Run("coalesces concurrent loads into one batch", func(t *testing.T) {
+		var mu sync.Mutex
+		var batches [][]int
+		b := gofp.NewBatcher(func(keys []int) map[int]gofp.Result[int] {
+			mu.Lock()
+			batches = append(batches, keys)
+			mu.Unlock()
+
+			results := make(map[int]gofp.Result[int], len(keys))
+			for _, k := range keys {
+				results[k] = gofp.Ok(k * 10)
+			}
+			return results
+		}, 10*time.Millisecond, 0)
+
+		got := b.LoadMany([]int{1, 2, 3, 2})
+		for i, want := range []int{10, 20, 30, 20} {
+			if got[i].Unwrap() != want {
+				t.Errorf("expected %d, got %v", want, got[i])
+			}
+		}
+		if len(batches) != 1 {
+			t.Fatalf("expected 1 batch, got %d", len(batches))
+		}
+		if len(batches[0]) != 3 {
+			t.Errorf("expected 3 distinct keys, got %v", batches[0])
+		}
+	})
+
+	t.Run("dispatches early when batch is full", func(t *testing.T) {
+		var mu sync.Mutex
+		calls := 0
+		b := gofp.NewBatcher(func(keys []int) map[int]gofp.Result[int] {
+			mu.Lock()
+			calls++
+			mu.Unlock()
+
+			results := make(map[int]gofp.Result[int], len(keys))
+			for _, k := range keys {
+				results[k] = gofp.Ok(k)
+			}
+			return results
+		}, time.Hour, 2)
+
+		got := b.LoadMany([]int{1, 2, 3, 4})
+		for i, want := range []int{1, 2, 3, 4} {
+			if got[i].Unwrap() != want {
+				t.Errorf("expected %d, got %v", want, got[i])
+			}
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 batches, got %d", calls)
+		}
+	})
+
+	t.Run("returns Err for missing keys", func(t *testing.T) {
+		b := gofp.NewBatcher(func(_ []string) map[string]gofp.Result[int] {
+			return map[string]gofp.Result[int]{}
+		}, time.Millisecond, 0)
+
+		got := b.Load("missing")
+		if !errors.Is(got.UnwrapErr(), gofp.ErrMissingKey) {
+			t.Errorf("expected ErrMissingKey, got %v", got)
+		}
+	})
+	t.Run("returns Err for every key when the batch function panics", func(t *testing.T) {
+		b := gofp.NewBatcher(func(_ []int) map[int]gofp.Result[int] {
+			panic("boom")
+		}, time.Millisecond, 0)
+
+		for _, got := range b.LoadMany([]int{1, 2, 3}) {
+			if !errors.Is(got.UnwrapErr(), gofp.ErrFetchPanicked) {
+				t.Errorf("expected ErrFetchPanicked, got %v", got)
+			}
+		}
+	})
+}
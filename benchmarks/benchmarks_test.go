@@ -0,0 +1,226 @@
+package benchmarks_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+var (
+	errNegative = errors.New("negative")
+	errTooLarge = errors.New("too large")
+
+	sinkInt    int
+	sinkErr    error
+	sinkResult gofp.Result[int]
+	sinkOption gofp.Option[int]
+)
+
+// The stages of each pipeline are written once in the (value, error) style
+// and once returning a [gofp.Result], doing identical work.
+
+func parse(s string) (int, error) {
+	return strconv.Atoi(s)
+}
+
+func checkPositive(n int) (int, error) {
+	if n < 0 {
+		return 0, errNegative
+	}
+	return n, nil
+}
+
+func checkBounded(n int) (int, error) {
+	if n > 1_000_000 {
+		return 0, errTooLarge
+	}
+	return n, nil
+}
+
+func double(n int) int {
+	return n * 2
+}
+
+func parseResult(s string) gofp.Result[int] {
+	return gofp.FromReturn(strconv.Atoi(s))
+}
+
+func checkPositiveResult(n int) gofp.Result[int] {
+	if n < 0 {
+		return gofp.Err[int](errNegative)
+	}
+	return gofp.Ok(n)
+}
+
+func checkBoundedResult(n int) gofp.Result[int] {
+	if n > 1_000_000 {
+		return gofp.Err[int](errTooLarge)
+	}
+	return gofp.Ok(n)
+}
+
+// Small pipelines parse, validate and transform a single value.
+
+func smallIdiomatic(s string) (int, error) {
+	n, err := parse(s)
+	if err != nil {
+		return 0, err
+	}
+	n, err = checkPositive(n)
+	if err != nil {
+		return 0, err
+	}
+	n, err = checkBounded(n)
+	if err != nil {
+		return 0, err
+	}
+	return double(n), nil
+}
+
+func smallResult(s string) gofp.Result[int] {
+	return parseResult(s).
+		FlatMap(checkPositiveResult).
+		FlatMap(checkBoundedResult).
+		Map(double)
+}
+
+// Medium pipelines repeat the validation and transformation stages, as a
+// longer chain of dependent steps would.
+
+const mediumRounds = 5
+
+func mediumIdiomatic(s string) (int, error) {
+	n, err := parse(s)
+	if err != nil {
+		return 0, err
+	}
+	for range mediumRounds {
+		n, err = checkPositive(n)
+		if err != nil {
+			return 0, err
+		}
+		n, err = checkBounded(n)
+		if err != nil {
+			return 0, err
+		}
+		n = double(n)
+	}
+	return n, nil
+}
+
+func mediumResult(s string) gofp.Result[int] {
+	r := parseResult(s)
+	for range mediumRounds {
+		r = r.FlatMap(checkPositiveResult).FlatMap(checkBoundedResult).Map(double)
+	}
+	return r
+}
+
+func BenchmarkSmallPipeline(b *testing.B) {
+	for _, input := range []struct{ name, value string }{{"Ok", "21"}, {"Err", "-21"}} {
+		b.Run("Idiomatic/"+input.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				sinkInt, sinkErr = smallIdiomatic(input.value)
+			}
+		})
+		b.Run("Result/"+input.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				sinkResult = smallResult(input.value)
+			}
+		})
+	}
+}
+
+func BenchmarkMediumPipeline(b *testing.B) {
+	for _, input := range []struct{ name, value string }{{"Ok", "21"}, {"Err", "999999"}} {
+		b.Run("Idiomatic/"+input.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				sinkInt, sinkErr = mediumIdiomatic(input.value)
+			}
+		})
+		b.Run("Result/"+input.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				sinkResult = mediumResult(input.value)
+			}
+		})
+	}
+}
+
+// Optional lookups compare a chain of map lookups through nil pointers with
+// the same chain through [gofp.Option].
+
+type node struct {
+	next  *node
+	value int
+}
+
+var chain = map[string]*node{
+	"present": {next: &node{next: &node{value: 42}}},
+	"missing": {next: &node{}},
+}
+
+func lookupPointer(key string) *int {
+	n := chain[key]
+	if n == nil || n.next == nil || n.next.next == nil {
+		return nil
+	}
+	return &n.next.next.value
+}
+
+func lookupOption(key string) gofp.Option[int] {
+	n := gofp.MapGet(chain, key).FlatMap(optionFromNode).FlatMap(next).FlatMap(next)
+	return gofp.OptionMap(n, func(n *node) int { return n.value })
+}
+
+func optionFromNode(n *node) gofp.Option[*node] {
+	return gofp.OptionFromPtr(&n).Filter(func(n *node) bool { return n != nil })
+}
+
+func next(n *node) gofp.Option[*node] {
+	return optionFromNode(n.next)
+}
+
+func BenchmarkLookup(b *testing.B) {
+	for _, key := range []string{"present", "missing"} {
+		b.Run("Pointer/"+key, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if p := lookupPointer(key); p != nil {
+					sinkInt = *p
+				}
+			}
+		})
+		b.Run("Option/"+key, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				sinkOption = lookupOption(key)
+			}
+		})
+	}
+}
+
+// TestEquivalence checks that each pair of benchmarked implementations
+// compute the same results, so that the benchmarks compare like with like.
+func TestEquivalence(t *testing.T) {
+	for _, s := range []string{"21", "-21", "999999", "x"} {
+		n, err := smallIdiomatic(s)
+		if got, want := smallResult(s), gofp.FromReturn(n, err); got.String() != want.String() {
+			t.Errorf("small %q: expected %v, got %v", s, want, got)
+		}
+		n, err = mediumIdiomatic(s)
+		if got, want := mediumResult(s), gofp.FromReturn(n, err); got.String() != want.String() {
+			t.Errorf("medium %q: expected %v, got %v", s, want, got)
+		}
+	}
+	for key := range chain {
+		if got, want := lookupOption(key), gofp.OptionFromPtr(lookupPointer(key)); got != want {
+			t.Errorf("lookup %q: expected %v, got %v", key, want, got)
+		}
+	}
+}
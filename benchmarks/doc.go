@@ -0,0 +1,12 @@
+// Package benchmarks compares gofp pipelines with the equivalent idiomatic Go.
+//
+// The package has no API. Its benchmarks run the same small and medium
+// pipelines written with [gofp.Result] and [gofp.Option] chains and with
+// (value, error) returns and nil pointers, on both the success and failure
+// paths:
+//
+//	go test -bench . -benchmem ./benchmarks
+//
+// The results indicate the overhead of the containers for a given pipeline
+// shape, and guard against performance regressions when they are redesigned.
+package benchmarks
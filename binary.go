@@ -0,0 +1,190 @@
+package gofp
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidEncoding is returned when decoding data that was not produced by
+// the MarshalBinary method of the same container type.
+var ErrInvalidEncoding = errors.New("invalid binary encoding")
+
+// binaryVersion is the first byte of every binary encoding, so that the format
+// may be changed without misinterpreting previously stored data.
+const binaryVersion = 1
+
+// Each binary encoding is the version byte, followed by a tag byte recording
+// whether the container is None, Err or Left (0), or Some, Ok or Right (1),
+// followed by the gob encoding of each of the values the container holds.
+const (
+	tagAbsent  = 0
+	tagPresent = 1
+)
+
+func encodeBinary(tag byte, values ...any) ([]byte, error) {
+	buf := bytes.NewBuffer([]byte{binaryVersion, tag})
+	enc := gob.NewEncoder(buf)
+	for _, v := range values {
+		if err := enc.Encode(v); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeBinary(data []byte) (byte, *gob.Decoder, error) {
+	if len(data) < 2 || data[0] != binaryVersion || data[1] > tagPresent {
+		return 0, nil, ErrInvalidEncoding
+	}
+	return data[1], gob.NewDecoder(bytes.NewReader(data[2:])), nil
+}
+
+func decodeValues(dec *gob.Decoder, values ...any) error {
+	for _, v := range values {
+		if err := dec.Decode(v); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidEncoding, err)
+		}
+	}
+	return nil
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler]. The value of a Some is
+// encoded using [encoding/gob].
+func (o Option[T]) MarshalBinary() ([]byte, error) {
+	if !o.valid {
+		return encodeBinary(tagAbsent)
+	}
+	return encodeBinary(tagPresent, o.value)
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+func (o *Option[T]) UnmarshalBinary(data []byte) error {
+	tag, dec, err := decodeBinary(data)
+	if err != nil {
+		return err
+	}
+	if tag == tagAbsent {
+		*o = None[T]()
+		return nil
+	}
+	var value T
+	if err := decodeValues(dec, &value); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
+
+// GobEncode implements [gob.GobEncoder] using [Option.MarshalBinary].
+func (o Option[T]) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements [gob.GobDecoder] using [Option.UnmarshalBinary].
+func (o *Option[T]) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler]. The value of an Ok is
+// encoded using [encoding/gob]. Since errors are interfaces whose concrete
+// types cannot generally be reconstructed, only the message and stack trace of
+// an Err are encoded. An Err holding a nil error has an empty message.
+func (r Result[T]) MarshalBinary() ([]byte, error) {
+	if r.isErr {
+		var msg string
+		if r.err != nil {
+			msg = r.err.Error()
+		}
+		return encodeBinary(tagAbsent, msg, r.stack.String())
+	}
+	return encodeBinary(tagPresent, r.value)
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler]. A decoded Err holds
+// an error with the encoded message and retains the encoded stack trace.
+func (r *Result[T]) UnmarshalBinary(data []byte) error {
+	tag, dec, err := decodeBinary(data)
+	if err != nil {
+		return err
+	}
+	if tag == tagAbsent {
+		var msg, stack string
+		if err := decodeValues(dec, &msg, &stack); err != nil {
+			return err
+		}
+		*r = Result[T]{err: errors.New(msg), isErr: true, stack: resolvedStack(stack)}
+		return nil
+	}
+	var value T
+	if err := decodeValues(dec, &value); err != nil {
+		return err
+	}
+	*r = Ok(value)
+	return nil
+}
+
+// GobEncode implements [gob.GobEncoder] using [Result.MarshalBinary].
+func (r Result[T]) GobEncode() ([]byte, error) {
+	return r.MarshalBinary()
+}
+
+// GobDecode implements [gob.GobDecoder] using [Result.UnmarshalBinary].
+func (r *Result[T]) GobDecode(data []byte) error {
+	return r.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler]. The left or right value
+// is encoded using [encoding/gob].
+func (e Either[T, U]) MarshalBinary() ([]byte, error) {
+	if e.isLeft {
+		return encodeBinary(tagAbsent, e.left)
+	}
+	return encodeBinary(tagPresent, e.right)
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+func (e *Either[T, U]) UnmarshalBinary(data []byte) error {
+	tag, dec, err := decodeBinary(data)
+	if err != nil {
+		return err
+	}
+	if tag == tagAbsent {
+		var left T
+		if err := decodeValues(dec, &left); err != nil {
+			return err
+		}
+		*e = Left[T, U](left)
+		return nil
+	}
+	var right U
+	if err := decodeValues(dec, &right); err != nil {
+		return err
+	}
+	*e = Right[T](right)
+	return nil
+}
+
+// GobEncode implements [gob.GobEncoder] using [Either.MarshalBinary].
+func (e Either[T, U]) GobEncode() ([]byte, error) {
+	return e.MarshalBinary()
+}
+
+// GobDecode implements [gob.GobDecoder] using [Either.UnmarshalBinary].
+func (e *Either[T, U]) GobDecode(data []byte) error {
+	return e.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler]. Since [Unit] has only
+// one value, its encoding is empty. This allows containers of [Unit], such as
+// Result[Unit], to be encoded with [encoding/gob], which otherwise rejects
+// structs without exported fields.
+func (Unit) MarshalBinary() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+func (*Unit) UnmarshalBinary([]byte) error {
+	return nil
+}
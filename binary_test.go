@@ -0,0 +1,119 @@
+package gofp_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func roundTrip[T any](t *testing.T, v T) T {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		t.Fatalf("expected no error encoding, got %v", err)
+	}
+	var got T
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("expected no error decoding, got %v", err)
+	}
+	return got
+}
+
+func TestOptionBinary(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		got := roundTrip(t, gofp.Some(42))
+		if got.UnwrapOr(0) != 42 {
+			t.Errorf("expected Some(42), got %v", got)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		got := roundTrip(t, gofp.None[int]())
+		if got.IsSome() {
+			t.Errorf("expected None, got %v", got)
+		}
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type entry struct {
+			Key   string
+			Value gofp.Option[[]string]
+		}
+		got := roundTrip(t, entry{Key: "k", Value: gofp.Some([]string{"a", "b"})})
+		if got.Key != "k" || len(got.Value.UnwrapOr(nil)) != 2 {
+			t.Errorf("expected entry to round trip, got %v", got)
+		}
+	})
+}
+
+func TestResultBinary(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		got := roundTrip(t, gofp.Ok("done"))
+		if got.UnwrapOr("") != "done" {
+			t.Errorf("expected Ok(done), got %v", got)
+		}
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		r := gofp.Err[int](errors.New("failed"))
+		got := roundTrip(t, r)
+		if !got.IsErr() || got.UnwrapErr().Error() != "failed" {
+			t.Errorf("expected Err(failed), got %v", got)
+		}
+		if got.StackTrace() != r.StackTrace() {
+			t.Errorf("expected stack trace to be preserved, got %q", got.StackTrace())
+		}
+	})
+
+	t.Run("Err holding nil", func(t *testing.T) {
+		got := roundTrip(t, gofp.Err[int](nil))
+		if !got.IsErr() || got.UnwrapErr().Error() != "" {
+			t.Errorf("expected Err with an empty message, got %v", got)
+		}
+	})
+
+	t.Run("Unit", func(t *testing.T) {
+		got := roundTrip(t, gofp.Ok(gofp.UnitValue))
+		if !got.IsOk() {
+			t.Errorf("expected Ok, got %v", got)
+		}
+	})
+}
+
+func TestEitherBinary(t *testing.T) {
+	t.Run("Left", func(t *testing.T) {
+		got := roundTrip(t, gofp.Left[string, int]("left"))
+		if got.UnwrapLeftOr("") != "left" {
+			t.Errorf("expected Left(left), got %v", got)
+		}
+	})
+
+	t.Run("Right", func(t *testing.T) {
+		got := roundTrip(t, gofp.Right[string](7))
+		if got.UnwrapOr(0) != 7 {
+			t.Errorf("expected Right(7), got %v", got)
+		}
+	})
+}
+
+func TestUnmarshalBinary_Invalid(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":           nil,
+		"unknown version": {9, 1},
+		"unknown tag":     {1, 7},
+		"truncated":       {1, 1},
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			var o gofp.Option[int]
+			if err := o.UnmarshalBinary(data); !errors.Is(err, gofp.ErrInvalidEncoding) {
+				t.Errorf("expected ErrInvalidEncoding, got %v", err)
+			}
+		})
+	}
+}
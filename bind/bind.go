@@ -0,0 +1,210 @@
+// Package bind decodes form and query string values into structs.
+//
+// A form value that was not submitted and one that was submitted empty are
+// usually decoded to the same zero value, so a handler cannot tell whether a
+// client meant to clear a field or left it out. Fields of type [gofp.Option]
+// are instead decoded to None when their key is absent, and to Some when it is
+// present, even if its value is empty:
+//
+//	type search struct {
+//		Query string              `form:"q"`
+//		Page  gofp.Option[int]    `form:"page"`
+//		Sort  gofp.Option[string] `form:"sort"`
+//	}
+//
+//	params := bind.Values[search](r.URL.Query())
+//
+// Every value that cannot be decoded is reported, rather than only the first,
+// as a Left holding a [validate.FieldError] for each, so that binding errors
+// may be reported alongside validation errors.
+//
+// Fields are decoded from the key given by their form tag, or otherwise from
+// their name. Fields tagged "-" and unexported fields are ignored. Fields may
+// be strings, booleans, numbers, [time.Duration] values or types implementing
+// [encoding.TextUnmarshaler], slices of these, which receive every value of
+// their key, or an Option holding any of these.
+package bind
+
+import (
+	"encoding"
+	"fmt"
+	"mime/multipart"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/validate"
+)
+
+const tagName = "form"
+
+var (
+	pkgPath             = reflect.TypeFor[gofp.Unit]().PkgPath()
+	durationType        = reflect.TypeFor[time.Duration]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// Values decodes the given values into a new value of the struct type T. It
+// returns Right holding the value if every field was decoded, or otherwise
+// Left holding an error for every value that could not be.
+//
+// It panics if T is not a struct or has a field of an unsupported type, since
+// these are programming errors.
+func Values[T any](values url.Values) gofp.Either[[]validate.FieldError, T] {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("bind: %s is not a struct", rv.Type()))
+	}
+
+	var errs []validate.FieldError
+	for i := range rv.NumField() {
+		sf := rv.Type().Field(i)
+		key, ok := fieldKey(sf)
+		if !ok {
+			continue
+		}
+		if !supported(sf.Type) {
+			panic(fmt.Sprintf("bind: field %s has unsupported type %s", sf.Name, sf.Type))
+		}
+		if err := setField(rv.Field(i), values[key], values.Has(key)); err != nil {
+			errs = append(errs, validate.FieldError{Field: key, Rule: "type", Message: err.Error()})
+		}
+	}
+	if len(errs) > 0 {
+		return gofp.Left[[]validate.FieldError, T](errs)
+	}
+	return gofp.Right[[]validate.FieldError](v)
+}
+
+// Form decodes the values of a multipart form into a new value of the struct
+// type T, as [Values] does. Files in the form are ignored.
+func Form[T any](form *multipart.Form) gofp.Either[[]validate.FieldError, T] {
+	return Values[T](url.Values(form.Value))
+}
+
+// fieldKey returns the key a field is decoded from, and whether it is decoded
+// at all.
+func fieldKey(sf reflect.StructField) (string, bool) {
+	if !sf.IsExported() {
+		return "", false
+	}
+	tag := sf.Tag.Get(tagName)
+	if tag == "-" {
+		return "", false
+	}
+	if tag != "" {
+		return tag, true
+	}
+	return sf.Name, true
+}
+
+// setField decodes the values of a key into a field. A field whose key is
+// absent is left as its zero value, which for an Option is None.
+func setField(v reflect.Value, values []string, present bool) error {
+	if !present {
+		return nil
+	}
+	if isOption(v.Type()) {
+		m, _ := v.Type().MethodByName("TryUnwrap")
+		inner := reflect.New(m.Type.Out(0)).Elem()
+		if err := setValue(inner, values); err != nil {
+			return err
+		}
+		v.Addr().MethodByName("Insert").Call([]reflect.Value{inner})
+		return nil
+	}
+	return setValue(v, values)
+}
+
+func setValue(v reflect.Value, values []string) error {
+	if v.Kind() == reflect.Slice && !isScalar(v.Type()) {
+		s := reflect.MakeSlice(v.Type(), len(values), len(values))
+		for i, value := range values {
+			if err := parse(s.Index(i), value); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	}
+	if len(values) == 0 {
+		return parse(v, "")
+	}
+	return parse(v, values[0])
+}
+
+func isOption(t reflect.Type) bool {
+	return t.PkgPath() == pkgPath && strings.HasPrefix(t.Name(), "Option[")
+}
+
+func supported(t reflect.Type) bool {
+	if isOption(t) {
+		m, _ := t.MethodByName("TryUnwrap")
+		t = m.Type.Out(0)
+	}
+	return isScalar(t) || t.Kind() == reflect.Slice && isScalar(t.Elem())
+}
+
+// isScalar reports whether a value of the type is decoded from a single
+// string.
+func isScalar(t reflect.Type) bool {
+	if t == durationType || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func parse(v reflect.Value, s string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("must be a duration, got %q", s)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("must be a boolean, got %q", s)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be an integer, got %q", s)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be a non-negative integer, got %q", s)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be a number, got %q", s)
+		}
+		v.SetFloat(n)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package bind_test
+
+import (
+	"mime/multipart"
+	"net/url"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+	"github.com/tomasbasham/gofp/bind"
+	"github.com/tomasbasham/gofp/validate"
+)
+
+type params struct {
+	Query    string              `form:"q"`
+	Page     gofp.Option[int]    `form:"page"`
+	Sort     gofp.Option[string] `form:"sort"`
+	Tags     []string            `form:"tag"`
+	Exact    bool                `form:"exact"`
+	Timeout  gofp.Option[time.Duration]
+	Ratio    float64                `form:"ratio"`
+	Since    gofp.Option[time.Time] `form:"since"`
+	Internal string                 `form:"-"`
+}
+
+func TestValues(t *testing.T) {
+	t.Run("decodes present values", func(t *testing.T) {
+		values := url.Values{
+			"q":       {"gofp"},
+			"page":    {"2"},
+			"sort":    {""},
+			"tag":     {"go", "fp"},
+			"exact":   {"true"},
+			"Timeout": {"5s"},
+			"ratio":   {"0.5"},
+			"since":   {"2024-01-02T03:04:05Z"},
+		}
+		got := assert.Right(t, bind.Values[params](values))
+
+		if got.Query != "gofp" || !got.Exact || got.Ratio != 0.5 {
+			t.Errorf("expected scalar fields to be decoded, got %+v", got)
+		}
+		assert.SomeEqual(t, got.Page, 2)
+		assert.SomeEqual(t, got.Sort, "")
+		assert.SomeEqual(t, got.Timeout, 5*time.Second)
+		assert.SomeEqual(t, got.Since, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+		if !slices.Equal(got.Tags, []string{"go", "fp"}) {
+			t.Errorf("expected [go fp], got %v", got.Tags)
+		}
+	})
+
+	t.Run("decodes absent options as None", func(t *testing.T) {
+		got := assert.Right(t, bind.Values[params](url.Values{"q": {"gofp"}}))
+		assert.None(t, got.Page)
+		assert.None(t, got.Sort)
+		assert.None(t, got.Timeout)
+	})
+
+	t.Run("ignores fields tagged -", func(t *testing.T) {
+		got := assert.Right(t, bind.Values[params](url.Values{"Internal": {"x"}, "-": {"x"}}))
+		if got.Internal != "" {
+			t.Errorf("expected Internal to be ignored, got %q", got.Internal)
+		}
+	})
+
+	t.Run("accumulates every error", func(t *testing.T) {
+		values := url.Values{
+			"page":  {"two"},
+			"exact": {"maybe"},
+			"ratio": {""},
+		}
+		errs := assert.Left(t, bind.Values[params](values))
+
+		want := []validate.FieldError{
+			{Field: "page", Rule: "type", Message: `must be an integer, got "two"`},
+			{Field: "exact", Rule: "type", Message: `must be a boolean, got "maybe"`},
+			{Field: "ratio", Rule: "type", Message: `must be a number, got ""`},
+		}
+		if !reflect.DeepEqual(errs, want) {
+			t.Errorf("expected %v, got %v", want, errs)
+		}
+	})
+
+	t.Run("panics on unsupported types", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected Values to panic")
+			}
+		}()
+		bind.Values[struct{ Data map[string]string }](url.Values{})
+	})
+}
+
+func TestForm(t *testing.T) {
+	form := &multipart.Form{Value: map[string][]string{"q": {"gofp"}, "page": {"3"}}}
+	got := assert.Right(t, bind.Form[params](form))
+	if got.Query != "gofp" {
+		t.Errorf("expected gofp, got %q", got.Query)
+	}
+	assert.SomeEqual(t, got.Page, 3)
+}
@@ -0,0 +1,27 @@
+package bind_test
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/bind"
+)
+
+func ExampleValues() {
+	type update struct {
+		Name  gofp.Option[string] `form:"name"`
+		Email gofp.Option[string] `form:"email"`
+	}
+
+	// The name is submitted empty, so should be cleared, but the email is not
+	// submitted at all, so should be left unchanged.
+	values, _ := url.ParseQuery("name=")
+	u := bind.Values[update](values).UnwrapOr(update{})
+
+	fmt.Println(u.Name)
+	fmt.Println(u.Email)
+	// Output:
+	// Some()
+	// None
+}
@@ -0,0 +1,159 @@
+// Package budget limits the cost and duration of [reader.Reader] computations.
+//
+// A [Budget] holds a remaining cost and an optional deadline. It is carried in
+// the environment of a computation, which must implement [Env], and is debited
+// by the computations wrapped with [Spend]. Once the cost is exhausted or the
+// deadline has passed, further computations produce an Err rather than run.
+//
+// [Limit] runs a computation with a child budget installed in its environment
+// using [reader.Local], so that a single stage of a pipeline may be capped
+// without affecting the budget available to the rest of it. Spending from a
+// child budget also spends from its parents.
+package budget
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/reader"
+)
+
+var (
+	// ErrExhausted is held by the result of a computation that was not run
+	// because its cost exceeded the remaining budget.
+	ErrExhausted = gofp.NewCodedError(gofp.CodeResourceExhausted, "budget exhausted")
+
+	// ErrDeadlineExceeded is held by the result of a computation that was not
+	// run because the deadline of the budget had passed.
+	ErrDeadlineExceeded = gofp.NewCodedError(gofp.CodeDeadlineExceeded, "budget deadline exceeded")
+
+	// ErrNegativeCost is held by the result of a computation that was not run
+	// because its cost was negative, which would otherwise refund the budget.
+	ErrNegativeCost = gofp.NewCodedError(gofp.CodeInvalidArgument, "negative budget cost")
+)
+
+// Budget is a cost and deadline shared by the computations that spend from it.
+// A Budget is safe for concurrent use.
+type Budget struct {
+	mu        sync.Mutex
+	remaining int64
+	deadline  time.Time
+	parent    *Budget
+}
+
+// New returns a [*Budget] holding the given cost. A zero deadline means that
+// the budget never expires.
+func New(cost int64, deadline time.Time) *Budget {
+	return &Budget{remaining: cost, deadline: deadline}
+}
+
+// Remaining returns the cost that may still be spent from the budget, which is
+// limited by the remaining cost of each of its parents.
+func (b *Budget) Remaining() int64 {
+	remaining := b.own()
+	for p := b.parent; p != nil; p = p.parent {
+		remaining = min(remaining, p.own())
+	}
+	return remaining
+}
+
+// Deadline returns the time at which the budget expires, and whether it has a
+// deadline at all.
+func (b *Budget) Deadline() (time.Time, bool) {
+	return b.deadline, !b.deadline.IsZero()
+}
+
+// Child returns a [*Budget] holding at most the given cost, and expiring no
+// later than timeout from now. A zero timeout means the child expires with b.
+// Spending from the child also spends from b.
+func (b *Budget) Child(cost int64, timeout time.Duration) *Budget {
+	deadline := b.deadline
+	if timeout > 0 {
+		if d := time.Now().Add(timeout); deadline.IsZero() || d.Before(deadline) {
+			deadline = d
+		}
+	}
+	return &Budget{
+		remaining: min(cost, b.Remaining()),
+		deadline:  deadline,
+		parent:    b,
+	}
+}
+
+// Spend debits the given cost from the budget and each of its parents. It
+// returns [ErrNegativeCost] if the cost is negative, [ErrDeadlineExceeded] if
+// the deadline has passed, or [ErrExhausted] if any of the budgets cannot
+// cover the cost, in which case none of them are debited.
+func (b *Budget) Spend(cost int64) error {
+	if cost < 0 {
+		return ErrNegativeCost
+	}
+	if d, ok := b.Deadline(); ok && !time.Now().Before(d) {
+		return ErrDeadlineExceeded
+	}
+
+	var spent []*Budget
+	for p := b; p != nil; p = p.parent {
+		if !p.debit(cost) {
+			for _, s := range spent {
+				s.debit(-cost)
+			}
+			return ErrExhausted
+		}
+		spent = append(spent, p)
+	}
+	return nil
+}
+
+func (b *Budget) own() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.remaining
+}
+
+func (b *Budget) debit(cost int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if cost > b.remaining {
+		return false
+	}
+	b.remaining -= cost
+	return true
+}
+
+// Env is implemented by environments that carry a [*Budget].
+//
+// Type parameter E represents the environment type itself, so that
+// WithBudget may return a modified copy of the environment.
+type Env[E any] interface {
+	// Budget returns the budget carried by the environment.
+	Budget() *Budget
+
+	// WithBudget returns a copy of the environment carrying the given budget.
+	WithBudget(*Budget) E
+}
+
+// Spend wraps a [reader.TryReader] computation so that the given cost is
+// debited from the budget of the environment before it runs. If the cost is
+// negative, the budget is exhausted or its deadline has passed, the
+// computation is not run and the result is an Err holding the error returned
+// by [Budget.Spend].
+func Spend[E Env[E], A any](cost int64, r reader.TryReader[E, A]) reader.TryReader[E, A] {
+	return reader.New(func(env E) gofp.Result[A] {
+		if err := env.Budget().Spend(cost); err != nil {
+			return gofp.Err[A](err)
+		}
+		return r.Run(env)
+	})
+}
+
+// Limit runs a [reader.TryReader] computation with a child of the budget of
+// the environment, holding at most the given cost and expiring no later than
+// timeout from when it runs. A zero timeout imposes no additional deadline.
+// See [Budget.Child].
+func Limit[E Env[E], A any](cost int64, timeout time.Duration, r reader.TryReader[E, A]) reader.TryReader[E, A] {
+	return reader.Local(r, func(env E) E {
+		return env.WithBudget(env.Budget().Child(cost, timeout))
+	})
+}
@@ -0,0 +1,178 @@
+package budget_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/budget"
+	"github.com/tomasbasham/gofp/reader"
+)
+
+type env struct {
+	budget *budget.Budget
+}
+
+func (e env) Budget() *budget.Budget {
+	return e.budget
+}
+
+func (e env) WithBudget(b *budget.Budget) env {
+	e.budget = b
+	return e
+}
+
+func counting(runs *int) reader.TryReader[env, int] {
+	return reader.New(func(env) gofp.Result[int] {
+		*runs++
+		return gofp.Ok(*runs)
+	})
+}
+
+func TestSpend(t *testing.T) {
+	t.Run("debits the budget", func(t *testing.T) {
+		var runs int
+		e := env{budget.New(10, time.Time{})}
+		r := budget.Spend(4, counting(&runs))
+
+		if got := r.Run(e); got.IsErr() {
+			t.Fatalf("expected Ok, got %v", got)
+		}
+		if got := r.Run(e); got.IsErr() {
+			t.Fatalf("expected Ok, got %v", got)
+		}
+		if got := e.budget.Remaining(); got != 2 {
+			t.Errorf("expected 2 remaining, got %v", got)
+		}
+	})
+
+	t.Run("returns Err when exhausted", func(t *testing.T) {
+		var runs int
+		e := env{budget.New(3, time.Time{})}
+		got := budget.Spend(4, counting(&runs)).Run(e)
+
+		if !errors.Is(got.UnwrapErr(), budget.ErrExhausted) {
+			t.Errorf("expected ErrExhausted, got %v", got)
+		}
+		if gofp.CodeOf(got.UnwrapErr()) != gofp.CodeResourceExhausted {
+			t.Errorf("expected %v, got %v", gofp.CodeResourceExhausted, gofp.CodeOf(got.UnwrapErr()))
+		}
+		if runs != 0 {
+			t.Errorf("expected computation not to run, ran %d times", runs)
+		}
+		if got := e.budget.Remaining(); got != 3 {
+			t.Errorf("expected 3 remaining, got %v", got)
+		}
+	})
+
+	t.Run("returns Err for a negative cost", func(t *testing.T) {
+		var runs int
+		e := env{budget.New(3, time.Time{})}
+		got := budget.Spend(-4, counting(&runs)).Run(e)
+
+		if !errors.Is(got.UnwrapErr(), budget.ErrNegativeCost) {
+			t.Errorf("expected ErrNegativeCost, got %v", got)
+		}
+		if gofp.CodeOf(got.UnwrapErr()) != gofp.CodeInvalidArgument {
+			t.Errorf("expected %v, got %v", gofp.CodeInvalidArgument, gofp.CodeOf(got.UnwrapErr()))
+		}
+		if runs != 0 {
+			t.Errorf("expected computation not to run, ran %d times", runs)
+		}
+		if got := e.budget.Remaining(); got != 3 {
+			t.Errorf("expected 3 remaining, got %v", got)
+		}
+	})
+
+	t.Run("returns Err after the deadline", func(t *testing.T) {
+		var runs int
+		e := env{budget.New(10, time.Now().Add(-time.Second))}
+		got := budget.Spend(1, counting(&runs)).Run(e)
+
+		if !errors.Is(got.UnwrapErr(), budget.ErrDeadlineExceeded) {
+			t.Errorf("expected ErrDeadlineExceeded, got %v", got)
+		}
+		if runs != 0 {
+			t.Errorf("expected computation not to run, ran %d times", runs)
+		}
+	})
+}
+
+func TestLimit(t *testing.T) {
+	t.Run("caps the cost of a stage", func(t *testing.T) {
+		var runs int
+		e := env{budget.New(10, time.Time{})}
+		stage := reader.TryFlatMap(budget.Spend(2, counting(&runs)), func(int) reader.TryReader[env, int] {
+			return budget.Spend(2, counting(&runs))
+		})
+
+		got := budget.Limit(3, 0, stage).Run(e)
+		if !errors.Is(got.UnwrapErr(), budget.ErrExhausted) {
+			t.Errorf("expected ErrExhausted, got %v", got)
+		}
+		if runs != 1 {
+			t.Errorf("expected 1 run, got %d", runs)
+		}
+		if got := e.budget.Remaining(); got != 8 {
+			t.Errorf("expected 8 remaining in the parent, got %v", got)
+		}
+	})
+
+	t.Run("is capped by the parent", func(t *testing.T) {
+		var runs int
+		e := env{budget.New(10, time.Time{})}
+		r := reader.TryFlatMap(budget.Spend(8, counting(&runs)), func(int) reader.TryReader[env, int] {
+			return budget.Limit(5, 0, budget.Spend(3, counting(&runs)))
+		})
+
+		got := r.Run(e)
+		if !errors.Is(got.UnwrapErr(), budget.ErrExhausted) {
+			t.Errorf("expected ErrExhausted, got %v", got)
+		}
+		if got := e.budget.Remaining(); got != 2 {
+			t.Errorf("expected 2 remaining, got %v", got)
+		}
+	})
+
+	t.Run("imposes a deadline", func(t *testing.T) {
+		var runs int
+		e := env{budget.New(10, time.Time{})}
+		slow := reader.TryFlatMap(budget.Spend(1, counting(&runs)), func(int) reader.TryReader[env, int] {
+			time.Sleep(20 * time.Millisecond)
+			return budget.Spend(1, counting(&runs))
+		})
+
+		got := budget.Limit(10, 10*time.Millisecond, slow).Run(e)
+		if !errors.Is(got.UnwrapErr(), budget.ErrDeadlineExceeded) {
+			t.Errorf("expected ErrDeadlineExceeded, got %v", got)
+		}
+		if gofp.CodeOf(got.UnwrapErr()) != gofp.CodeDeadlineExceeded {
+			t.Errorf("expected %v, got %v", gofp.CodeDeadlineExceeded, gofp.CodeOf(got.UnwrapErr()))
+		}
+	})
+}
+
+func TestBudgetChild(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	parent := budget.New(10, deadline)
+
+	child := parent.Child(20, 0)
+	if got := child.Remaining(); got != 10 {
+		t.Errorf("expected 10 remaining, got %v", got)
+	}
+	if got, ok := child.Deadline(); !ok || !got.Equal(deadline) {
+		t.Errorf("expected deadline %v, got %v", deadline, got)
+	}
+
+	if err := child.Spend(4); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := parent.Remaining(); got != 6 {
+		t.Errorf("expected 6 remaining in the parent, got %v", got)
+	}
+
+	if _, ok := budget.New(1, time.Time{}).Deadline(); ok {
+		t.Error("expected no deadline")
+	}
+}
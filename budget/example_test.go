@@ -0,0 +1,36 @@
+package budget_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/budget"
+	"github.com/tomasbasham/gofp/reader"
+)
+
+type Request struct {
+	budget *budget.Budget
+}
+
+func (r Request) Budget() *budget.Budget {
+	return r.budget
+}
+
+func (r Request) WithBudget(b *budget.Budget) Request {
+	r.budget = b
+	return r
+}
+
+func ExampleSpend() {
+	query := budget.Spend(3, reader.Pure[Request](gofp.Ok("rows")))
+
+	req := Request{budget.New(5, time.Time{})}
+	fmt.Println(query.Run(req))
+	fmt.Println(query.Run(req))
+	fmt.Println(req.Budget().Remaining())
+	// Output:
+	// Ok(rows)
+	// Err(RESOURCE_EXHAUSTED: budget exhausted)
+	// 2
+}
@@ -0,0 +1,118 @@
+package gofp
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrCanceled is the error reported when a computation stops because it was
+// canceled, whether by a [CancelToken] or a [context.Context]. It has
+// [CodeCanceled] and wraps [context.Canceled], so it matches either with
+// [errors.Is]. It is also the cause of a [CancelToken] canceled without a
+// cause of its own.
+var ErrCanceled error = &CodedError{Code: CodeCanceled, Cause: context.Canceled}
+
+// ContextError returns the error reported when a computation stops because
+// ctx is done, or nil if it is not. It returns [ErrCanceled] if ctx was
+// canceled, and a [*CodedError] with [CodeDeadlineExceeded] wrapping
+// [context.DeadlineExceeded] if its deadline passed.
+func ContextError(ctx context.Context) error {
+	err := ctx.Err()
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, context.DeadlineExceeded):
+		return &CodedError{Code: CodeDeadlineExceeded, Cause: err}
+	default:
+		return ErrCanceled
+	}
+}
+
+// CancelToken signals that a computation should stop. It serves the same
+// purpose as the cancellation of a [context.Context], for code that does not
+// otherwise use contexts, and is accepted by the concurrent combinators such
+// as [ParTraverseToken] and [Group]. A nil *CancelToken is never canceled, so
+// it may be passed where cancellation is not needed. A CancelToken is safe for
+// concurrent use.
+type CancelToken struct {
+	done  chan struct{}
+	once  sync.Once
+	cause error
+}
+
+// NewCancelToken returns a new [*CancelToken] and a function that cancels it
+// with the given cause, or [ErrCanceled] if the cause is nil. Only the first
+// call to the function has any effect. If parent is not nil the token is also
+// canceled, with the same cause, when parent is canceled. As with a context,
+// the function should be called once the token is no longer needed to release
+// the resources associated with it.
+func NewCancelToken(parent *CancelToken) (*CancelToken, func(cause error)) {
+	t := &CancelToken{done: make(chan struct{})}
+	if parent != nil {
+		go func() {
+			select {
+			case <-parent.done:
+				t.cancel(parent.cause)
+			case <-t.done:
+			}
+		}()
+	}
+	return t, t.cancel
+}
+
+// CancelTokenFromContext returns a [*CancelToken] that is canceled when ctx
+// is done, with the cause of ctx as given by [context.Cause], and a function
+// that cancels it sooner, as with [NewCancelToken]. A context done without a
+// cause of its own cancels the token with the error given by [ContextError].
+// The function should be called once the token is no longer needed, so that
+// the token stops waiting for ctx.
+func CancelTokenFromContext(ctx context.Context) (*CancelToken, func(cause error)) {
+	t := &CancelToken{done: make(chan struct{})}
+	stop := context.AfterFunc(ctx, func() {
+		cause := context.Cause(ctx)
+		if cause == ctx.Err() {
+			cause = ContextError(ctx)
+		}
+		t.cancel(cause)
+	})
+	return t, func(cause error) {
+		t.cancel(cause)
+		stop()
+	}
+}
+
+func (t *CancelToken) cancel(cause error) {
+	t.once.Do(func() {
+		if cause == nil {
+			cause = ErrCanceled
+		}
+		t.cause = cause
+		close(t.done)
+	})
+}
+
+// IsCanceled returns Some holding the cause of the cancellation if the token
+// has been canceled, or None otherwise. Its spelling follows that of
+// [context.Canceled] and [CodeCanceled].
+func (t *CancelToken) IsCanceled() Option[error] {
+	if t == nil {
+		return None[error]()
+	}
+	select {
+	case <-t.done:
+		return Some(t.cause)
+	default:
+		return None[error]()
+	}
+}
+
+// Done returns a channel that is closed when the token is canceled, for use
+// in select statements. The channel of a nil token is nil, so it is never
+// ready.
+func (t *CancelToken) Done() <-chan struct{} {
+	if t == nil {
+		return nil
+	}
+	return t.done
+}
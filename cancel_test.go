@@ -0,0 +1,174 @@
+package gofp_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+)
+
+func TestCancelToken(t *testing.T) {
+	t.Run("is not canceled until cancel is called", func(t *testing.T) {
+		tok, cancel := gofp.NewCancelToken(nil)
+		assert.None(t, tok.IsCanceled())
+
+		errStop := errors.New("stop")
+		cancel(errStop)
+		cancel(errors.New("ignored"))
+		assert.SomeEqual(t, tok.IsCanceled(), errStop)
+
+		select {
+		case <-tok.Done():
+		default:
+			t.Error("expected Done to be closed")
+		}
+	})
+
+	t.Run("defaults the cause to ErrCanceled", func(t *testing.T) {
+		tok, cancel := gofp.NewCancelToken(nil)
+		cancel(nil)
+		assert.SomeEqual[error](t, tok.IsCanceled(), gofp.ErrCanceled)
+		if gofp.CodeOf(gofp.ErrCanceled) != gofp.CodeCanceled {
+			t.Errorf("expected %v, got %v", gofp.CodeCanceled, gofp.CodeOf(gofp.ErrCanceled))
+		}
+	})
+
+	t.Run("is canceled with its parent", func(t *testing.T) {
+		parent, cancelParent := gofp.NewCancelToken(nil)
+		child, cancelChild := gofp.NewCancelToken(parent)
+		defer cancelChild(nil)
+
+		errStop := errors.New("stop")
+		cancelParent(errStop)
+		<-child.Done()
+		assert.SomeEqual(t, child.IsCanceled(), errStop)
+	})
+
+	t.Run("does not cancel its parent", func(t *testing.T) {
+		parent, cancelParent := gofp.NewCancelToken(nil)
+		defer cancelParent(nil)
+		_, cancelChild := gofp.NewCancelToken(parent)
+		cancelChild(nil)
+		assert.None(t, parent.IsCanceled())
+	})
+
+	t.Run("is never canceled when nil", func(t *testing.T) {
+		var tok *gofp.CancelToken
+		assert.None(t, tok.IsCanceled())
+		if tok.Done() != nil {
+			t.Error("expected nil Done channel")
+		}
+	})
+}
+
+func TestCancelTokenFromContext(t *testing.T) {
+	errStop := errors.New("stop")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	tok, stop := gofp.CancelTokenFromContext(ctx)
+	defer stop(nil)
+	assert.None(t, tok.IsCanceled())
+
+	cancel(errStop)
+	select {
+	case <-tok.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected token to be canceled")
+	}
+	assert.SomeEqual(t, tok.IsCanceled(), errStop)
+}
+
+func TestCancelTokenFromContext_withoutCause(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	tok, stop := gofp.CancelTokenFromContext(ctx)
+	defer stop(nil)
+
+	cancel()
+	<-tok.Done()
+	assert.SomeEqual(t, tok.IsCanceled(), gofp.ErrCanceled)
+}
+
+func TestCancelTokenFromContext_canceledDirectly(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	tok, stop := gofp.CancelTokenFromContext(ctx)
+
+	errStop := errors.New("stop")
+	stop(errStop)
+	assert.SomeEqual(t, tok.IsCanceled(), errStop)
+
+	// The token no longer waits for the context, so its cause is unchanged.
+	cancel(errors.New("ignored"))
+	assert.SomeEqual(t, tok.IsCanceled(), errStop)
+}
+
+func TestContextError(t *testing.T) {
+	t.Run("returns nil when the context is not done", func(t *testing.T) {
+		if err := gofp.ContextError(context.Background()); err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+	})
+
+	t.Run("returns ErrCanceled when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := gofp.ContextError(ctx)
+		if err != gofp.ErrCanceled {
+			t.Errorf("expected ErrCanceled, got %v", err)
+		}
+		if !errors.Is(err, context.Canceled) {
+			t.Error("expected error to wrap context.Canceled")
+		}
+	})
+
+	t.Run("returns DEADLINE_EXCEEDED when the deadline passes", func(t *testing.T) {
+		ctx, cancel := context.WithDeadline(context.Background(), time.Time{})
+		defer cancel()
+		err := gofp.ContextError(ctx)
+		if gofp.CodeOf(err) != gofp.CodeDeadlineExceeded || !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected DEADLINE_EXCEEDED wrapping context.DeadlineExceeded, got %v", err)
+		}
+	})
+}
+
+func TestParTraverseToken(t *testing.T) {
+	errStop := errors.New("stop")
+	tok, cancel := gofp.NewCancelToken(nil)
+
+	var calls atomic.Int32
+	got := gofp.ParTraverseToken(tok, make([]int, 10), 1, func(int) gofp.Result[int] {
+		if calls.Add(1) == 3 {
+			cancel(errStop)
+		}
+		return gofp.Ok(0)
+	})
+
+	assert.ErrIs(t, got, errStop)
+	if n := calls.Load(); n != 3 {
+		t.Errorf("expected 3 calls, got %d", n)
+	}
+}
+
+func TestParTraverseUnorderedToken(t *testing.T) {
+	tok, cancel := gofp.NewCancelToken(nil)
+
+	var seen int
+	for range gofp.ParTraverseUnorderedToken(tok, make([]int, 100), 1, func(int) gofp.Result[int] {
+		return gofp.Ok(0)
+	}) {
+		seen++
+		if seen == 3 {
+			cancel(nil)
+		}
+	}
+
+	// The single worker may have started one more call before the token was
+	// canceled.
+	if seen < 3 || seen > 4 {
+		t.Errorf("expected iteration to stop after cancellation, saw %d results", seen)
+	}
+	assert.Some(t, tok.IsCanceled())
+}
@@ -0,0 +1,55 @@
+package gofp
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrChanClosed is returned by [FromChan] and [Await] when the channel is
+// closed before a value is received.
+var ErrChanClosed = errors.New("channel closed")
+
+// FromChan receives a single value from a channel and returns it as an Ok. If
+// the context is done first, it returns an Err holding the error given by
+// [ContextError]. If the channel is closed, it returns an Err holding
+// [ErrChanClosed].
+func FromChan[T any](ctx context.Context, ch <-chan T) Result[T] {
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			return Err[T](ErrChanClosed)
+		}
+		return Ok(v)
+	case <-ctx.Done():
+		return Err[T](ContextError(ctx))
+	}
+}
+
+// Await receives a single [Result] from a channel. It behaves as [FromChan],
+// except that the received [Result] is returned as is.
+func Await[T any](ctx context.Context, ch <-chan Result[T]) Result[T] {
+	return ResultFlatMap(FromChan(ctx, ch), func(r Result[T]) Result[T] {
+		return r
+	})
+}
+
+// ResultToChan returns a closed channel from which the given [Result] can be
+// received exactly once.
+func ResultToChan[T any](r Result[T]) <-chan Result[T] {
+	ch := make(chan Result[T], 1)
+	ch <- r
+	close(ch)
+	return ch
+}
+
+// Async runs a function in a new goroutine and returns a channel from which
+// its [Result] can be received once it completes. The channel is closed after
+// the [Result] is sent.
+func Async[T any](fn func() Result[T]) <-chan Result[T] {
+	ch := make(chan Result[T], 1)
+	go func() {
+		defer close(ch)
+		ch <- fn()
+	}()
+	return ch
+}
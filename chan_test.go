@@ -0,0 +1,66 @@
+package gofp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestFromChan(t *testing.T) {
+	t.Run("receives value", func(t *testing.T) {
+		ch := make(chan int, 1)
+		ch <- 1
+		got := gofp.FromChan(context.Background(), ch)
+		if got.Unwrap() != 1 {
+			t.Errorf("expected Ok(1), got %v", got)
+		}
+	})
+
+	t.Run("returns ErrChanClosed for closed channel", func(t *testing.T) {
+		ch := make(chan int)
+		close(ch)
+		got := gofp.FromChan(context.Background(), ch)
+		if got.UnwrapErr() != gofp.ErrChanClosed {
+			t.Errorf("expected ErrChanClosed, got %v", got)
+		}
+	})
+
+	t.Run("returns canceled error", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		got := gofp.FromChan(ctx, make(chan int))
+		if gofp.CodeOf(got.UnwrapErr()) != gofp.CodeCanceled {
+			t.Errorf("expected CANCELED, got %v", got)
+		}
+		if !errors.Is(got.UnwrapErr(), context.Canceled) {
+			t.Errorf("expected error to wrap context.Canceled")
+		}
+	})
+
+	t.Run("returns deadline exceeded error", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		got := gofp.FromChan(ctx, make(chan int))
+		if gofp.CodeOf(got.UnwrapErr()) != gofp.CodeDeadlineExceeded {
+			t.Errorf("expected DEADLINE_EXCEEDED, got %v", got)
+		}
+	})
+}
+
+func TestAwait(t *testing.T) {
+	errFailed := errors.New("failed")
+	got := gofp.Await(context.Background(), gofp.Async(func() gofp.Result[int] {
+		return gofp.Err[int](errFailed)
+	}))
+	if got.UnwrapErr() != errFailed {
+		t.Errorf("expected %v, got %v", errFailed, got)
+	}
+
+	got = gofp.Await(context.Background(), gofp.ResultToChan(gofp.Ok(1)))
+	if got.Unwrap() != 1 {
+		t.Errorf("expected Ok(1), got %v", got)
+	}
+}
@@ -0,0 +1,92 @@
+// Gofpcheck reports calls whose [gofp.Result] or [gofp.Option] is discarded.
+//
+// A function that returns an error as a [gofp.Result] rather than as a second
+// return value cannot be checked by tools such as errcheck, and the compiler
+// does not require the result of a call to be used. Gofpcheck reports every
+// call used as a statement, including in go and defer statements, whose value
+// is a [gofp.Result] or [gofp.Option], since an Err or None discarded in this
+// way is silently ignored.
+//
+// Usage:
+//
+//	gofpcheck [flags] [packages]
+//
+// It may also be run by go vet:
+//
+//	go vet -vettool=$(which gofpcheck) ./...
+//
+// A value that is deliberately ignored should be assigned to the blank
+// identifier, which is not reported:
+//
+//	_ = cache.Store(key, value)
+package main
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/analysis/singlechecker"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const gofpPath = "github.com/tomasbasham/gofp"
+
+// Analyzer reports calls whose Result or Option is discarded.
+var Analyzer = &analysis.Analyzer{
+	Name:     "discard",
+	Doc:      "report calls whose gofp.Result or gofp.Option is discarded",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func main() {
+	singlechecker.Main(Analyzer)
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodes := []ast.Node{
+		(*ast.ExprStmt)(nil),
+		(*ast.GoStmt)(nil),
+		(*ast.DeferStmt)(nil),
+	}
+	ins.Preorder(nodes, func(n ast.Node) {
+		var call *ast.CallExpr
+		switch stmt := n.(type) {
+		case *ast.ExprStmt:
+			call, _ = ast.Unparen(stmt.X).(*ast.CallExpr)
+		case *ast.GoStmt:
+			call = stmt.Call
+		case *ast.DeferStmt:
+			call = stmt.Call
+		}
+		if call == nil {
+			return
+		}
+
+		if container, ok := discarded(pass.TypesInfo.TypeOf(call)); ok {
+			pass.Reportf(call.Pos(), "%s returned by call is discarded", container)
+		}
+	})
+	return nil, nil
+}
+
+// discarded reports whether t is a Result or Option, and if so the name of
+// the container.
+func discarded(t types.Type) (string, bool) {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return "", false
+	}
+	obj := named.Origin().Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != gofpPath {
+		return "", false
+	}
+	switch obj.Name() {
+	case "Result", "Option":
+		return obj.Name(), true
+	}
+	return "", false
+}
@@ -0,0 +1,25 @@
+package example
+
+import "github.com/tomasbasham/gofp"
+
+type ResultAlias = gofp.Result[int]
+
+func store() gofp.Result[int] { return gofp.Ok(1) }
+
+func alias() ResultAlias { return gofp.Ok(1) }
+
+func statements() {
+	store()                                   // want `Result returned by call is discarded`
+	(store())                                 // want `Result returned by call is discarded`
+	gofp.Some("a")                            // want `Option returned by call is discarded`
+	alias()                                   // want `Result returned by call is discarded`
+	store().Map(func(x int) int { return x }) // want `Result returned by call is discarded`
+	defer store()                             // want `Result returned by call is discarded`
+	go store()                                // want `Result returned by call is discarded`
+
+	_ = store()
+	r := store()
+	_ = r
+	gofp.Right[string](1)
+	println("ok")
+}
@@ -0,0 +1,17 @@
+// Package gofp is a stub of the gofp package holding the types that
+// gofpcheck inspects.
+package gofp
+
+type Option[T any] struct{ value T }
+
+func Some[T any](v T) Option[T] { return Option[T]{v} }
+
+type Result[T any] struct{ value T }
+
+func Ok[T any](v T) Result[T] { return Result[T]{v} }
+
+func (r Result[T]) Map(fn func(T) T) Result[T] { return Ok(fn(r.value)) }
+
+type Either[T, U any] struct{ right U }
+
+func Right[T, U any](v U) Either[T, U] { return Either[T, U]{v} }
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("generates functions for each type and arity", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := Generate(&buf, Config{Package: "pipeline", Min: 2, Max: 3, Types: []string{"option", "result"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), "gen.go", buf.Bytes(), 0)
+		if err != nil {
+			t.Fatalf("generated invalid source: %v", err)
+		}
+		if f.Name.Name != "pipeline" {
+			t.Errorf("expected package pipeline, got %s", f.Name.Name)
+		}
+
+		want := map[string]bool{"OptionMap2": false, "OptionMap3": false, "ResultMap2": false, "ResultMap3": false}
+		for name := range f.Scope.Objects {
+			if _, ok := want[name]; ok {
+				want[name] = true
+			}
+		}
+		for name, found := range want {
+			if !found {
+				t.Errorf("expected function %s to be generated", name)
+			}
+		}
+	})
+
+	t.Run("rejects unknown types", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := Generate(&buf, Config{Package: "pipeline", Min: 2, Max: 2, Types: []string{"either"}})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("rejects invalid arity range", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := Generate(&buf, Config{Package: "pipeline", Min: 3, Max: 2, Types: []string{"option"}})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
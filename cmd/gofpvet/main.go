@@ -0,0 +1,135 @@
+// Gofpvet reports calls to the gofp methods that panic, such as
+// [gofp.Result.Unwrap], outside of tests.
+//
+// Unwrap, UnwrapErr and UnwrapLeft panic when a container does not hold the
+// value asked for, so a call that is safe today may panic in production once
+// the code around it changes. Gofpvet flags every use of these methods on
+// [gofp.Option], [gofp.Result] and [gofp.Either], including method values,
+// so that they may be replaced with TryUnwrap, UnwrapOr or a Fold.
+//
+// Usage:
+//
+//	gofpvet [flags] [packages]
+//
+// It may also be run by go vet:
+//
+//	go vet -vettool=$(which gofpvet) ./...
+//
+// Uses in files ending in _test.go are not reported, since a panic there only
+// fails the test. A use that is known to be safe may be allowed by ending its
+// line with the comment:
+//
+//	//gofpvet:allow
+package main
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/analysis/singlechecker"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	gofpPath       = "github.com/tomasbasham/gofp"
+	allowDirective = "//gofpvet:allow"
+)
+
+// Analyzer reports uses of the gofp methods that panic.
+var Analyzer = &analysis.Analyzer{
+	Name:     "unwrap",
+	Doc:      "report uses of gofp methods that panic, such as Result.Unwrap, outside of tests",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// panicking holds the names of the panicking methods of each container.
+var panicking = map[string]map[string]bool{
+	"Option": {"Unwrap": true},
+	"Result": {"Unwrap": true, "UnwrapErr": true},
+	"Either": {"Unwrap": true, "UnwrapLeft": true},
+}
+
+func main() {
+	singlechecker.Main(Analyzer)
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	// The gofp package itself unwraps values only once it has checked that
+	// they are present.
+	if pass.Pkg.Path() == gofpPath {
+		return nil, nil
+	}
+
+	allowed := allowedLines(pass)
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	ins.Preorder([]ast.Node{(*ast.SelectorExpr)(nil)}, func(n ast.Node) {
+		sel := n.(*ast.SelectorExpr)
+		pos := pass.Fset.Position(sel.Sel.Pos())
+		if strings.HasSuffix(pos.Filename, "_test.go") || allowed[line{pos.Filename, pos.Line}] {
+			return
+		}
+
+		container, ok := panickingMethod(pass.TypesInfo, sel)
+		if !ok {
+			return
+		}
+		pass.Reportf(sel.Sel.Pos(), "%s.%s may panic; use TryUnwrap, UnwrapOr or a Fold instead", container, sel.Sel.Name)
+	})
+	return nil, nil
+}
+
+// panickingMethod reports whether sel selects a panicking method of a gofp
+// container, and if so the name of the container.
+func panickingMethod(info *types.Info, sel *ast.SelectorExpr) (string, bool) {
+	selection, ok := info.Selections[sel]
+	if !ok || selection.Kind() == types.FieldVal {
+		return "", false
+	}
+
+	fn, ok := selection.Obj().(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != gofpPath {
+		return "", false
+	}
+
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return "", false
+	}
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return "", false
+	}
+
+	container := named.Obj().Name()
+	return container, panicking[container][fn.Name()]
+}
+
+// allowedLines returns the lines of the package that end with the allow
+// directive.
+func allowedLines(pass *analysis.Pass) map[line]bool {
+	allowed := make(map[line]bool)
+	for _, f := range pass.Files {
+		for _, group := range f.Comments {
+			for _, c := range group.List {
+				if strings.TrimSpace(c.Text) == allowDirective {
+					pos := pass.Fset.Position(c.Pos())
+					allowed[line{pos.Filename, pos.Line}] = true
+				}
+			}
+		}
+	}
+	return allowed
+}
+
+type line struct {
+	filename string
+	number   int
+}
@@ -0,0 +1,33 @@
+package example
+
+import "github.com/tomasbasham/gofp"
+
+type wrapper struct {
+	Unwrap func() int
+}
+
+func calls() {
+	o := gofp.Some(1)
+	_ = o.Unwrap() // want `Option.Unwrap may panic`
+	_ = o.UnwrapOr(0)
+	_, _ = o.TryUnwrap()
+
+	r := gofp.Ok("a")
+	_ = r.Unwrap()    // want `Result.Unwrap may panic`
+	_ = r.UnwrapErr() // want `Result.UnwrapErr may panic`
+
+	var e gofp.Either[string, int]
+	_ = e.Unwrap()     // want `Either.Unwrap may panic`
+	_ = e.UnwrapLeft() // want `Either.UnwrapLeft may panic`
+
+	_ = r.Unwrap() //gofpvet:allow
+
+	w := wrapper{Unwrap: func() int { return 1 }}
+	_ = w.Unwrap()
+}
+
+func values() {
+	f := gofp.Ok(1).Unwrap          // want `Result.Unwrap may panic`
+	g := gofp.Option[string].Unwrap // want `Option.Unwrap may panic`
+	_, _ = f, g
+}
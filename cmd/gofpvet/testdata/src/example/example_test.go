@@ -0,0 +1,7 @@
+package example
+
+import "github.com/tomasbasham/gofp"
+
+func inTest() {
+	_ = gofp.Some(1).Unwrap()
+}
@@ -0,0 +1,26 @@
+// Package gofp is a stub of the gofp package holding the methods that
+// gofpvet inspects.
+package gofp
+
+type Option[T any] struct{ value T }
+
+func (o Option[T]) Unwrap() T            { return o.value }
+func (o Option[T]) UnwrapOr(def T) T     { return def }
+func (o Option[T]) TryUnwrap() (T, bool) { return o.value, true }
+
+type Result[T any] struct{ value T }
+
+func (r Result[T]) Unwrap() T        { return r.value }
+func (r Result[T]) UnwrapErr() error { return nil }
+
+type Either[T, U any] struct {
+	left  T
+	right U
+}
+
+func (e Either[T, U]) Unwrap() U     { return e.right }
+func (e Either[T, U]) UnwrapLeft() T { return e.left }
+
+func Some[T any](v T) Option[T] { return Option[T]{v} }
+
+func Ok[T any](v T) Result[T] { return Result[T]{v} }
@@ -0,0 +1,227 @@
+package gofp
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Code classifies an error into a category that can be handled
+// deterministically, for example when translating errors into HTTP or gRPC
+// responses. The canonical codes mirror those used by gRPC, but applications
+// may define their own.
+type Code string
+
+// Canonical error codes.
+const (
+	CodeOK                 Code = "OK"
+	CodeCanceled           Code = "CANCELED"
+	CodeUnknown            Code = "UNKNOWN"
+	CodeInvalidArgument    Code = "INVALID_ARGUMENT"
+	CodeDeadlineExceeded   Code = "DEADLINE_EXCEEDED"
+	CodeNotFound           Code = "NOT_FOUND"
+	CodeAlreadyExists      Code = "ALREADY_EXISTS"
+	CodePermissionDenied   Code = "PERMISSION_DENIED"
+	CodeResourceExhausted  Code = "RESOURCE_EXHAUSTED"
+	CodeFailedPrecondition Code = "FAILED_PRECONDITION"
+	CodeAborted            Code = "ABORTED"
+	CodeOutOfRange         Code = "OUT_OF_RANGE"
+	CodeUnimplemented      Code = "UNIMPLEMENTED"
+	CodeInternal           Code = "INTERNAL"
+	CodeUnavailable        Code = "UNAVAILABLE"
+	CodeDataLoss           Code = "DATA_LOSS"
+	CodeUnauthenticated    Code = "UNAUTHENTICATED"
+)
+
+// canonicalCodes lists the canonical codes indexed by their gRPC codes.
+var canonicalCodes = []Code{
+	CodeOK, CodeCanceled, CodeUnknown, CodeInvalidArgument,
+	CodeDeadlineExceeded, CodeNotFound, CodeAlreadyExists,
+	CodePermissionDenied, CodeResourceExhausted, CodeFailedPrecondition,
+	CodeAborted, CodeOutOfRange, CodeUnimplemented, CodeInternal,
+	CodeUnavailable, CodeDataLoss, CodeUnauthenticated,
+}
+
+type codeMapping struct {
+	http int
+	grpc uint32
+}
+
+var (
+	codesMu sync.RWMutex
+	codes   = map[Code]codeMapping{
+		CodeOK:                 {200, 0},
+		CodeCanceled:           {499, 1},
+		CodeUnknown:            {500, 2},
+		CodeInvalidArgument:    {400, 3},
+		CodeDeadlineExceeded:   {504, 4},
+		CodeNotFound:           {404, 5},
+		CodeAlreadyExists:      {409, 6},
+		CodePermissionDenied:   {403, 7},
+		CodeResourceExhausted:  {429, 8},
+		CodeFailedPrecondition: {400, 9},
+		CodeAborted:            {409, 10},
+		CodeOutOfRange:         {400, 11},
+		CodeUnimplemented:      {501, 12},
+		CodeInternal:           {500, 13},
+		CodeUnavailable:        {503, 14},
+		CodeDataLoss:           {500, 15},
+		CodeUnauthenticated:    {401, 16},
+	}
+)
+
+// RegisterCode registers the HTTP status and gRPC code that an application
+// defined [Code] maps to, replacing any existing mapping.
+func RegisterCode(code Code, httpStatus int, grpcCode uint32) {
+	codesMu.Lock()
+	defer codesMu.Unlock()
+	codes[code] = codeMapping{http: httpStatus, grpc: grpcCode}
+}
+
+func (c Code) mapping() codeMapping {
+	codesMu.RLock()
+	defer codesMu.RUnlock()
+	if m, ok := codes[c]; ok {
+		return m
+	}
+	return codes[CodeUnknown]
+}
+
+// HTTPStatus returns the HTTP status code that the [Code] maps to. Codes
+// without a mapping are treated as [CodeUnknown].
+func (c Code) HTTPStatus() int {
+	return c.mapping().http
+}
+
+// GRPCCode returns the numeric gRPC status code that the [Code] maps to. Codes
+// without a mapping are treated as [CodeUnknown].
+func (c Code) GRPCCode() uint32 {
+	return c.mapping().grpc
+}
+
+// httpCodes maps HTTP statuses to the canonical code preferred when
+// classifying a response, since several codes share some statuses.
+var httpCodes = map[int]Code{
+	400: CodeInvalidArgument,
+	401: CodeUnauthenticated,
+	403: CodePermissionDenied,
+	404: CodeNotFound,
+	409: CodeAlreadyExists,
+	429: CodeResourceExhausted,
+	499: CodeCanceled,
+	500: CodeInternal,
+	501: CodeUnimplemented,
+	503: CodeUnavailable,
+	504: CodeDeadlineExceeded,
+}
+
+// CodeFromHTTPStatus returns the canonical [Code] that best describes an HTTP
+// status, such as one received from a server. Successful statuses are
+// [CodeOK], and statuses without a canonical code are [CodeUnknown].
+// Application defined codes registered with [RegisterCode] are not returned.
+func CodeFromHTTPStatus(status int) Code {
+	if status >= 200 && status < 300 {
+		return CodeOK
+	}
+	if code, ok := httpCodes[status]; ok {
+		return code
+	}
+	return CodeUnknown
+}
+
+// CodeFromGRPCCode returns the canonical [Code] with the given numeric gRPC
+// status code, such as one received from a server. Numbers without a canonical
+// code are [CodeUnknown]. Application defined codes registered with
+// [RegisterCode] are not returned.
+func CodeFromGRPCCode(grpcCode uint32) Code {
+	if int(grpcCode) < len(canonicalCodes) {
+		return canonicalCodes[grpcCode]
+	}
+	return CodeUnknown
+}
+
+// CodedError is an error classified by a [Code]. It may carry a cause and
+// arbitrary metadata describing the error.
+type CodedError struct {
+	Code     Code
+	Message  string
+	Cause    error
+	Metadata map[string]string
+}
+
+// NewCodedError returns a [*CodedError] with the given code and message.
+func NewCodedError(code Code, message string) *CodedError {
+	return &CodedError{Code: code, Message: message}
+}
+
+// Errorf returns a [*CodedError] with the given code and a message formatted
+// according to a format specifier.
+func Errorf(code Code, format string, args ...any) *CodedError {
+	return NewCodedError(code, fmt.Sprintf(format, args...))
+}
+
+func (e *CodedError) Error() string {
+	switch {
+	case e.Message == "" && e.Cause == nil:
+		return string(e.Code)
+	case e.Message == "":
+		return fmt.Sprintf("%s: %v", e.Code, e.Cause)
+	case e.Cause == nil:
+		return fmt.Sprintf("%s: %s", e.Code, e.Message)
+	default:
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Cause)
+	}
+}
+
+// Unwrap returns the cause of the error.
+func (e *CodedError) Unwrap() error {
+	return e.Cause
+}
+
+// WithCause returns a copy of the [*CodedError] with the given cause.
+func (e *CodedError) WithCause(err error) *CodedError {
+	c := *e
+	c.Cause = err
+	return &c
+}
+
+// WithMetadata returns a copy of the [*CodedError] with the given metadata
+// entry added.
+func (e *CodedError) WithMetadata(key, value string) *CodedError {
+	c := *e
+	c.Metadata = make(map[string]string, len(e.Metadata)+1)
+	for k, v := range e.Metadata {
+		c.Metadata[k] = v
+	}
+	c.Metadata[key] = value
+	return &c
+}
+
+// CodeOf returns the [Code] of the first [*CodedError] in the error's tree. It
+// returns [CodeOK] for a nil error and [CodeUnknown] if the tree contains no
+// [*CodedError].
+func CodeOf(err error) Code {
+	if err == nil {
+		return CodeOK
+	}
+	var coded *CodedError
+	if errors.As(err, &coded) {
+		return coded.Code
+	}
+	return CodeUnknown
+}
+
+// WithCode classifies the error with the given [Code] if the [Result] is an
+// Err, preserving the stack trace. The original error becomes the cause of a
+// [*CodedError].
+func (r Result[T]) WithCode(code Code) Result[T] {
+	if !r.isErr {
+		return r
+	}
+	return Result[T]{
+		err:   &CodedError{Code: code, Cause: r.err},
+		isErr: true,
+		stack: r.stack,
+		meta:  r.meta,
+	}
+}
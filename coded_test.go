@@ -0,0 +1,125 @@
+package gofp_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestCodedError_Error(t *testing.T) {
+	cause := errors.New("connection refused")
+	tests := []struct {
+		name string
+		err  *gofp.CodedError
+		want string
+	}{
+		{"code only", &gofp.CodedError{Code: gofp.CodeInternal}, "INTERNAL"},
+		{"with message", gofp.NewCodedError(gofp.CodeNotFound, "user not found"), "NOT_FOUND: user not found"},
+		{"with cause", &gofp.CodedError{Code: gofp.CodeUnavailable, Cause: cause}, "UNAVAILABLE: connection refused"},
+		{"with message and cause", gofp.Errorf(gofp.CodeUnavailable, "dial %s", "db").WithCause(cause), "UNAVAILABLE: dial db: connection refused"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCodedError_WithMetadata(t *testing.T) {
+	base := gofp.NewCodedError(gofp.CodeNotFound, "user not found")
+	err := base.WithMetadata("id", "42")
+	if err.Metadata["id"] != "42" {
+		t.Errorf("expected metadata id=42, got %v", err.Metadata)
+	}
+	if base.Metadata != nil {
+		t.Errorf("expected original error to be unchanged, got %v", base.Metadata)
+	}
+}
+
+func TestCodeOf(t *testing.T) {
+	coded := gofp.NewCodedError(gofp.CodeNotFound, "user not found")
+	tests := []struct {
+		name string
+		err  error
+		want gofp.Code
+	}{
+		{"nil error", nil, gofp.CodeOK},
+		{"plain error", errors.New("failed"), gofp.CodeUnknown},
+		{"coded error", coded, gofp.CodeNotFound},
+		{"wrapped coded error", fmt.Errorf("lookup: %w", coded), gofp.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gofp.CodeOf(tt.err); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCode_Mapping(t *testing.T) {
+	if got := gofp.CodeNotFound.HTTPStatus(); got != 404 {
+		t.Errorf("expected 404, got %d", got)
+	}
+	if got := gofp.CodeNotFound.GRPCCode(); got != 5 {
+		t.Errorf("expected 5, got %d", got)
+	}
+
+	custom := gofp.Code("INSUFFICIENT_FUNDS")
+	if got := custom.HTTPStatus(); got != 500 {
+		t.Errorf("expected unmapped code to be 500, got %d", got)
+	}
+	gofp.RegisterCode(custom, 422, 9)
+	if got := custom.HTTPStatus(); got != 422 {
+		t.Errorf("expected 422, got %d", got)
+	}
+}
+
+func TestCodeFromHTTPStatus(t *testing.T) {
+	tests := map[int]gofp.Code{
+		200: gofp.CodeOK,
+		204: gofp.CodeOK,
+		400: gofp.CodeInvalidArgument,
+		404: gofp.CodeNotFound,
+		409: gofp.CodeAlreadyExists,
+		500: gofp.CodeInternal,
+		503: gofp.CodeUnavailable,
+		418: gofp.CodeUnknown,
+	}
+
+	for status, want := range tests {
+		if got := gofp.CodeFromHTTPStatus(status); got != want {
+			t.Errorf("expected %d to be %v, got %v", status, want, got)
+		}
+	}
+}
+
+func TestCodeFromGRPCCode(t *testing.T) {
+	for _, code := range []gofp.Code{gofp.CodeOK, gofp.CodeNotFound, gofp.CodeUnauthenticated} {
+		if got := gofp.CodeFromGRPCCode(code.GRPCCode()); got != code {
+			t.Errorf("expected %v, got %v", code, got)
+		}
+	}
+	if got := gofp.CodeFromGRPCCode(99); got != gofp.CodeUnknown {
+		t.Errorf("expected UNKNOWN, got %v", got)
+	}
+}
+
+func TestResult_WithCode(t *testing.T) {
+	cause := errors.New("no rows")
+	r := gofp.Err[int](cause).WithCode(gofp.CodeNotFound)
+	if gofp.CodeOf(r.UnwrapErr()) != gofp.CodeNotFound {
+		t.Errorf("expected NOT_FOUND, got %v", r.UnwrapErr())
+	}
+	if !errors.Is(r.UnwrapErr(), cause) {
+		t.Errorf("expected error to wrap %v", cause)
+	}
+
+	if got := gofp.Ok(1).WithCode(gofp.CodeNotFound); got.Unwrap() != 1 {
+		t.Errorf("expected Ok(1), got %v", got)
+	}
+}
@@ -0,0 +1,262 @@
+// Package consume processes messages from a queue with handlers that return a
+// [gofp.Result].
+//
+// A [Handler] returns Ok holding an [Ack] once it has dealt with a message, or
+// an Err if it could not. A [Consumer] decides what happens next from the
+// [gofp.Code] of the error: transient failures, such as [gofp.CodeUnavailable]
+// or an unclassified error, are retried with backoff, and permanent failures,
+// such as [gofp.CodeInvalidArgument], are sent straight to a dead letter
+// queue, as is a message whose handler still fails after every attempt.
+// Handlers are therefore written in terms of the same classified errors as the
+// rest of an application, with no retry or dead letter logic of their own.
+//
+// Processing a message is a [writer.Writer] computation whose output is an
+// audit log of each attempt and its outcome, so that the complete history of
+// a message may be recorded in one place.
+package consume
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/monoid"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+// Message is a message received from a queue.
+type Message struct {
+	ID         string
+	Body       []byte
+	Attributes map[string]string
+}
+
+// Ack describes how a [Handler] dealt with a message.
+type Ack int
+
+const (
+	// Done acknowledges a message that was processed.
+	Done Ack = iota
+
+	// Skip acknowledges a message that was deliberately not processed, such as
+	// a duplicate delivery.
+	Skip
+)
+
+// String returns the name of the [Ack].
+func (a Ack) String() string {
+	switch a {
+	case Done:
+		return "done"
+	case Skip:
+		return "skip"
+	default:
+		return fmt.Sprintf("Ack(%d)", int(a))
+	}
+}
+
+// Handler processes a message.
+type Handler func(context.Context, Message) gofp.Result[Ack]
+
+// DeadLetter sets aside a message that could not be processed, along with the
+// error returned by its final attempt.
+type DeadLetter func(context.Context, Message, error) error
+
+// Outcome is the result of processing a message, which determines how it is
+// settled with the queue.
+type Outcome int
+
+const (
+	// Acknowledged means that the handler returned an [Ack], and the message
+	// should be removed from the queue.
+	Acknowledged Outcome = iota
+
+	// DeadLettered means that the handler failed, and the message was sent to
+	// the dead letter queue, so should be removed from the queue.
+	DeadLettered
+
+	// Requeued means that the message was neither processed nor dead
+	// lettered, because processing was canceled or the dead letter queue
+	// failed, and it should be returned to the queue to be delivered again.
+	Requeued
+)
+
+// String returns the name of the [Outcome].
+func (o Outcome) String() string {
+	switch o {
+	case Acknowledged:
+		return "acknowledged"
+	case DeadLettered:
+		return "dead-lettered"
+	case Requeued:
+		return "requeued"
+	default:
+		return fmt.Sprintf("Outcome(%d)", int(o))
+	}
+}
+
+// Delivery is a message received from a queue that must be settled once it is
+// processed.
+type Delivery interface {
+	// Message returns the delivered message.
+	Message() Message
+
+	// Ack removes the message from the queue.
+	Ack() error
+
+	// Nack returns the message to the queue to be delivered again.
+	Nack() error
+}
+
+type config struct {
+	attempts   int
+	backoff    time.Duration
+	deadLetter DeadLetter
+	audit      func(Message, Outcome, []string)
+}
+
+// Option configures a [Consumer].
+type Option func(*config)
+
+// WithRetry sets the number of times a transient failure is attempted, and the
+// delay before the first retry, which doubles after each attempt. By default a
+// message is attempted 3 times, starting with a delay of 100ms.
+func WithRetry(attempts int, backoff time.Duration) Option {
+	return func(c *config) {
+		c.attempts = max(attempts, 1)
+		c.backoff = backoff
+	}
+}
+
+// WithDeadLetter sets where messages that cannot be processed are sent. By
+// default they are discarded.
+func WithDeadLetter(dl DeadLetter) Option {
+	return func(c *config) {
+		c.deadLetter = dl
+	}
+}
+
+// WithAudit sets a function called by [Consumer.Run] with the outcome and
+// audit log of each message it processes.
+func WithAudit(f func(Message, Outcome, []string)) Option {
+	return func(c *config) {
+		c.audit = f
+	}
+}
+
+// Consumer processes messages with a [Handler], retrying and dead lettering
+// them as necessary.
+type Consumer struct {
+	handler Handler
+	config  config
+}
+
+// New returns a [*Consumer] that processes messages with h.
+func New(h Handler, opts ...Option) *Consumer {
+	cfg := config{
+		attempts:   3,
+		backoff:    100 * time.Millisecond,
+		deadLetter: func(context.Context, Message, error) error { return nil },
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Consumer{handler: h, config: cfg}
+}
+
+// Process returns a [writer.Writer] computation that processes a message,
+// producing its [Outcome] along with an audit log of each attempt.
+//
+// An error whose code is [gofp.CodeUnknown], [gofp.CodeUnavailable],
+// [gofp.CodeResourceExhausted], [gofp.CodeDeadlineExceeded] or
+// [gofp.CodeAborted] is transient, and the message is attempted again until
+// the configured number of attempts is reached. Any other error is permanent.
+// A message that fails permanently, or on its final attempt, is dead lettered.
+func (c *Consumer) Process(ctx context.Context, msg Message) writer.Writer[[]string, Outcome] {
+	return writer.New(func() (Outcome, []string) {
+		var log []string
+		logf := func(format string, args ...any) {
+			log = append(log, fmt.Sprintf(format, args...))
+		}
+
+		var err error
+		for attempt := 1; ; attempt++ {
+			r := c.handler(ctx, msg)
+			if ack, ok := r.TryUnwrap(); ok {
+				logf("attempt %d: %s", attempt, ack)
+				return Acknowledged, log
+			}
+			err = r.UnwrapErr()
+			logf("attempt %d: %v", attempt, err)
+
+			if ctx.Err() != nil {
+				logf("canceled: %v", ctx.Err())
+				return Requeued, log
+			}
+
+			if !transient(err) || attempt >= c.config.attempts {
+				break
+			}
+
+			delay := c.config.backoff << (attempt - 1)
+			logf("retrying in %v", delay)
+			select {
+			case <-ctx.Done():
+				logf("canceled: %v", ctx.Err())
+				return Requeued, log
+			case <-time.After(delay):
+			}
+		}
+
+		if dlErr := c.config.deadLetter(ctx, msg, err); dlErr != nil {
+			logf("dead letter failed: %v", dlErr)
+			return Requeued, log
+		}
+		logf("dead-lettered")
+		return DeadLettered, log
+	}, monoid.Slice[string]{})
+}
+
+// Run processes deliveries until the channel is closed, in which case it
+// returns nil, or ctx is done, in which case it returns the error given by
+// [gofp.ContextError], such as [gofp.ErrCanceled]. Each delivery is
+// acknowledged or returned to the queue according to its [Outcome].
+func (c *Consumer) Run(ctx context.Context, deliveries <-chan Delivery) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return gofp.ContextError(ctx)
+		case d, ok := <-deliveries:
+			if !ok {
+				return nil
+			}
+			c.settle(ctx, d)
+		}
+	}
+}
+
+func (c *Consumer) settle(ctx context.Context, d Delivery) {
+	msg := d.Message()
+	outcome, log := c.Process(ctx, msg).Run()
+
+	settle := d.Ack
+	if outcome == Requeued {
+		settle = d.Nack
+	}
+	if err := settle(); err != nil {
+		log = append(log, fmt.Sprintf("settling as %s failed: %v", outcome, err))
+	}
+
+	if c.config.audit != nil {
+		c.config.audit(msg, outcome, log)
+	}
+}
+
+func transient(err error) bool {
+	switch gofp.CodeOf(err) {
+	case gofp.CodeUnknown, gofp.CodeUnavailable, gofp.CodeResourceExhausted, gofp.CodeDeadlineExceeded, gofp.CodeAborted:
+		return true
+	}
+	return false
+}
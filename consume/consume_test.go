@@ -0,0 +1,161 @@
+package consume_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/consume"
+)
+
+// failing returns a handler that fails with err the given number of times
+// before succeeding, and the number of times it was called.
+func failing(n int, err error) (consume.Handler, *int) {
+	calls := 0
+	return func(context.Context, consume.Message) gofp.Result[consume.Ack] {
+		calls++
+		if calls <= n {
+			return gofp.Err[consume.Ack](err)
+		}
+		return gofp.Ok(consume.Done)
+	}, &calls
+}
+
+func TestConsumer_Process(t *testing.T) {
+	ctx := context.Background()
+	msg := consume.Message{ID: "1"}
+	unavailable := gofp.NewCodedError(gofp.CodeUnavailable, "database down")
+
+	t.Run("acknowledges processed messages", func(t *testing.T) {
+		h, _ := failing(0, nil)
+		outcome, log := consume.New(h).Process(ctx, msg).Run()
+		if outcome != consume.Acknowledged || !slices.Equal(log, []string{"attempt 1: done"}) {
+			t.Errorf("expected acknowledged after 1 attempt, got %v %q", outcome, log)
+		}
+	})
+
+	t.Run("retries transient failures", func(t *testing.T) {
+		h, calls := failing(2, unavailable)
+		outcome, log := consume.New(h, consume.WithRetry(3, time.Millisecond)).Process(ctx, msg).Run()
+
+		want := []string{
+			"attempt 1: UNAVAILABLE: database down",
+			"retrying in 1ms",
+			"attempt 2: UNAVAILABLE: database down",
+			"retrying in 2ms",
+			"attempt 3: done",
+		}
+		if outcome != consume.Acknowledged || *calls != 3 || !slices.Equal(log, want) {
+			t.Errorf("expected acknowledged after 3 attempts, got %v %q", outcome, log)
+		}
+	})
+
+	t.Run("dead letters after every attempt fails", func(t *testing.T) {
+		var dead []error
+		dl := func(_ context.Context, _ consume.Message, err error) error {
+			dead = append(dead, err)
+			return nil
+		}
+		h, calls := failing(5, unavailable)
+		outcome, log := consume.New(h, consume.WithRetry(2, time.Millisecond), consume.WithDeadLetter(dl)).Process(ctx, msg).Run()
+
+		if outcome != consume.DeadLettered || *calls != 2 || len(dead) != 1 || log[len(log)-1] != "dead-lettered" {
+			t.Errorf("expected dead-lettered after 2 attempts, got %v %q", outcome, log)
+		}
+	})
+
+	t.Run("dead letters permanent failures immediately", func(t *testing.T) {
+		h, calls := failing(5, gofp.NewCodedError(gofp.CodeInvalidArgument, "malformed"))
+		outcome, _ := consume.New(h, consume.WithRetry(3, time.Millisecond)).Process(ctx, msg).Run()
+		if outcome != consume.DeadLettered || *calls != 1 {
+			t.Errorf("expected dead-lettered after 1 attempt, got %v after %d", outcome, *calls)
+		}
+	})
+
+	t.Run("requeues when dead lettering fails", func(t *testing.T) {
+		dl := func(context.Context, consume.Message, error) error { return errors.New("queue full") }
+		h, _ := failing(5, errors.New("boom"))
+		outcome, log := consume.New(h, consume.WithRetry(1, 0), consume.WithDeadLetter(dl)).Process(ctx, msg).Run()
+		if outcome != consume.Requeued || log[len(log)-1] != "dead letter failed: queue full" {
+			t.Errorf("expected requeued, got %v %q", outcome, log)
+		}
+	})
+
+	t.Run("requeues when canceled", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		h, _ := failing(5, unavailable)
+		outcome, _ := consume.New(h).Process(canceled, msg).Run()
+		if outcome != consume.Requeued {
+			t.Errorf("expected requeued, got %v", outcome)
+		}
+	})
+}
+
+type delivery struct {
+	msg     consume.Message
+	settled *[]string
+}
+
+func (d delivery) Message() consume.Message { return d.msg }
+
+func (d delivery) Ack() error {
+	*d.settled = append(*d.settled, "ack "+d.msg.ID)
+	return nil
+}
+
+func (d delivery) Nack() error {
+	*d.settled = append(*d.settled, "nack "+d.msg.ID)
+	return nil
+}
+
+func TestConsumer_Run(t *testing.T) {
+	handler := func(_ context.Context, m consume.Message) gofp.Result[consume.Ack] {
+		switch m.ID {
+		case "ok":
+			return gofp.Ok(consume.Done)
+		case "dup":
+			return gofp.Ok(consume.Skip)
+		default:
+			return gofp.Err[consume.Ack](gofp.NewCodedError(gofp.CodeInvalidArgument, "bad"))
+		}
+	}
+
+	var (
+		settled  []string
+		outcomes []consume.Outcome
+	)
+	audit := func(_ consume.Message, o consume.Outcome, _ []string) {
+		outcomes = append(outcomes, o)
+	}
+
+	deliveries := make(chan consume.Delivery, 3)
+	for _, id := range []string{"ok", "dup", "bad"} {
+		deliveries <- delivery{msg: consume.Message{ID: id}, settled: &settled}
+	}
+	close(deliveries)
+
+	if err := consume.New(handler, consume.WithAudit(audit)).Run(context.Background(), deliveries); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	if !slices.Equal(settled, []string{"ack ok", "ack dup", "ack bad"}) {
+		t.Errorf("expected every delivery to be acknowledged, got %q", settled)
+	}
+	want := []consume.Outcome{consume.Acknowledged, consume.Acknowledged, consume.DeadLettered}
+	if !slices.Equal(outcomes, want) {
+		t.Errorf("expected %v, got %v", want, outcomes)
+	}
+}
+
+func TestConsumer_RunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	h, _ := failing(0, nil)
+	if err := consume.New(h).Run(ctx, make(chan consume.Delivery)); !errors.Is(err, gofp.ErrCanceled) {
+		t.Errorf("expected gofp.ErrCanceled, got %v", err)
+	}
+}
@@ -0,0 +1,392 @@
+// Package dag executes graphs of dependent computations.
+//
+// A [Graph] is built by adding nodes, each of which is a computation returning
+// a [gofp.Result] and declaring the nodes whose values it depends on. Because a
+// node may only depend on nodes that were added before it, every graph is
+// acyclic by construction and the order in which nodes are added is a valid
+// topological order.
+//
+// Running a graph executes every node once all of its dependencies have
+// succeeded. If a dependency fails, the node is not run and its result is an
+// Err holding a [*DependencyError], so failures propagate to every dependent
+// node.
+//
+// Nodes created with [Source] hold values that may be changed between runs.
+// Running a graph with the [Incremental] option reuses the results of nodes
+// whose inputs are unchanged, so that after a source changes only the nodes
+// affected by it are recomputed.
+package dag
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"sync"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// DependencyError is held by the result of a node that was not run because one
+// of its dependencies failed.
+type DependencyError struct {
+	// Node is the name of the node that was not run.
+	Node string
+
+	// Dependency is the name of the dependency that failed.
+	Dependency string
+
+	// Err is the error held by the result of the dependency.
+	Err error
+}
+
+func (e *DependencyError) Error() string {
+	return fmt.Sprintf("%s: dependency %s failed: %v", e.Node, e.Dependency, e.Err)
+}
+
+// Unwrap returns the error held by the result of the dependency.
+func (e *DependencyError) Unwrap() error {
+	return e.Err
+}
+
+// Handle is implemented by every [Node], regardless of its value type, so that
+// nodes of different types may be declared as dependencies together.
+type Handle interface {
+	handle() (*Graph, int)
+}
+
+// Node is a typed handle to a node within a [Graph]. It is used to declare
+// dependencies, to read the values of dependencies with [Input] and to read
+// the results of a run with [Get].
+//
+// Type parameter T represents the value type of the node.
+type Node[T any] struct {
+	graph *Graph
+	index int
+	name  string
+}
+
+func (n Node[T]) handle() (*Graph, int) {
+	return n.graph, n.index
+}
+
+// Name returns the name of the node.
+func (n Node[T]) Name() string {
+	return n.name
+}
+
+// Inputs holds the values of the dependencies of a node while it runs.
+type Inputs struct {
+	node   string
+	values map[int]any
+}
+
+// Input returns the value of the dependency n. It panics if n was not declared
+// as a dependency of the running node.
+func Input[T any](in Inputs, n Node[T]) T {
+	v, ok := in.values[n.index]
+	if !ok {
+		panic(fmt.Sprintf("dag: %s is not a dependency of %s", n.name, in.node))
+	}
+	return v.(T)
+}
+
+type node struct {
+	name string
+	deps []int
+	run  func(context.Context, Inputs) gofp.Result[any]
+
+	// source is set for nodes created by [Source], whose value is provided
+	// directly rather than computed.
+	source gofp.Option[any]
+
+	// print formats the value of the node for fingerprinting in incremental
+	// runs. It is set by [SetFingerprint], and defaults to the %#v verb.
+	print func(any) string
+}
+
+type memo struct {
+	key    uint64
+	print  uint64
+	result gofp.Result[any]
+}
+
+// Graph is a directed acyclic graph of computations. Nodes are added with
+// [Add] and [Source]. A Graph must not be modified while it is running, but
+// may be run concurrently, including incrementally.
+type Graph struct {
+	nodes []node
+	names map[string]struct{}
+	seed  maphash.Seed
+
+	mu    sync.Mutex // guards cache
+	cache []gofp.Option[memo]
+}
+
+// New returns an empty [Graph].
+func New() *Graph {
+	return &Graph{
+		names: make(map[string]struct{}),
+		seed:  maphash.MakeSeed(),
+	}
+}
+
+// Add adds a node with the given name to the [Graph]. The node runs f once
+// every one of deps has succeeded, and f may read their values using [Input].
+// Add panics if the name is already in use, or if a dependency belongs to a
+// different graph.
+func Add[T any](g *Graph, name string, f func(context.Context, Inputs) gofp.Result[T], deps ...Handle) Node[T] {
+	return add[T](g, name, node{
+		name: name,
+		deps: g.resolve(name, deps),
+		run: func(ctx context.Context, in Inputs) gofp.Result[any] {
+			return gofp.ResultMap(f(ctx, in), func(v T) any { return v })
+		},
+	})
+}
+
+// Source adds a node with the given name to the [Graph] whose value is v. The
+// value of a source node may be changed between runs with [Set]. Source panics
+// if the name is already in use.
+func Source[T any](g *Graph, name string, v T) Node[T] {
+	return add[T](g, name, node{name: name, source: gofp.Some[any](v)})
+}
+
+// Set changes the value of a node created by [Source]. It panics if n was not
+// created by [Source].
+func Set[T any](n Node[T], v T) {
+	nd := &n.graph.nodes[n.index]
+	if nd.source.IsNone() {
+		panic(fmt.Sprintf("dag: %s is not a source node", n.name))
+	}
+	nd.source = gofp.Some[any](v)
+}
+
+// SetFingerprint sets the function used to identify the value of the node n in
+// incremental runs, replacing the default %#v formatting. Two values for which
+// f returns the same string are treated as equal, so nodes that depend on n
+// are not recomputed when its value changes to an equal one. It should be used
+// for values holding pointers, maps or other references whose targets change
+// between runs.
+func SetFingerprint[T any](n Node[T], f func(T) string) {
+	n.graph.nodes[n.index].print = func(v any) string { return f(v.(T)) }
+}
+
+func add[T any](g *Graph, name string, nd node) Node[T] {
+	if _, ok := g.names[name]; ok {
+		panic(fmt.Sprintf("dag: duplicate node %s", name))
+	}
+
+	n := Node[T]{graph: g, index: len(g.nodes), name: name}
+	g.names[name] = struct{}{}
+	g.nodes = append(g.nodes, nd)
+	g.cache = append(g.cache, gofp.None[memo]())
+	return n
+}
+
+func (g *Graph) resolve(name string, deps []Handle) []int {
+	ids := make([]int, len(deps))
+	for i, d := range deps {
+		owner, id := d.handle()
+		if owner != g {
+			panic(fmt.Sprintf("dag: dependency of %s belongs to another graph", name))
+		}
+		ids[i] = id
+	}
+	return ids
+}
+
+// Results holds the result of every node of a [Graph] after it has run.
+type Results struct {
+	names   []string
+	results []gofp.Result[any]
+	prints  []uint64
+	ran     []bool
+}
+
+// Get returns the result of the node n.
+func Get[T any](r *Results, n Node[T]) gofp.Result[T] {
+	return gofp.ResultMap(r.results[n.index], func(v any) T { return v.(T) })
+}
+
+// Computed returns the names of the nodes whose computations were run, in the
+// order they were added. Nodes whose results were reused from a previous
+// incremental run, source nodes and nodes skipped because a dependency failed
+// are not included.
+func (r *Results) Computed() []string {
+	var names []string
+	for i, ran := range r.ran {
+		if ran {
+			names = append(names, r.names[i])
+		}
+	}
+	return names
+}
+
+// Option configures how a [Graph] is run.
+type Option func(*config)
+
+type config struct {
+	parallelism int
+	incremental bool
+}
+
+// WithParallelism runs up to n nodes concurrently. Nodes are run one at a
+// time, in the order they were added, by default.
+func WithParallelism(n int) Option {
+	return func(c *config) {
+		c.parallelism = n
+	}
+}
+
+// Incremental reuses the results of nodes whose inputs have not changed since
+// the graph was last run incrementally. Each node is keyed by a hash of the
+// values of its dependencies, so changing a source with [Set] recomputes only
+// the nodes that depend on it, directly or indirectly, and whose inputs then
+// differ. Only Ok results are reused.
+//
+// Values are hashed using their Go-syntax representation, as formatted by the
+// %#v verb, so node computations must be pure. The %#v verb formats pointers
+// nested within a value as addresses, so a change to the target of such a
+// pointer is not detected, and dependent nodes are not recomputed. Nodes
+// whose values hold pointers should be given a fingerprint with
+// [SetFingerprint].
+func Incremental() Option {
+	return func(c *config) {
+		c.incremental = true
+	}
+}
+
+// Run executes every node of the [Graph] and returns their results. A node
+// that has not started when the context is done is not run, and its result is
+// an Err holding the error given by [gofp.ContextError].
+func (g *Graph) Run(ctx context.Context, opts ...Option) *Results {
+	cfg := config{parallelism: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	r := &Results{
+		names:   make([]string, len(g.nodes)),
+		results: make([]gofp.Result[any], len(g.nodes)),
+		prints:  make([]uint64, len(g.nodes)),
+		ran:     make([]bool, len(g.nodes)),
+	}
+	for i, n := range g.nodes {
+		r.names[i] = n.name
+	}
+
+	if cfg.parallelism <= 1 {
+		for i := range g.nodes {
+			g.runNode(ctx, i, r, cfg.incremental)
+		}
+		return r
+	}
+
+	done := make([]chan struct{}, len(g.nodes))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	sem := make(chan struct{}, cfg.parallelism)
+	var wg sync.WaitGroup
+	wg.Add(len(g.nodes))
+	for i, n := range g.nodes {
+		go func() {
+			defer wg.Done()
+			defer close(done[i])
+			for _, d := range n.deps {
+				<-done[d]
+			}
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+			}
+			g.runNode(ctx, i, r, cfg.incremental)
+		}()
+	}
+	wg.Wait()
+
+	return r
+}
+
+// runNode runs the node at index i, given that the results of all of its
+// dependencies are available, and records its result in r.
+func (g *Graph) runNode(ctx context.Context, i int, r *Results, incremental bool) {
+	n := g.nodes[i]
+	if v, ok := n.source.TryUnwrap(); ok {
+		r.results[i] = gofp.Ok(v)
+		if incremental {
+			r.prints[i] = g.fingerprint(n, v)
+		}
+		return
+	}
+
+	in := Inputs{node: n.name, values: make(map[int]any, len(n.deps))}
+	for _, d := range n.deps {
+		v, ok := r.results[d].TryUnwrap()
+		if !ok {
+			r.results[i] = gofp.Err[any](&DependencyError{
+				Node:       n.name,
+				Dependency: g.nodes[d].name,
+				Err:        r.results[d].UnwrapErr(),
+			})
+			return
+		}
+		in.values[d] = v
+	}
+
+	var key uint64
+	if incremental {
+		key = g.key(n, r)
+		if m, ok := g.memo(i).TryUnwrap(); ok && m.key == key {
+			r.results[i] = m.result
+			r.prints[i] = m.print
+			return
+		}
+	}
+
+	if err := gofp.ContextError(ctx); err != nil {
+		r.results[i] = gofp.Err[any](err)
+		return
+	}
+
+	res := n.run(ctx, in)
+	r.results[i] = res
+	r.ran[i] = true
+	if v, ok := res.TryUnwrap(); ok && incremental {
+		r.prints[i] = g.fingerprint(n, v)
+		g.setMemo(i, memo{key: key, print: r.prints[i], result: res})
+	}
+}
+
+func (g *Graph) memo(i int) gofp.Option[memo] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.cache[i]
+}
+
+func (g *Graph) setMemo(i int, m memo) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.cache[i] = gofp.Some(m)
+}
+
+// key hashes the fingerprints of the dependencies of the node n.
+func (g *Graph) key(n node, r *Results) uint64 {
+	var h maphash.Hash
+	h.SetSeed(g.seed)
+	for _, d := range n.deps {
+		binary.Write(&h, binary.LittleEndian, r.prints[d])
+	}
+	return h.Sum64()
+}
+
+func (g *Graph) fingerprint(n node, v any) uint64 {
+	if n.print != nil {
+		return maphash.String(g.seed, n.print(v))
+	}
+	return maphash.String(g.seed, fmt.Sprintf("%#v", v))
+}
@@ -0,0 +1,304 @@
+package dag_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/dag"
+)
+
+func value[T any](v T) func(context.Context, dag.Inputs) gofp.Result[T] {
+	return func(context.Context, dag.Inputs) gofp.Result[T] {
+		return gofp.Ok(v)
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("runs nodes in dependency order", func(t *testing.T) {
+		g := dag.New()
+		src := dag.Add(g, "source", value("main.go"))
+		flags := dag.Add(g, "flags", value([]string{"-O2"}))
+		obj := dag.Add(g, "compile", func(_ context.Context, in dag.Inputs) gofp.Result[string] {
+			s := dag.Input(in, src)
+			f := dag.Input(in, flags)
+			return gofp.Ok(strings.TrimSuffix(s, ".go") + ".o " + strings.Join(f, " "))
+		}, src, flags)
+
+		r := g.Run(context.Background())
+		if got := dag.Get(r, obj); got.Unwrap() != "main.o -O2" {
+			t.Errorf("expected 'main.o -O2', got %v", got)
+		}
+		if got := dag.Get(r, src); got.Unwrap() != "main.go" {
+			t.Errorf("expected main.go, got %v", got)
+		}
+	})
+
+	t.Run("propagates errors to dependents", func(t *testing.T) {
+		errSyntax := errors.New("syntax error")
+
+		g := dag.New()
+		compile := dag.Add(g, "compile", func(context.Context, dag.Inputs) gofp.Result[string] {
+			return gofp.Err[string](errSyntax)
+		})
+		ran := false
+		test := dag.Add(g, "test", func(context.Context, dag.Inputs) gofp.Result[int] {
+			ran = true
+			return gofp.Ok(0)
+		}, compile)
+		docs := dag.Add(g, "docs", value("docs"))
+		release := dag.Add(g, "release", value(true), test, docs)
+
+		r := g.Run(context.Background())
+		if ran {
+			t.Error("expected dependent node not to run")
+		}
+		if got := dag.Get(r, docs); got.IsErr() {
+			t.Errorf("expected independent node to succeed, got %v", got)
+		}
+
+		err := dag.Get(r, release).UnwrapErr()
+		if !errors.Is(err, errSyntax) {
+			t.Errorf("expected root cause to be preserved, got %v", err)
+		}
+
+		var depErr *dag.DependencyError
+		if !errors.As(err, &depErr) || depErr.Node != "release" || depErr.Dependency != "test" {
+			t.Errorf("expected release to report failed dependency test, got %v", err)
+		}
+	})
+
+	t.Run("does not run nodes once the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		g := dag.New()
+		first := dag.Add(g, "first", func(context.Context, dag.Inputs) gofp.Result[int] {
+			cancel()
+			return gofp.Ok(1)
+		})
+		second := dag.Add(g, "second", value(2))
+
+		r := g.Run(ctx)
+		if dag.Get(r, first).IsErr() {
+			t.Error("expected first node to succeed")
+		}
+		if err := dag.Get(r, second).UnwrapErr(); err != gofp.ErrCanceled {
+			t.Errorf("expected ErrCanceled, got %v", err)
+		}
+	})
+}
+
+func TestRun_Parallel(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		order   []string
+		running atomic.Int32
+		peak    atomic.Int32
+	)
+	work := func(name string) func(context.Context, dag.Inputs) gofp.Result[string] {
+		return func(context.Context, dag.Inputs) gofp.Result[string] {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			running.Add(-1)
+
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			return gofp.Ok(name)
+		}
+	}
+
+	g := dag.New()
+	a := dag.Add(g, "a", work("a"))
+	b := dag.Add(g, "b", work("b"))
+	c := dag.Add(g, "c", work("c"))
+	join := dag.Add(g, "join", work("join"), a, b, c)
+
+	r := g.Run(context.Background(), dag.WithParallelism(2))
+	if got := dag.Get(r, join); got.Unwrap() != "join" {
+		t.Errorf("expected join, got %v", got)
+	}
+	if got := peak.Load(); got != 2 {
+		t.Errorf("expected at most 2 nodes to run concurrently, got %d", got)
+	}
+	if !slices.Equal(order[3:], []string{"join"}) {
+		t.Errorf("expected join to run last, got %v", order)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	t.Run("panics on duplicate names", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected a panic")
+			}
+		}()
+		g := dag.New()
+		dag.Add(g, "a", value(1))
+		dag.Add(g, "a", value(2))
+	})
+
+	t.Run("panics on dependencies from another graph", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected a panic")
+			}
+		}()
+		other := dag.Add(dag.New(), "a", value(1))
+		dag.Add(dag.New(), "b", value(2), other)
+	})
+
+	t.Run("input panics for undeclared dependencies", func(t *testing.T) {
+		g := dag.New()
+		a := dag.Add(g, "a", value(1))
+		b := dag.Add(g, "b", func(_ context.Context, in dag.Inputs) (r gofp.Result[int]) {
+			defer func() {
+				if recover() != nil {
+					r = gofp.Err[int](errors.New("panicked"))
+				}
+			}()
+			return gofp.Ok(dag.Input(in, a))
+		})
+
+		if dag.Get(g.Run(context.Background()), b).IsOk() {
+			t.Error("expected a panic")
+		}
+	})
+}
+
+func TestRun_Incremental(t *testing.T) {
+	g := dag.New()
+	main := dag.Source(g, "main.go", "package main")
+	util := dag.Source(g, "util.go", "package util")
+
+	compile := func(src dag.Node[string]) func(context.Context, dag.Inputs) gofp.Result[int] {
+		return func(_ context.Context, in dag.Inputs) gofp.Result[int] {
+			return gofp.Ok(len(strings.TrimSpace(dag.Input(in, src))))
+		}
+	}
+	mainObj := dag.Add(g, "main.o", compile(main), main)
+	utilObj := dag.Add(g, "util.o", compile(util), util)
+	link := dag.Add(g, "link", func(_ context.Context, in dag.Inputs) gofp.Result[int] {
+		return gofp.Ok(dag.Input(in, mainObj) + dag.Input(in, utilObj))
+	}, mainObj, utilObj)
+
+	t.Run("computes every node on the first run", func(t *testing.T) {
+		r := g.Run(context.Background(), dag.Incremental())
+		if got := r.Computed(); !slices.Equal(got, []string{"main.o", "util.o", "link"}) {
+			t.Errorf("expected every node to be computed, got %v", got)
+		}
+		if got := dag.Get(r, link); got.Unwrap() != 24 {
+			t.Errorf("expected 24, got %v", got)
+		}
+	})
+
+	t.Run("reuses every node when nothing changed", func(t *testing.T) {
+		r := g.Run(context.Background(), dag.Incremental())
+		if got := r.Computed(); len(got) != 0 {
+			t.Errorf("expected no nodes to be computed, got %v", got)
+		}
+		if got := dag.Get(r, link); got.Unwrap() != 24 {
+			t.Errorf("expected 24, got %v", got)
+		}
+	})
+
+	t.Run("recomputes only affected nodes", func(t *testing.T) {
+		dag.Set(util, "package util // v2")
+		r := g.Run(context.Background(), dag.Incremental())
+		if got := r.Computed(); !slices.Equal(got, []string{"util.o", "link"}) {
+			t.Errorf("expected util.o and link to be computed, got %v", got)
+		}
+		if got := dag.Get(r, link); got.Unwrap() != 30 {
+			t.Errorf("expected 30, got %v", got)
+		}
+	})
+
+	t.Run("stops when a recomputed value is unchanged", func(t *testing.T) {
+		dag.Set(main, "package main  ")
+		r := g.Run(context.Background(), dag.Incremental(), dag.WithParallelism(2))
+		if got := r.Computed(); !slices.Equal(got, []string{"main.o"}) {
+			t.Errorf("expected only main.o to be computed, got %v", got)
+		}
+	})
+
+	t.Run("recomputes everything when not incremental", func(t *testing.T) {
+		r := g.Run(context.Background())
+		if got := r.Computed(); len(got) != 3 {
+			t.Errorf("expected every node to be computed, got %v", got)
+		}
+	})
+
+	t.Run("set panics for computed nodes", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected a panic")
+			}
+		}()
+		dag.Set(link, 0)
+	})
+}
+
+func TestRun_IncrementalConcurrent(t *testing.T) {
+	g := dag.New()
+	src := dag.Source(g, "src", 20)
+	double := dag.Add(g, "double", func(_ context.Context, in dag.Inputs) gofp.Result[int] {
+		return gofp.Ok(2 * dag.Input(in, src))
+	}, src)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := g.Run(context.Background(), dag.Incremental())
+			if got := dag.Get(r, double); got.Unwrap() != 40 {
+				t.Errorf("expected 40, got %v", got)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSetFingerprint(t *testing.T) {
+	type config struct{ Name string }
+
+	g := dag.New()
+	c := &config{Name: "a"}
+	cfg := dag.Source(g, "config", c)
+	dag.SetFingerprint(cfg, func(c *config) string { return c.Name })
+	greet := dag.Add(g, "greet", func(_ context.Context, in dag.Inputs) gofp.Result[string] {
+		return gofp.Ok("hello " + dag.Input(in, cfg).Name)
+	}, cfg)
+
+	g.Run(context.Background(), dag.Incremental())
+
+	t.Run("recomputes nodes when the target of a pointer changes", func(t *testing.T) {
+		c.Name = "b"
+		r := g.Run(context.Background(), dag.Incremental())
+		if got := r.Computed(); !slices.Equal(got, []string{"greet"}) {
+			t.Errorf("expected greet to be computed, got %v", got)
+		}
+		if got := dag.Get(r, greet); got.Unwrap() != "hello b" {
+			t.Errorf("expected hello b, got %v", got)
+		}
+	})
+
+	t.Run("reuses nodes when the fingerprint is unchanged", func(t *testing.T) {
+		dag.Set(cfg, &config{Name: "b"})
+		r := g.Run(context.Background(), dag.Incremental())
+		if got := r.Computed(); len(got) != 0 {
+			t.Errorf("expected no nodes to be computed, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package dag_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/dag"
+)
+
+func ExampleGraph_Run() {
+	g := dag.New()
+	source := dag.Add(g, "source", func(context.Context, dag.Inputs) gofp.Result[string] {
+		return gofp.Ok("main.go")
+	})
+	compile := dag.Add(g, "compile", func(_ context.Context, in dag.Inputs) gofp.Result[string] {
+		return gofp.Ok(strings.Replace(dag.Input(in, source), ".go", ".o", 1))
+	}, source)
+	test := dag.Add(g, "test", func(_ context.Context, in dag.Inputs) gofp.Result[int] {
+		return gofp.Err[int](fmt.Errorf("tests failed for %s", dag.Input(in, compile)))
+	}, compile)
+	release := dag.Add(g, "release", func(context.Context, dag.Inputs) gofp.Result[string] {
+		return gofp.Ok("v1.0.0")
+	}, compile, test)
+
+	r := g.Run(context.Background(), dag.WithParallelism(4))
+	fmt.Println(dag.Get(r, compile))
+	fmt.Println(dag.Get(r, release))
+	// Output:
+	// Ok(main.o)
+	// Err(release: dependency test failed: tests failed for main.o)
+}
@@ -0,0 +1,42 @@
+package gofp
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Default is implemented by types that provide their own default value. The
+// method is called on the zero value of the type.
+//
+// Type parameter T represents the value type.
+type Default[T any] interface {
+	Default() T
+}
+
+var defaults sync.Map // map[reflect.Type]func() any
+
+// RegisterDefault registers a function that provides the default value for
+// type T, replacing any previously registered function. Registered defaults
+// take precedence over those provided by the [Default] interface.
+func RegisterDefault[T any](fn func() T) {
+	defaults.Store(typeOf[T](), func() any { return fn() })
+}
+
+// DefaultOf returns the default value for type T. It returns the registered
+// default if one exists, otherwise the value provided by the [Default] interface
+// if T implements it, otherwise the zero value of T.
+func DefaultOf[T any]() T {
+	if fn, ok := defaults.Load(typeOf[T]()); ok {
+		return fn.(func() any)().(T)
+	}
+
+	var zero T
+	if d, ok := any(zero).(Default[T]); ok {
+		return d.Default()
+	}
+	return zero
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
@@ -0,0 +1,55 @@
+package gofp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+type port int
+
+func (port) Default() port {
+	return 8080
+}
+
+type timeout int
+
+func TestDefaultOf(t *testing.T) {
+	t.Run("returns zero value", func(t *testing.T) {
+		if got := gofp.DefaultOf[string](); got != "" {
+			t.Errorf("expected empty string, got %q", got)
+		}
+	})
+
+	t.Run("returns value from Default interface", func(t *testing.T) {
+		if got := gofp.DefaultOf[port](); got != 8080 {
+			t.Errorf("expected 8080, got %d", got)
+		}
+	})
+
+	t.Run("returns registered value", func(t *testing.T) {
+		gofp.RegisterDefault(func() timeout { return 30 })
+		if got := gofp.DefaultOf[timeout](); got != 30 {
+			t.Errorf("expected 30, got %d", got)
+		}
+	})
+}
+
+func TestOption_UnwrapOrDefault(t *testing.T) {
+	if got := gofp.Some[port](80).UnwrapOrDefault(); got != 80 {
+		t.Errorf("expected 80, got %d", got)
+	}
+	if got := gofp.None[port]().UnwrapOrDefault(); got != 8080 {
+		t.Errorf("expected 8080, got %d", got)
+	}
+}
+
+func TestResult_UnwrapOrDefault(t *testing.T) {
+	if got := gofp.Ok[port](80).UnwrapOrDefault(); got != 80 {
+		t.Errorf("expected 80, got %d", got)
+	}
+	if got := gofp.Err[port](errors.New("error")).UnwrapOrDefault(); got != 8080 {
+		t.Errorf("expected 8080, got %d", got)
+	}
+}
@@ -147,6 +147,42 @@ func EitherSequence[T, U any](eithers []Either[T, U]) Either[T, []U] {
 	return values
 }
 
+// EitherSequenceAll transforms a slice of [Either] values into a single
+// [Either] of a slice. Unlike [EitherSequence], it does not stop at the first
+// Left. If any value is Left, it returns Left with a slice of all left values,
+// preserving order.
+func EitherSequenceAll[T, U any](eithers []Either[T, U]) Either[[]T, []U] {
+	var lefts []T
+	rights := make([]U, 0, len(eithers))
+	for _, e := range eithers {
+		if e.isLeft {
+			lefts = append(lefts, e.left)
+			continue
+		}
+		rights = append(rights, e.right)
+	}
+	if len(lefts) > 0 {
+		return Left[[]T, []U](lefts)
+	}
+	return Right[[]T](rights)
+}
+
+// EitherSequenceMapKeyed partitions a map of [Either] values by key, returning
+// the right values and the left values, each under its original key. Both
+// maps are non-nil.
+func EitherSequenceMapKeyed[K comparable, T, U any](eithers map[K]Either[T, U]) (map[K]U, map[K]T) {
+	rights := make(map[K]U, len(eithers))
+	lefts := make(map[K]T)
+	for k, e := range eithers {
+		if e.isLeft {
+			lefts[k] = e.left
+			continue
+		}
+		rights[k] = e.right
+	}
+	return rights, lefts
+}
+
 // EitherFold applies one of the two functions to the value of the [Either]
 // depending on whether it is Left or Right.
 func EitherFold[T, U, R any](e Either[T, U], left func(T) R, right func(U) R) R {
@@ -156,11 +192,31 @@ func EitherFold[T, U, R any](e Either[T, U], left func(T) R, right func(U) R) R
 	return right(e.right)
 }
 
+// EitherVisitor consumes an [Either] by handling each of its cases. Since a
+// type must implement both methods to satisfy the interface, a visitor passed
+// to [EitherVisit] is checked at compile time to handle both Left and Right,
+// offering the same totality guarantee as [EitherFold] without closures.
+//
+// Type parameter R represents the type returned by the visitor.
+type EitherVisitor[T, U, R any] interface {
+	VisitLeft(T) R
+	VisitRight(U) R
+}
+
+// EitherVisit calls the method of the visitor corresponding to whether the
+// [Either] is Left or Right, and returns its result.
+func EitherVisit[T, U, R any](e Either[T, U], v EitherVisitor[T, U, R]) R {
+	if e.isLeft {
+		return v.VisitLeft(e.left)
+	}
+	return v.VisitRight(e.right)
+}
+
 func (e Either[T, U]) String() string {
 	if e.isLeft {
-		return fmt.Sprintf("Left(%v)", e.left)
+		return fmt.Sprintf("Left(%s)", DebugString(e.left))
 	}
-	return fmt.Sprintf("Right(%v)", e.right)
+	return fmt.Sprintf("Right(%s)", DebugString(e.right))
 }
 
 // IsLeft returns true if the [Either] is Left.
@@ -3,6 +3,7 @@ package gofp_test
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"testing"
 
 	"github.com/tomasbasham/gofp"
@@ -293,6 +294,44 @@ func TestEither_FlatMapLeft(t *testing.T) {
 	})
 }
 
+func TestEitherSequenceMapKeyed(t *testing.T) {
+	rights, lefts := gofp.EitherSequenceMapKeyed(map[int]gofp.Either[string, int]{
+		1: gofp.Right[string](10),
+		2: gofp.Left[string, int]("invalid"),
+		3: gofp.Right[string](30),
+	})
+
+	if want := map[int]int{1: 10, 3: 30}; !maps.Equal(rights, want) {
+		t.Errorf("expected rights %v, got %v", want, rights)
+	}
+	if want := map[int]string{2: "invalid"}; !maps.Equal(lefts, want) {
+		t.Errorf("expected lefts %v, got %v", want, lefts)
+	}
+}
+
+func TestEitherSequenceAll(t *testing.T) {
+	t.Run("collects all Right values", func(t *testing.T) {
+		got := gofp.EitherSequenceAll([]gofp.Either[string, int]{
+			gofp.Right[string](1),
+			gofp.Right[string](2),
+		})
+		if fmt.Sprint(got.Unwrap()) != "[1 2]" {
+			t.Errorf("expected [1 2], got %v", got)
+		}
+	})
+
+	t.Run("collects all Left values", func(t *testing.T) {
+		got := gofp.EitherSequenceAll([]gofp.Either[string, int]{
+			gofp.Left[string, int]("a"),
+			gofp.Right[string](1),
+			gofp.Left[string, int]("b"),
+		})
+		if fmt.Sprint(got.UnwrapLeft()) != "[a b]" {
+			t.Errorf("expected [a b], got %v", got)
+		}
+	})
+}
+
 func TestEither_String(t *testing.T) {
 	t.Run("formats Left value", func(t *testing.T) {
 		e := gofp.Left[string, int]("test")
@@ -404,3 +443,29 @@ func TestEither_Swap(t *testing.T) {
 		}
 	})
 }
+
+type describer struct{}
+
+func (describer) VisitLeft(s string) string {
+	return "left " + s
+}
+
+func (describer) VisitRight(n int) string {
+	return fmt.Sprintf("right %d", n)
+}
+
+func TestEitherVisit(t *testing.T) {
+	t.Run("visits Left", func(t *testing.T) {
+		got := gofp.EitherVisit(gofp.Left[string, int]("test"), describer{})
+		if got != "left test" {
+			t.Errorf("expected left test, got %v", got)
+		}
+	})
+
+	t.Run("visits Right", func(t *testing.T) {
+		got := gofp.EitherVisit(gofp.Right[string](42), describer{})
+		if got != "right 42" {
+			t.Errorf("expected right 42, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,152 @@
+// Package eitherenc encodes [gofp.Either] values as JSON tagged with the type
+// of the value they hold.
+//
+// An Either is encoded as an envelope holding a discriminator, which names the
+// type of its value, alongside the value itself:
+//
+//	{"type":"order.placed","value":{"id":"A1","total":42}}
+//
+// Tags are assigned to types with a [Registry] rather than derived from Go
+// type names, so that stored data survives types being renamed or moved
+// between packages, and may be read by services written in other languages.
+// As a schema evolves, a tag that is no longer written may be registered with
+// [Upgrade] to convert values encoded with an old type into the current one.
+//
+// Whether a decoded value is held as a Left or a Right is determined by its
+// type, so an Either whose sides may hold the same type cannot be decoded.
+package eitherenc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/tomasbasham/gofp"
+)
+
+var (
+	// ErrUnregistered is returned when encoding a value whose type has no tag.
+	ErrUnregistered = errors.New("type not registered")
+
+	// ErrUnknownTag is returned when decoding a value whose tag has not been
+	// registered.
+	ErrUnknownTag = errors.New("unknown tag")
+
+	// ErrMismatch is returned when decoding a value whose type may be held by
+	// neither, or both, sides of the Either.
+	ErrMismatch = errors.New("type does not match either side")
+)
+
+// envelope is the encoding of an Either.
+type envelope struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Registry maps tags to the types of the values they identify. Types are
+// registered with [Register] and [Upgrade], which must not be called
+// concurrently with encoding or decoding.
+type Registry struct {
+	decoders map[string]func(json.RawMessage) (any, error)
+	tags     map[reflect.Type]string
+}
+
+// NewRegistry returns an empty [*Registry].
+func NewRegistry() *Registry {
+	return &Registry{
+		decoders: make(map[string]func(json.RawMessage) (any, error)),
+		tags:     make(map[reflect.Type]string),
+	}
+}
+
+// Register assigns the tag to values of type T, which are encoded with that
+// tag and decoded into a T. It panics if the tag or type is already
+// registered.
+func Register[T any](r *Registry, tag string) {
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() == reflect.Interface {
+		panic(fmt.Sprintf("eitherenc: cannot register interface type %v", typ))
+	}
+	if existing, ok := r.tags[typ]; ok {
+		panic(fmt.Sprintf("eitherenc: type %v already registered as %q", typ, existing))
+	}
+	r.addDecoder(tag, func(data json.RawMessage) (any, error) {
+		var v T
+		err := json.Unmarshal(data, &v)
+		return v, err
+	})
+	r.tags[typ] = tag
+}
+
+// Upgrade registers a tag that is decoded but no longer encoded. Values with
+// the tag are decoded into an Old and converted by upgrade into a New, whose
+// type should itself be registered under its current tag. It panics if the tag
+// is already registered.
+func Upgrade[Old, New any](r *Registry, tag string, upgrade func(Old) New) {
+	r.addDecoder(tag, func(data json.RawMessage) (any, error) {
+		var old Old
+		if err := json.Unmarshal(data, &old); err != nil {
+			return nil, err
+		}
+		return upgrade(old), nil
+	})
+}
+
+func (r *Registry) addDecoder(tag string, decode func(json.RawMessage) (any, error)) {
+	if _, ok := r.decoders[tag]; ok {
+		panic(fmt.Sprintf("eitherenc: tag %q already registered", tag))
+	}
+	r.decoders[tag] = decode
+}
+
+// Marshal encodes an Either as JSON, tagged with the tag registered for the
+// dynamic type of the value it holds. The result is an Err holding
+// [ErrUnregistered] if the type has no tag.
+func Marshal[A, B any](r *Registry, e gofp.Either[A, B]) gofp.Result[[]byte] {
+	v := gofp.EitherFold(e,
+		func(a A) any { return a },
+		func(b B) any { return b },
+	)
+
+	tag, ok := r.tags[reflect.TypeOf(v)]
+	if !ok {
+		return gofp.Err[[]byte](fmt.Errorf("%w: %T", ErrUnregistered, v))
+	}
+	value, err := json.Marshal(v)
+	if err != nil {
+		return gofp.Err[[]byte](err)
+	}
+	return gofp.FromReturn(json.Marshal(envelope{Type: tag, Value: value}))
+}
+
+// Unmarshal decodes an Either encoded by [Marshal]. The value is decoded into
+// the type registered for its tag, and held as a Left if that type may be held
+// by A, or a Right if it may be held by B. The result is an Err holding
+// [ErrUnknownTag] if the tag is not registered, or [ErrMismatch] if the type
+// may be held by neither or both sides.
+func Unmarshal[A, B any](r *Registry, data []byte) gofp.Result[gofp.Either[A, B]] {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return gofp.Err[gofp.Either[A, B]](err)
+	}
+
+	decode, ok := r.decoders[env.Type]
+	if !ok {
+		return gofp.Err[gofp.Either[A, B]](fmt.Errorf("%w %q", ErrUnknownTag, env.Type))
+	}
+	v, err := decode(env.Value)
+	if err != nil {
+		return gofp.Err[gofp.Either[A, B]](fmt.Errorf("decoding %q: %w", env.Type, err))
+	}
+
+	a, isLeft := v.(A)
+	b, isRight := v.(B)
+	switch {
+	case isLeft && !isRight:
+		return gofp.Ok(gofp.Left[A, B](a))
+	case isRight && !isLeft:
+		return gofp.Ok(gofp.Right[A](b))
+	}
+	return gofp.Err[gofp.Either[A, B]](fmt.Errorf("%w: %T", ErrMismatch, v))
+}
@@ -0,0 +1,126 @@
+package eitherenc_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+	"github.com/tomasbasham/gofp/eitherenc"
+)
+
+type Event interface {
+	event()
+}
+
+type Placed struct {
+	ID    string `json:"id"`
+	Total int    `json:"total"`
+}
+
+type Cancelled struct {
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+type Failure struct {
+	Message string `json:"message"`
+}
+
+func (Placed) event()    {}
+func (Cancelled) event() {}
+
+// PlacedV1 is an older version of Placed whose total was a string.
+type PlacedV1 struct {
+	ID    string `json:"id"`
+	Total string `json:"total"`
+}
+
+func registry() *eitherenc.Registry {
+	r := eitherenc.NewRegistry()
+	eitherenc.Register[Placed](r, "order.placed.v2")
+	eitherenc.Register[Cancelled](r, "order.cancelled")
+	eitherenc.Register[Failure](r, "failure")
+	eitherenc.Upgrade(r, "order.placed", func(old PlacedV1) Placed {
+		return Placed{ID: old.ID, Total: len(old.Total)}
+	})
+	return r
+}
+
+func TestMarshal(t *testing.T) {
+	r := registry()
+
+	t.Run("tags the value", func(t *testing.T) {
+		got := assert.Ok(t, eitherenc.Marshal(r, gofp.Right[Failure, Event](Placed{"A1", 42})))
+		want := `{"type":"order.placed.v2","value":{"id":"A1","total":42}}`
+		if string(got) != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	})
+
+	t.Run("returns ErrUnregistered for an unregistered type", func(t *testing.T) {
+		assert.ErrIs(t, eitherenc.Marshal(r, gofp.Left[string, Event]("oops")), eitherenc.ErrUnregistered)
+	})
+}
+
+func TestUnmarshal(t *testing.T) {
+	r := registry()
+
+	t.Run("round trips both sides", func(t *testing.T) {
+		for _, e := range []gofp.Either[Failure, Event]{
+			gofp.Right[Failure, Event](Placed{"A1", 42}),
+			gofp.Right[Failure, Event](Cancelled{"A1", "changed mind"}),
+			gofp.Left[Failure, Event](Failure{"out of stock"}),
+		} {
+			data := assert.Ok(t, eitherenc.Marshal(r, e))
+			got := assert.Ok(t, eitherenc.Unmarshal[Failure, Event](r, data))
+			if got != e {
+				t.Errorf("expected %v, got %v", e, got)
+			}
+		}
+	})
+
+	t.Run("upgrades old tags", func(t *testing.T) {
+		data := []byte(`{"type":"order.placed","value":{"id":"A1","total":"xxx"}}`)
+		got := assert.Ok(t, eitherenc.Unmarshal[Failure, Event](r, data))
+		assert.RightEqual[Failure, Event](t, got, Placed{"A1", 3})
+	})
+
+	t.Run("returns ErrUnknownTag for an unregistered tag", func(t *testing.T) {
+		data := []byte(`{"type":"order.shipped","value":{}}`)
+		assert.ErrIs(t, eitherenc.Unmarshal[Failure, Event](r, data), eitherenc.ErrUnknownTag)
+	})
+
+	t.Run("returns ErrMismatch when neither side holds the type", func(t *testing.T) {
+		data := []byte(`{"type":"failure","value":{"message":"x"}}`)
+		assert.ErrIs(t, eitherenc.Unmarshal[string, Event](r, data), eitherenc.ErrMismatch)
+	})
+
+	t.Run("returns ErrMismatch when both sides hold the type", func(t *testing.T) {
+		data := []byte(`{"type":"failure","value":{"message":"x"}}`)
+		assert.ErrIs(t, eitherenc.Unmarshal[Failure, any](r, data), eitherenc.ErrMismatch)
+	})
+
+	t.Run("returns Err for a malformed value", func(t *testing.T) {
+		data := []byte(`{"type":"order.placed.v2","value":{"total":"x"}}`)
+		assert.Err(t, eitherenc.Unmarshal[Failure, Event](r, data))
+	})
+}
+
+func TestRegister(t *testing.T) {
+	tests := map[string]func(*eitherenc.Registry){
+		"duplicate tag":  func(r *eitherenc.Registry) { eitherenc.Register[Failure](r, "order.cancelled") },
+		"duplicate type": func(r *eitherenc.Registry) { eitherenc.Register[Placed](r, "order.placed.v3") },
+		"interface":      func(r *eitherenc.Registry) { eitherenc.Register[Event](r, "event") },
+	}
+
+	for name, register := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+			register(registry())
+		})
+	}
+}
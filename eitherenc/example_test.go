@@ -0,0 +1,30 @@
+package eitherenc_test
+
+import (
+	"fmt"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/eitherenc"
+)
+
+func ExampleMarshal() {
+	type Rejected struct {
+		Reason string `json:"reason"`
+	}
+	type Accepted struct {
+		ID string `json:"id"`
+	}
+
+	r := eitherenc.NewRegistry()
+	eitherenc.Register[Rejected](r, "payment.rejected")
+	eitherenc.Register[Accepted](r, "payment.accepted")
+
+	data := eitherenc.Marshal(r, gofp.Left[Rejected, Accepted](Rejected{"card declined"})).Unwrap()
+	fmt.Println(string(data))
+
+	decoded := eitherenc.Unmarshal[Rejected, Accepted](r, data).Unwrap()
+	fmt.Println(decoded)
+	// Output:
+	// {"type":"payment.rejected","value":{"reason":"card declined"}}
+	// Left({card declined})
+}
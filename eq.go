@@ -0,0 +1,89 @@
+package gofp
+
+import "hash/maphash"
+
+// Eq is a type class for types whose values can be compared for equality. It
+// allows types that are not comparable, such as slices or structs containing
+// slices, to be used where equality is required.
+//
+// Type parameter T represents the value type.
+type Eq[T any] interface {
+	Equal(a, b T) bool
+}
+
+// Hash is a type class for types whose values can be hashed. Values that are
+// equal must produce the same hash.
+//
+// Type parameter T represents the value type.
+type Hash[T any] interface {
+	Eq[T]
+	Hash(T) uint64
+}
+
+// EqFunc is an adapter that allows the use of an ordinary function as an [Eq]
+// instance.
+type EqFunc[T any] func(a, b T) bool
+
+// Equal returns f(a, b).
+func (f EqFunc[T]) Equal(a, b T) bool {
+	return f(a, b)
+}
+
+var seed = maphash.MakeSeed()
+
+type comparableHash[T comparable] struct{}
+
+func (comparableHash[T]) Equal(a, b T) bool {
+	return a == b
+}
+
+func (comparableHash[T]) Hash(v T) uint64 {
+	return maphash.Comparable(seed, v)
+}
+
+// EqComparable returns an [Eq] instance for a comparable type using the ==
+// operator.
+func EqComparable[T comparable]() Eq[T] {
+	return comparableHash[T]{}
+}
+
+// HashComparable returns a [Hash] instance for a comparable type using the ==
+// operator.
+func HashComparable[T comparable]() Hash[T] {
+	return comparableHash[T]{}
+}
+
+type hashBy[T any, K comparable] struct {
+	key func(T) K
+}
+
+func (h hashBy[T, K]) Equal(a, b T) bool {
+	return h.key(a) == h.key(b)
+}
+
+func (h hashBy[T, K]) Hash(v T) uint64 {
+	return maphash.Comparable(seed, h.key(v))
+}
+
+// EqBy returns an [Eq] instance that compares values by the comparable key
+// produced by the given function.
+func EqBy[T any, K comparable](key func(T) K) Eq[T] {
+	return hashBy[T, K]{key: key}
+}
+
+// HashBy returns a [Hash] instance that compares and hashes values by the
+// comparable key produced by the given function.
+func HashBy[T any, K comparable](key func(T) K) Hash[T] {
+	return hashBy[T, K]{key: key}
+}
+
+// Contains reports whether a slice contains a value according to the given
+// [Eq] instance.
+func Contains[T any](xs []T, x T, eq Eq[T]) bool {
+	for _, v := range xs {
+		if eq.Equal(v, x) {
+			return true
+		}
+	}
+	return false
+}
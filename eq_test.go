@@ -0,0 +1,56 @@
+package gofp_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestHashComparable(t *testing.T) {
+	h := gofp.HashComparable[string]()
+	if !h.Equal("a", "a") || h.Equal("a", "b") {
+		t.Error("expected equality by ==")
+	}
+	if h.Hash("a") != h.Hash("a") {
+		t.Error("expected equal values to have equal hashes")
+	}
+}
+
+func TestHashBy(t *testing.T) {
+	type user struct {
+		ID    int
+		Roles []string
+	}
+
+	h := gofp.HashBy(func(u user) int { return u.ID })
+	a := user{ID: 1, Roles: []string{"admin"}}
+	b := user{ID: 1, Roles: []string{"user"}}
+	c := user{ID: 2}
+
+	if !h.Equal(a, b) {
+		t.Error("expected users with the same ID to be equal")
+	}
+	if h.Equal(a, c) {
+		t.Error("expected users with different IDs not to be equal")
+	}
+	if h.Hash(a) != h.Hash(b) {
+		t.Error("expected equal values to have equal hashes")
+	}
+}
+
+func TestContains(t *testing.T) {
+	xs := [][]int{{1, 2}, {3}}
+	eq := gofp.EqBy(func(x []int) int { return len(x) })
+	if !gofp.Contains(xs, []int{4}, eq) {
+		t.Error("expected slice to contain a value of length 1")
+	}
+	if gofp.Contains(xs, []int{}, eq) {
+		t.Error("expected slice not to contain a value of length 0")
+	}
+
+	fold := gofp.EqFunc[string](strings.EqualFold)
+	if !gofp.Contains[string]([]string{"Go"}, "go", fold) {
+		t.Error("expected case-insensitive match")
+	}
+}
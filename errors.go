@@ -0,0 +1,92 @@
+package gofp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IndexError records an error produced by the element at a given index of a
+// slice.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// AggregateError collects multiple errors into one. It is compatible with
+// [errors.Is] and [errors.As], which examine each of the collected errors.
+type AggregateError struct {
+	Errors []error
+}
+
+func (e *AggregateError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the collected errors.
+func (e *AggregateError) Unwrap() []error {
+	return e.Errors
+}
+
+// QuorumError is held by the result of [ResultSequenceAtLeast] when fewer
+// values were Ok than were needed.
+type QuorumError struct {
+	// Need is the number of values that needed to be Ok.
+	Need int
+
+	// Ok is the number of values that were Ok.
+	Ok int
+
+	// Total is the number of values.
+	Total int
+
+	// Err is an [*AggregateError] collecting the error of every value that
+	// was Err, in order. It is nil if every value was Ok.
+	Err error
+}
+
+func (e *QuorumError) Error() string {
+	msg := fmt.Sprintf("quorum not reached: %d of %d succeeded, need %d", e.Ok, e.Total, e.Need)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the collected errors.
+func (e *QuorumError) Unwrap() error {
+	return e.Err
+}
+
+// ChunkError records the failure of a chunk processed by
+// [ResultTraverseChunked].
+type ChunkError struct {
+	// Chunk is the index of the chunk, starting at zero.
+	Chunk int
+
+	// Index is the index, within the whole slice, of the element that failed.
+	Index int
+
+	Err error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("chunk %d: index %d: %v", e.Chunk, e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
@@ -3,6 +3,7 @@ package gofp_test
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/tomasbasham/gofp"
 )
@@ -211,6 +212,28 @@ func ExampleOptionFlatMap() {
 	// Hello, Alice
 }
 
+func ExampleOptionPath3() {
+	type Address struct{ City string }
+	type User struct{ Address *Address }
+	users := map[string]User{"alice": {Address: &Address{City: "London"}}, "bob": {}}
+
+	city := func(name string) gofp.Option[string] {
+		return gofp.OptionPath3(gofp.Some(name),
+			func(name string) gofp.Option[User] { return gofp.MapGet(users, name) },
+			func(u User) gofp.Option[*Address] { return gofp.OptionFromZero(u.Address) },
+			func(a *Address) gofp.Option[string] { return gofp.OptionFromZero(a.City) },
+		)
+	}
+
+	fmt.Println(city("alice"))
+	fmt.Println(city("bob"))
+	fmt.Println(city("carol"))
+	// Output:
+	// Some(London)
+	// None
+	// None
+}
+
 func ExampleOptionSequence() {
 	options := []gofp.Option[int]{gofp.Some(1), gofp.Some(2), gofp.Some(3)}
 	sequenced := gofp.OptionSequence(options)
@@ -232,6 +255,15 @@ func ExampleOptionFold() {
 	// Value is 5
 }
 
+func ExampleFirstSome() {
+	fromEnv := gofp.None[string]()
+	fromConfig := gofp.Some("config.yaml")
+	port := gofp.FirstSome(fromEnv, fromConfig, gofp.Some("default.yaml"))
+	fmt.Println(port)
+	// Output:
+	// Some(config.yaml)
+}
+
 func ExampleOption_And() {
 	o := gofp.Some(5)
 	nextOpt := o.And(gofp.Some(10))
@@ -388,6 +420,114 @@ func ExampleResultSequence() {
 	// [1 2 3]
 }
 
+func ExampleFallback() {
+	live := func() gofp.Result[string] { return gofp.Err[string](errors.New("timeout")) }
+	cached := func() gofp.Result[string] { return gofp.Ok("cached price") }
+
+	served := gofp.Fallback(live, cached).Unwrap()
+	fmt.Println(served.Value, served.Source, served.Degraded(), served.Failures)
+	// Output:
+	// cached price 1 true [timeout]
+}
+
+func ExampleResult_WithMeta() {
+	type key string
+
+	r := gofp.Ok("42").WithMeta(key("source"), "cache")
+	n := gofp.ResultMap(r, func(s string) int { return len(s) })
+
+	fmt.Println(n, gofp.MetaOf[string](n, key("source")))
+	// Output:
+	// Ok(2) Some(cache)
+}
+
+func ExampleResultSequenceAtLeast() {
+	writes := []gofp.Result[string]{
+		gofp.Ok("replica-1"),
+		gofp.Err[string](errors.New("replica-2 unavailable")),
+		gofp.Ok("replica-3"),
+	}
+
+	fmt.Println(gofp.ResultSequenceAtLeast(writes, 2).Unwrap().Values)
+	fmt.Println(gofp.ResultSequenceAtLeast(writes, 3).UnwrapErr())
+	// Output:
+	// [replica-1 replica-3]
+	// quorum not reached: 2 of 3 succeeded, need 3: replica-2 unavailable
+}
+
+func ExampleResultSequenceMapKeyed() {
+	loaded := map[string]gofp.Result[string]{
+		"u1": gofp.Ok("Ada"),
+		"u2": gofp.Err[string](errors.New("not found")),
+	}
+
+	users, errs := gofp.ResultSequenceMapKeyed(loaded)
+	fmt.Println(users, errs)
+	// Output:
+	// map[u1:Ada] map[u2:not found]
+}
+
+func ExampleResultTraverseChunked() {
+	parse := func(s string) gofp.Result[int] {
+		return gofp.FromReturn(strconv.Atoi(s))
+	}
+
+	records := []string{"1", "2", "x", "4", "5", "6"}
+	report := gofp.ResultTraverseChunked(records, 2, parse, true)
+	fmt.Println(report.Values, report.Succeeded, len(report.Failed))
+	fmt.Println(report.Err())
+	// Output:
+	// [1 2 5 6] 2 1
+	// chunk 1: index 2: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleResultTraverseWithProgress() {
+	ids := []int{1, 2, 3, 4}
+	fetch := func(id int) gofp.Result[string] {
+		return gofp.Ok(fmt.Sprintf("user-%d", id))
+	}
+
+	users := gofp.ResultTraverseWithProgress(ids, fetch, func(done, total int) {
+		fmt.Printf("%d%%\n", done*100/total)
+	})
+	fmt.Println(users)
+	// Output:
+	// 25%
+	// 50%
+	// 75%
+	// 100%
+	// Ok([user-1 user-2 user-3 user-4])
+}
+
+func ExampleParTraverse() {
+	square := func(n int) gofp.Result[int] {
+		return gofp.Ok(n * n)
+	}
+
+	fmt.Println(gofp.ParTraverse([]int{1, 2, 3, 4}, 2, square))
+	// Output:
+	// Ok([1 4 9 16])
+}
+
+func ExampleNewCancelToken() {
+	tok, cancel := gofp.NewCancelToken(nil)
+
+	fetch := func(id int) gofp.Result[int] {
+		if id == 2 {
+			cancel(errors.New("shutting down"))
+		}
+		return gofp.Ok(id)
+	}
+
+	// With a limit of one, elements are fetched one at a time, so none are
+	// started after the token is canceled.
+	fmt.Println(gofp.ParTraverseToken(tok, []int{1, 2, 3, 4}, 1, fetch))
+	fmt.Println(tok.IsCanceled())
+	// Output:
+	// Err(shutting down)
+	// Some(shutting down)
+}
+
 func ExampleResultFold() {
 	r := gofp.Ok(5)
 	value := gofp.ResultFold(
@@ -423,3 +563,33 @@ func ExampleResult_EnsureWith() {
 	// Output:
 	// 5 is not greater than 10
 }
+
+func ExampleFilterMap() {
+	xs := []int{1, 2, 3, 4}
+	evens := gofp.FilterMap(xs, func(x int) gofp.Option[string] {
+		if x%2 != 0 {
+			return gofp.None[string]()
+		}
+		return gofp.Some(fmt.Sprint(x))
+	})
+	fmt.Println(evens)
+	// Output:
+	// [2 4]
+}
+
+func ExampleUnfold() {
+	// Amortise a balance of 100 in payments of at most 30.
+	payments := gofp.Unfold(100, func(balance int) gofp.Option[gofp.Pair[int, int]] {
+		if balance <= 0 {
+			return gofp.None[gofp.Pair[int, int]]()
+		}
+		payment := 30
+		if balance < payment {
+			payment = balance
+		}
+		return gofp.Some(gofp.NewPair(payment, balance-payment))
+	})
+	fmt.Println(payments)
+	// Output:
+	// [30 30 30 10]
+}
@@ -39,13 +39,19 @@ func (m SliceMonoid[T]) Empty() []T {
 	return []T{}
 }
 
+// EmptySized returns an empty slice with capacity for hint elements, so that
+// the build log is not reallocated as each step appends to it.
+func (m SliceMonoid[T]) EmptySized(hint int) []T {
+	return make([]T, 0, hint)
+}
+
 // Append appends two slices together.
 func (m SliceMonoid[T]) Append(a, b []T) []T {
 	return append(a, b...)
 }
 
 func main() {
-	result, log := build("main.go").Run()
+	result, log := build("main.go").RunWithCapacity(8)
 
 	fmt.Println("Build log:")
 	for _, entry := range log {
@@ -56,10 +62,12 @@ func main() {
 }
 
 func build(source string) Build {
-	compiled := compile(source).
+	// Memoize the compile and test steps so that observing their logs does not
+	// run them a second time when the build is run.
+	compiled := writer.Memoize(compile(source).
 		FlatMap(func(r BuildResult) Build {
 			return gofp.EitherFold(r, propagateFailure, test)
-		})
+		}))
 
 	// Observe logs so far without changing the result.
 	listened := writer.Listen(compiled)
@@ -99,17 +99,13 @@ func main() {
 	})
 }
 
-func userPipeline(user User) reader.Reader[AppConfig, gofp.Result[User]] {
-	return reader.FlatMap(saveUser(user), func(err error) reader.Reader[AppConfig, gofp.Result[User]] {
-		if err != nil {
-			// Lift the error into a Result.
-			return reader.Pure[AppConfig](gofp.Err[User](err))
-		}
+func userPipeline(user User) reader.TryReader[AppConfig, User] {
+	return reader.TryFlatMap(saveUser(user), func(gofp.Unit) reader.TryReader[AppConfig, User] {
 		return getUserByID(user.ID)
 	})
 }
 
-func getUserByID(id int) reader.Reader[AppConfig, gofp.Result[User]] {
+func getUserByID(id int) reader.TryReader[AppConfig, User] {
 	return reader.New(func(cfg AppConfig) gofp.Result[User] {
 		if cfg.LogRequests {
 			fmt.Printf("Getting user with ID: %d\n", id)
@@ -124,11 +120,11 @@ func getUserByID(id int) reader.Reader[AppConfig, gofp.Result[User]] {
 	})
 }
 
-func saveUser(user User) reader.Reader[AppConfig, error] {
-	return reader.New(func(cfg AppConfig) error {
+func saveUser(user User) reader.TryReader[AppConfig, gofp.Unit] {
+	return reader.TryFromFunc(func(cfg AppConfig) (gofp.Unit, error) {
 		if cfg.LogRequests {
 			fmt.Printf("Saving user: %s\n", user.Name)
 		}
-		return cfg.Repository.Save(user)
+		return gofp.UnitValue, cfg.Repository.Save(user)
 	})
 }
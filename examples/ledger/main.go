@@ -15,7 +15,9 @@ import (
 type EntryID string
 
 // Ledger represents a simple ledger system mapping entry IDs to ledger entries.
-type Ledger map[EntryID]LedgerEntry
+// It is a copy-on-write map, so running a computation never mutates the ledger
+// it was given.
+type Ledger = state.COWMap[EntryID, LedgerEntry]
 
 // LedgerEntry represents a single entry in a ledger.
 type LedgerEntry struct {
@@ -52,31 +54,27 @@ func (o Operation) String() string {
 	}
 }
 
-// TransactionError is an error that occurs during a transaction.
-type TransactionError struct {
-	code    string
-	message string
-}
+const (
+	// CodeInsufficientFunds indicates that a withdrawal exceeds the balance.
+	CodeInsufficientFunds gofp.Code = "INSUFFICIENT_FUNDS"
+
+	// CodeInvalidAmount indicates that an amount is not positive.
+	CodeInvalidAmount gofp.Code = "INVALID_AMOUNT"
+)
 
-func (e *TransactionError) Error() string {
-	return fmt.Sprintf("%s: %s", e.code, e.message)
+func init() {
+	gofp.RegisterCode(CodeInsufficientFunds, 422, 9)
+	gofp.RegisterCode(CodeInvalidAmount, 400, 3)
 }
 
-// NewInsufficientFundsError creates a new TransactionError for insufficient
-// funds.
-func NewInsufficientFundsError(balance, amount int) *TransactionError {
-	return &TransactionError{
-		code:    "INSUFFICIENT_FUNDS",
-		message: fmt.Sprintf("insufficient funds for withdrawal: balance %d, requested %d", balance, amount),
-	}
+// NewInsufficientFundsError creates a new error for insufficient funds.
+func NewInsufficientFundsError(balance, amount int) *gofp.CodedError {
+	return gofp.Errorf(CodeInsufficientFunds, "insufficient funds for withdrawal: balance %d, requested %d", balance, amount)
 }
 
-// NewInvalidAmountError creates a new TransactionError for an invalid amount.
-func NewInvalidAmountError(amount int) *TransactionError {
-	return &TransactionError{
-		code:    "INVALID_AMOUNT",
-		message: fmt.Sprintf("invalid amount: %d", amount),
-	}
+// NewInvalidAmountError creates a new error for an invalid amount.
+func NewInvalidAmountError(amount int) *gofp.CodedError {
+	return gofp.Errorf(CodeInvalidAmount, "invalid amount: %d", amount)
 }
 
 func main() {
@@ -115,7 +113,7 @@ func main() {
 	// Commit the second transaction.
 	s = commit(s, tx2)
 
-	balance, _ = s.Run(ledger)
+	balance, ledger = s.Run(ledger)
 	if balance.IsErr() {
 		fmt.Printf("Error: %v\n", balance.UnwrapErr())
 		return
@@ -171,29 +169,18 @@ func commit(s1 LedgerState, s2 LedgerState) LedgerState {
 
 func update(s LedgerState, amount int, op Operation, fn func(int) gofp.Result[int]) (LedgerState, EntryID) {
 	id := newEntryID(op)
-	return s.FlatMap(func(res gofp.Result[int]) LedgerState {
-		if res.IsErr() {
-			return state.Pure[Ledger](res)
-		}
-
-		newBalance := fn(res.Unwrap())
-		if newBalance.IsErr() {
-			return state.Pure[Ledger](newBalance)
-		}
-
-		balanceAfter := newBalance.Unwrap()
-		return state.FlatMap(state.Get[Ledger](), func(l Ledger) LedgerState {
-			desc := fmt.Sprintf("%s of %d", op, amount)
-			if op == Transaction {
-				desc = "open transaction"
-			}
-			l[id] = LedgerEntry{
-				Amount:       amount,
-				balanceAfter: balanceAfter,
-				Description:  desc,
-			}
-			return state.FlatMap(state.Put(l), func(gofp.Unit) LedgerState {
-				return state.Pure[Ledger](gofp.Ok(balanceAfter))
+	return state.TryFlatMap(s, func(balance int) LedgerState {
+		return state.TryFlatMap(state.Pure[Ledger](fn(balance)), func(balanceAfter int) LedgerState {
+			return state.TryFromFunc(func(l Ledger) (int, Ledger, error) {
+				desc := fmt.Sprintf("%s of %d", op, amount)
+				if op == Transaction {
+					desc = "open transaction"
+				}
+				return balanceAfter, l.Set(id, LedgerEntry{
+					Amount:       amount,
+					balanceAfter: balanceAfter,
+					Description:  desc,
+				}), nil
 			})
 		})
 	}), id
@@ -215,10 +202,9 @@ func newID(prefix string) string {
 }
 
 func balanceAt(l Ledger, id EntryID) gofp.Option[int] {
-	if entry, ok := l[id]; ok {
-		return gofp.Some(entry.balanceAfter)
-	}
-	return gofp.None[int]()
+	return gofp.OptionMap(l.Get(id), func(entry LedgerEntry) int {
+		return entry.balanceAfter
+	})
 }
 
 func printBalances(l Ledger, ids ...EntryID) {
@@ -232,8 +218,7 @@ func printBalances(l Ledger, ids ...EntryID) {
 }
 
 func descriptionAt(l Ledger, id EntryID) gofp.Option[string] {
-	if entry, ok := l[id]; ok {
-		return gofp.Some(entry.Description)
-	}
-	return gofp.None[string]()
+	return gofp.OptionMap(l.Get(id), func(entry LedgerEntry) string {
+		return entry.Description
+	})
 }
@@ -0,0 +1,159 @@
+// Package execfp runs external commands as [writer.Writer] computations.
+//
+// Each line a command writes to its standard output or standard error is
+// appended to the output of the computation as it is written, so that the
+// output of several commands composed with [writer.FlatMap] forms a single
+// log. The value of the computation is a [gofp.Result] holding the captured
+// [Output], or an error describing why the command failed, such as an
+// [*ExitError] for a command that exited with a non-zero status.
+package execfp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/monoid"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+// StderrPrefix is prepended to lines written to standard error when they are
+// appended to the output of a [Run] computation, to distinguish them from lines
+// written to standard output.
+const StderrPrefix = "stderr: "
+
+// Output holds the lines a command wrote to its standard output and standard
+// error, without their trailing newlines.
+type Output struct {
+	Stdout []string
+	Stderr []string
+}
+
+// ExitError is held by the result of a command that ran but exited with a
+// non-zero status.
+type ExitError struct {
+	// Code is the exit status of the command, or -1 if it was terminated by a
+	// signal.
+	Code int
+
+	// Stderr holds the lines the command wrote to standard error, which
+	// usually describe why it failed.
+	Stderr []string
+
+	err *exec.ExitError
+}
+
+// Error implements the error interface.
+func (e *ExitError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying [*exec.ExitError].
+func (e *ExitError) Unwrap() error {
+	return e.err
+}
+
+// Run returns a [writer.Writer] computation that runs the command each time it
+// is run. Since an [exec.Cmd] may only be started once, cmd itself is never
+// started; its Path, Args, Dir, Env and Stdin are copied to a new command bound
+// to ctx, which is killed if ctx is done before it exits.
+//
+// The result is an Err holding an [*ExitError] if the command exits with a
+// non-zero status, the error given by [gofp.ContextError] if ctx is done
+// first, or otherwise the error that prevented the command from running.
+func Run(ctx context.Context, cmd *exec.Cmd) writer.Writer[[]string, gofp.Result[Output]] {
+	return writer.New(func() (gofp.Result[Output], []string) {
+		c := exec.CommandContext(ctx, cmd.Path)
+		if len(cmd.Args) > 0 {
+			c.Args = cmd.Args
+		}
+		c.Dir = cmd.Dir
+		c.Env = cmd.Env
+		c.Stdin = cmd.Stdin
+
+		var (
+			log    lineLog
+			stdout = lineWriter{log: &log}
+			stderr = lineWriter{log: &log, prefix: StderrPrefix}
+		)
+		c.Stdout = &stdout
+		c.Stderr = &stderr
+
+		err := c.Run()
+		stdout.flush()
+		stderr.flush()
+
+		output := Output{Stdout: stdout.lines, Stderr: stderr.lines}
+		return result(ctx, output, err), log.lines
+	}, monoid.Slice[string]{})
+}
+
+func result(ctx context.Context, output Output, err error) gofp.Result[Output] {
+	if err == nil {
+		return gofp.Ok(output)
+	}
+	if err := gofp.ContextError(ctx); err != nil {
+		return gofp.Err[Output](err)
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return gofp.Err[Output](&ExitError{Code: exitErr.ExitCode(), Stderr: output.Stderr, err: exitErr})
+	}
+	return gofp.Err[Output](fmt.Errorf("execfp: %w", err))
+}
+
+// lineLog records the lines written by both streams of a command in the order
+// they were completed.
+type lineLog struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *lineLog) append(line string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, line)
+}
+
+// lineWriter splits the data written to one stream of a command into lines,
+// recording each both for the stream and in the shared log.
+type lineWriter struct {
+	log     *lineLog
+	prefix  string
+	partial []byte
+	lines   []string
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for {
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			w.partial = append(w.partial, p...)
+			return n, nil
+		}
+		w.partial = append(w.partial, p[:i]...)
+		w.emit()
+		p = p[i+1:]
+	}
+}
+
+// flush records the final line of the stream if it did not end with a
+// newline.
+func (w *lineWriter) flush() {
+	if len(w.partial) > 0 {
+		w.emit()
+	}
+}
+
+func (w *lineWriter) emit() {
+	line := strings.TrimSuffix(string(w.partial), "\r")
+	w.partial = w.partial[:0]
+	w.lines = append(w.lines, line)
+	w.log.append(w.prefix + line)
+}
@@ -0,0 +1,101 @@
+package execfp_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+	"github.com/tomasbasham/gofp/execfp"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+// TestHelperProcess is not a real test. It is run as a subprocess by the
+// other tests to simulate a command.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("EXECFP_HELPER_PROCESS") != "1" {
+		return
+	}
+	switch os.Args[len(os.Args)-1] {
+	case "succeed":
+		fmt.Println("compiling")
+		fmt.Fprintln(os.Stderr, "warning: unused variable")
+		fmt.Print("done")
+		os.Exit(0)
+	case "fail":
+		fmt.Println("compiling")
+		fmt.Fprintln(os.Stderr, "syntax error")
+		os.Exit(3)
+	case "hang":
+		select {}
+	}
+	os.Exit(2)
+}
+
+func helper(args ...string) *exec.Cmd {
+	cmd := exec.Command(os.Args[0], append([]string{"-test.run=TestHelperProcess", "--"}, args...)...)
+	cmd.Env = append(os.Environ(), "EXECFP_HELPER_PROCESS=1")
+	return cmd
+}
+
+func TestRun(t *testing.T) {
+	t.Run("captures output", func(t *testing.T) {
+		result, log := execfp.Run(context.Background(), helper("succeed")).Run()
+
+		output := assert.Ok(t, result)
+		if !reflect.DeepEqual(output.Stdout, []string{"compiling", "done"}) {
+			t.Errorf("expected [compiling done], got %q", output.Stdout)
+		}
+		if !reflect.DeepEqual(output.Stderr, []string{"warning: unused variable"}) {
+			t.Errorf("expected [warning: unused variable], got %q", output.Stderr)
+		}
+		if len(log) != 3 || !slices.Contains(log, "stderr: warning: unused variable") {
+			t.Errorf("expected log of both streams, got %q", log)
+		}
+	})
+
+	t.Run("exit status", func(t *testing.T) {
+		result, _ := execfp.Run(context.Background(), helper("fail")).Run()
+
+		var exitErr *execfp.ExitError
+		if !errors.As(assert.Err(t, result), &exitErr) {
+			t.Fatalf("expected ExitError, got %v", result)
+		}
+		if exitErr.Code != 3 {
+			t.Errorf("expected exit code 3, got %d", exitErr.Code)
+		}
+		if !reflect.DeepEqual(exitErr.Stderr, []string{"syntax error"}) {
+			t.Errorf("expected [syntax error], got %q", exitErr.Stderr)
+		}
+	})
+
+	t.Run("command not found", func(t *testing.T) {
+		result, _ := execfp.Run(context.Background(), exec.Command("execfp-missing-command")).Run()
+		assert.ErrIs(t, result, exec.ErrNotFound)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		result, _ := execfp.Run(ctx, helper("hang")).Run()
+		assert.ErrIs(t, result, context.Canceled)
+	})
+
+	t.Run("may be run repeatedly", func(t *testing.T) {
+		w := execfp.Run(context.Background(), helper("succeed"))
+		w = writer.FlatMap(w, func(r gofp.Result[execfp.Output]) writer.Writer[[]string, gofp.Result[execfp.Output]] {
+			return execfp.Run(context.Background(), helper("succeed"))
+		})
+		result, log := w.Run()
+		assert.Ok(t, result)
+		if len(log) != 6 {
+			t.Errorf("expected 6 lines of log, got %q", log)
+		}
+	})
+}
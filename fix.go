@@ -0,0 +1,45 @@
+package gofp
+
+import "sync"
+
+// Fix returns the fixed point of f, allowing a recursive function to be
+// defined without first declaring a variable to refer to itself. The function
+// f receives the recursive function as self and returns its definition.
+func Fix[A, B any](f func(self func(A) B) func(A) B) func(A) B {
+	var g func(A) B
+	g = f(func(a A) B {
+		return g(a)
+	})
+	return g
+}
+
+// FixMemo is like [Fix] but memoises the result for each argument, so that
+// recursive calls with an argument that has already been seen return the cached
+// result. The returned function is safe for concurrent use, although
+// concurrent calls with the same argument may each compute the result.
+func FixMemo[A comparable, B any](f func(self func(A) B) func(A) B) func(A) B {
+	var (
+		mu    sync.Mutex
+		cache = make(map[A]B)
+		g     func(A) B
+	)
+
+	memo := func(a A) B {
+		mu.Lock()
+		b, ok := cache[a]
+		mu.Unlock()
+		if ok {
+			return b
+		}
+
+		b = g(a)
+
+		mu.Lock()
+		cache[a] = b
+		mu.Unlock()
+		return b
+	}
+
+	g = f(memo)
+	return memo
+}
@@ -0,0 +1,47 @@
+package gofp_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestFix(t *testing.T) {
+	factorial := gofp.Fix(func(self func(int) int) func(int) int {
+		return func(n int) int {
+			if n <= 1 {
+				return 1
+			}
+			return n * self(n-1)
+		}
+	})
+
+	if got := factorial(5); got != 120 {
+		t.Errorf("expected 120, got %v", got)
+	}
+}
+
+func TestFixMemo(t *testing.T) {
+	calls := 0
+	fib := gofp.FixMemo(func(self func(int) uint64) func(int) uint64 {
+		return func(n int) uint64 {
+			calls++
+			if n < 2 {
+				return uint64(n)
+			}
+			return self(n-1) + self(n-2)
+		}
+	})
+
+	if got := fib(90); got != 2880067194370816120 {
+		t.Errorf("expected 2880067194370816120, got %v", got)
+	}
+	if calls != 91 {
+		t.Errorf("expected each argument to be computed once, got %d calls", calls)
+	}
+
+	fib(90)
+	if calls != 91 {
+		t.Errorf("expected cached result to be reused, got %d calls", calls)
+	}
+}
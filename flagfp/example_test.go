@@ -0,0 +1,22 @@
+package flagfp_test
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/tomasbasham/gofp/flagfp"
+)
+
+func ExampleOptionIn() {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	port := flagfp.OptionIn[int](fs, "port", "port to listen on")
+	host := flagfp.OptionIn[string](fs, "host", "host to listen on")
+
+	fs.Parse([]string{"-port", "0"})
+
+	fmt.Println(port.Get())
+	fmt.Println(host.Get().UnwrapOr("localhost"))
+	// Output:
+	// Some(0)
+	// localhost
+}
@@ -0,0 +1,211 @@
+// Package flagfp integrates the standard library flag package with
+// [gofp.Option] and [gofp.Result].
+//
+// A flag registered with the flag package always has a value, so a flag that
+// was not provided on the command line is indistinguishable from one that was
+// explicitly set to its default. The flags registered by this package instead
+// yield None, or an Err, when they are not provided.
+package flagfp
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// ErrNotProvided is held by the [gofp.Result] of a [ResultFlag] that was not
+// provided on the command line.
+var ErrNotProvided = errors.New("flag not provided")
+
+// Value is a constraint that permits the types that flags may hold. Named types
+// are supported by their underlying kind, with the exception of
+// [time.Duration], which is parsed using [time.ParseDuration].
+type Value interface {
+	~string | ~bool |
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// OptionFlag is a [flag.Value] whose value is None until it is set.
+//
+// Type parameter T represents the flag value type.
+type OptionFlag[T Value] struct {
+	value gofp.Option[T]
+}
+
+// Option registers a flag with the given name and usage on
+// [flag.CommandLine].
+func Option[T Value](name, usage string) *OptionFlag[T] {
+	return OptionIn[T](flag.CommandLine, name, usage)
+}
+
+// OptionIn registers a flag with the given name and usage on the given
+// [flag.FlagSet].
+func OptionIn[T Value](fs *flag.FlagSet, name, usage string) *OptionFlag[T] {
+	f := &OptionFlag[T]{}
+	fs.Var(f, name, usage)
+	return f
+}
+
+// Get returns Some holding the value of the flag if it was provided, or None
+// otherwise.
+func (f *OptionFlag[T]) Get() gofp.Option[T] {
+	return f.value
+}
+
+// Set parses and stores the value of the flag. It implements [flag.Value].
+func (f *OptionFlag[T]) Set(s string) error {
+	v, err := parse[T](s)
+	if err != nil {
+		return err
+	}
+	f.value = gofp.Some(v)
+	return nil
+}
+
+// String returns the value of the flag, or the empty string if it was not
+// provided. It implements [flag.Value].
+func (f *OptionFlag[T]) String() string {
+	if f == nil {
+		return ""
+	}
+	return format(f.value)
+}
+
+// IsBoolFlag reports whether the flag may be given without a value. It allows
+// boolean flags to be given as -name rather than -name=true.
+func (f *OptionFlag[T]) IsBoolFlag() bool {
+	return isBool[T]()
+}
+
+// ResultFlag is a [flag.Value] that validates its value. Its value is an Err
+// until it is set, or if its value fails validation.
+//
+// Type parameter T represents the flag value type.
+type ResultFlag[T Value] struct {
+	name     string
+	validate func(T) error
+	value    gofp.Option[T]
+	err      error
+}
+
+// Result registers a flag with the given name and usage on
+// [flag.CommandLine]. The value of the flag is checked using validate, which
+// may be nil.
+func Result[T Value](name, usage string, validate func(T) error) *ResultFlag[T] {
+	return ResultIn(flag.CommandLine, name, usage, validate)
+}
+
+// ResultIn registers a flag with the given name and usage on the given
+// [flag.FlagSet]. The value of the flag is checked using validate, which may be
+// nil.
+func ResultIn[T Value](fs *flag.FlagSet, name, usage string, validate func(T) error) *ResultFlag[T] {
+	f := &ResultFlag[T]{name: name, validate: validate}
+	fs.Var(f, name, usage)
+	return f
+}
+
+// Get returns Ok holding the value of the flag if it was provided and passed
+// validation. Otherwise it returns an Err holding either the error that caused
+// the value to be rejected or [ErrNotProvided].
+func (f *ResultFlag[T]) Get() gofp.Result[T] {
+	if f.err != nil {
+		return gofp.Err[T](f.err)
+	}
+	if v, ok := f.value.TryUnwrap(); ok {
+		return gofp.Ok(v)
+	}
+	return gofp.Err[T](fmt.Errorf("-%s: %w", f.name, ErrNotProvided))
+}
+
+// Set parses, validates and stores the value of the flag. It implements
+// [flag.Value]. An error is returned, and also held by the flag, if the value
+// cannot be parsed or fails validation.
+func (f *ResultFlag[T]) Set(s string) error {
+	v, err := parse[T](s)
+	if err == nil && f.validate != nil {
+		err = f.validate(v)
+	}
+	if err != nil {
+		f.value = gofp.None[T]()
+		f.err = err
+		return err
+	}
+	f.value = gofp.Some(v)
+	f.err = nil
+	return nil
+}
+
+// String returns the value of the flag, or the empty string if it was not
+// provided. It implements [flag.Value].
+func (f *ResultFlag[T]) String() string {
+	if f == nil {
+		return ""
+	}
+	return format(f.value)
+}
+
+// IsBoolFlag reports whether the flag may be given without a value. It allows
+// boolean flags to be given as -name rather than -name=true.
+func (f *ResultFlag[T]) IsBoolFlag() bool {
+	return isBool[T]()
+}
+
+func isBool[T Value]() bool {
+	var zero T
+	return reflect.TypeOf(zero).Kind() == reflect.Bool
+}
+
+func format[T Value](o gofp.Option[T]) string {
+	v, ok := o.TryUnwrap()
+	if !ok {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func parse[T Value](s string) (T, error) {
+	var v T
+	if d, ok := any(&v).(*time.Duration); ok {
+		parsed, err := time.ParseDuration(s)
+		*d = parsed
+		return v, err
+	}
+
+	rv := reflect.ValueOf(&v).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return v, err
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 0, rv.Type().Bits())
+		if err != nil {
+			return v, err
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 0, rv.Type().Bits())
+		if err != nil {
+			return v, err
+		}
+		rv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return v, err
+		}
+		rv.SetFloat(n)
+	}
+	return v, nil
+}
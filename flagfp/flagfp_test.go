@@ -0,0 +1,123 @@
+package flagfp_test
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp/flagfp"
+)
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func TestOption(t *testing.T) {
+	t.Run("is None when not provided", func(t *testing.T) {
+		fs := newFlagSet()
+		port := flagfp.OptionIn[int](fs, "port", "port to listen on")
+		if err := fs.Parse(nil); err != nil {
+			t.Fatal(err)
+		}
+		if got := port.Get(); got.IsSome() {
+			t.Errorf("expected None, got %v", got)
+		}
+	})
+
+	t.Run("distinguishes an explicit zero value", func(t *testing.T) {
+		fs := newFlagSet()
+		port := flagfp.OptionIn[int](fs, "port", "port to listen on")
+		if err := fs.Parse([]string{"-port=0"}); err != nil {
+			t.Fatal(err)
+		}
+		if got := port.Get(); got.IsNone() || got.Unwrap() != 0 {
+			t.Errorf("expected Some(0), got %v", got)
+		}
+	})
+
+	t.Run("parses supported types", func(t *testing.T) {
+		type level uint8
+
+		fs := newFlagSet()
+		name := flagfp.OptionIn[string](fs, "name", "")
+		verbose := flagfp.OptionIn[bool](fs, "verbose", "")
+		timeout := flagfp.OptionIn[time.Duration](fs, "timeout", "")
+		ratio := flagfp.OptionIn[float64](fs, "ratio", "")
+		lvl := flagfp.OptionIn[level](fs, "level", "")
+
+		err := fs.Parse([]string{"-name", "api", "-verbose", "-timeout", "1m30s", "-ratio", "0.5", "-level", "3"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := name.Get().Unwrap(); got != "api" {
+			t.Errorf("expected api, got %v", got)
+		}
+		if got := verbose.Get().Unwrap(); !got {
+			t.Errorf("expected true, got %v", got)
+		}
+		if got := timeout.Get().Unwrap(); got != 90*time.Second {
+			t.Errorf("expected 1m30s, got %v", got)
+		}
+		if got := ratio.Get().Unwrap(); got != 0.5 {
+			t.Errorf("expected 0.5, got %v", got)
+		}
+		if got := lvl.Get().Unwrap(); got != 3 {
+			t.Errorf("expected 3, got %v", got)
+		}
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		fs := newFlagSet()
+		flagfp.OptionIn[uint8](fs, "level", "")
+		if err := fs.Parse([]string{"-level", "256"}); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestResult(t *testing.T) {
+	errLow := errors.New("port must be at least 1024")
+	validate := func(port int) error {
+		if port < 1024 {
+			return errLow
+		}
+		return nil
+	}
+
+	t.Run("is Err when not provided", func(t *testing.T) {
+		fs := newFlagSet()
+		port := flagfp.ResultIn(fs, "port", "", validate)
+		if err := fs.Parse(nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := port.Get().UnwrapErr(); !errors.Is(err, flagfp.ErrNotProvided) {
+			t.Errorf("expected ErrNotProvided, got %v", err)
+		}
+	})
+
+	t.Run("is Ok when valid", func(t *testing.T) {
+		fs := newFlagSet()
+		port := flagfp.ResultIn(fs, "port", "", validate)
+		if err := fs.Parse([]string{"-port", "8080"}); err != nil {
+			t.Fatal(err)
+		}
+		if got := port.Get(); got.IsErr() || got.Unwrap() != 8080 {
+			t.Errorf("expected Ok(8080), got %v", got)
+		}
+	})
+
+	t.Run("holds the validation error", func(t *testing.T) {
+		fs := newFlagSet()
+		port := flagfp.ResultIn(fs, "port", "", validate)
+		if err := fs.Parse([]string{"-port", "80"}); err == nil {
+			t.Error("expected parse to fail")
+		}
+		if err := port.Get().UnwrapErr(); !errors.Is(err, errLow) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+}
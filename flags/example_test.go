@@ -0,0 +1,40 @@
+package flags_test
+
+import (
+	"fmt"
+
+	"github.com/tomasbasham/gofp/flags"
+	"github.com/tomasbasham/gofp/reader"
+)
+
+type Request struct {
+	User  string
+	flags flags.Provider
+}
+
+func (r Request) Flags() flags.Provider {
+	return r.flags
+}
+
+func ExampleWhen() {
+	greet := flags.When("friendly",
+		reader.New(func(r Request) string { return "Hey " + r.User + "!" }),
+		reader.New(func(r Request) string { return "Hello, " + r.User + "." }),
+	)
+
+	fmt.Println(greet.Run(Request{"Ada", flags.Map{"friendly": true}}))
+	fmt.Println(greet.Run(Request{"Ada", flags.Map{}}))
+	// Output:
+	// Hey Ada!
+	// Hello, Ada.
+}
+
+func ExampleVariant() {
+	pageSize := flags.Variant[Request]("page-size", 20)
+
+	fmt.Println(pageSize.Run(Request{"Ada", flags.Map{"page-size": 50}}))
+	fmt.Println(pageSize.Run(Request{"Ada", flags.Map{}}))
+	// Output:
+	// 50
+	// 20
+}
@@ -0,0 +1,69 @@
+// Package flags resolves feature flags from the environment of a
+// [reader.Reader] computation.
+//
+// Flags are read from a [Provider] carried by the environment, which must
+// implement [Env]. [Enabled] and [Variant] are computations like any other, so
+// behaviour that depends on a flag is declared as part of a pipeline rather
+// than decided by reading global state, and is tested by running the pipeline
+// with a different provider, such as a [Map].
+package flags
+
+import (
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/reader"
+)
+
+// Provider looks up the values of feature flags.
+type Provider interface {
+	// Lookup returns the value of the named flag, or None if the flag is not
+	// set.
+	Lookup(name string) gofp.Option[any]
+}
+
+// Map is a [Provider] holding a fixed set of flags.
+type Map map[string]any
+
+// Lookup returns the value of the named flag, or None if it is not in the map.
+func (m Map) Lookup(name string) gofp.Option[any] {
+	return gofp.OptionFromMap(m, name)
+}
+
+// Env is implemented by environments that carry a [Provider].
+type Env interface {
+	// Flags returns the provider of the flags of the environment.
+	Flags() Provider
+}
+
+// Enabled returns a [reader.Reader] computation that reports whether the named
+// flag is set to true. A flag that is not set, or is not a bool, is disabled.
+func Enabled[E Env](name string) reader.Reader[E, bool] {
+	return Variant[E](name, false)
+}
+
+// Variant returns a [reader.Reader] computation that produces the value of the
+// named flag, or defaultValue if the flag is not set or its value is not of
+// type T.
+func Variant[E Env, T any](name string, defaultValue T) reader.Reader[E, T] {
+	return reader.New(func(env E) T {
+		v, ok := env.Flags().Lookup(name).TryUnwrap()
+		if !ok {
+			return defaultValue
+		}
+		t, ok := v.(T)
+		if !ok {
+			return defaultValue
+		}
+		return t
+	})
+}
+
+// When returns a [reader.Reader] computation that runs on if the named flag is
+// enabled, and off otherwise. Only the chosen computation is run.
+func When[E Env, A any](name string, on, off reader.Reader[E, A]) reader.Reader[E, A] {
+	return reader.FlatMap(Enabled[E](name), func(enabled bool) reader.Reader[E, A] {
+		if enabled {
+			return on
+		}
+		return off
+	})
+}
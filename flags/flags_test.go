@@ -0,0 +1,76 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/gofp/flags"
+	"github.com/tomasbasham/gofp/reader"
+)
+
+type env struct {
+	flags flags.Map
+}
+
+func (e env) Flags() flags.Provider {
+	return e.flags
+}
+
+func TestEnabled(t *testing.T) {
+	tests := map[string]struct {
+		flags flags.Map
+		want  bool
+	}{
+		"enabled":  {flags.Map{"beta": true}, true},
+		"disabled": {flags.Map{"beta": false}, false},
+		"unset":    {flags.Map{}, false},
+		"not bool": {flags.Map{"beta": "true"}, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := flags.Enabled[env]("beta").Run(env{tt.flags}); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestVariant(t *testing.T) {
+	tests := map[string]struct {
+		flags flags.Map
+		want  string
+	}{
+		"set":      {flags.Map{"checkout": "two-step"}, "two-step"},
+		"unset":    {flags.Map{}, "classic"},
+		"bad type": {flags.Map{"checkout": 2}, "classic"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := flags.Variant[env]("checkout", "classic").Run(env{tt.flags})
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWhen(t *testing.T) {
+	var offRuns int
+	on := reader.Pure[env]("new")
+	off := reader.New(func(env) string {
+		offRuns++
+		return "old"
+	})
+	r := flags.When("redesign", on, off)
+
+	if got := r.Run(env{flags.Map{"redesign": true}}); got != "new" {
+		t.Errorf("expected new, got %q", got)
+	}
+	if offRuns != 0 {
+		t.Errorf("expected the disabled branch not to run, ran %d times", offRuns)
+	}
+	if got := r.Run(env{flags.Map{}}); got != "old" {
+		t.Errorf("expected old, got %q", got)
+	}
+}
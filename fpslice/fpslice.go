@@ -0,0 +1,80 @@
+// Package fpslice provides bounds-checked slice operations that return a
+// [gofp.Option] or [gofp.Result] rather than panicking, so that index errors
+// in data-processing pipelines become values.
+package fpslice
+
+import (
+	"fmt"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// OutOfRangeError is held by the [gofp.Result] of an operation given an index
+// outside the bounds of a slice.
+type OutOfRangeError struct {
+	// Index is the index that was out of range.
+	Index int
+
+	// Len is the length of the slice.
+	Len int
+}
+
+func (e *OutOfRangeError) Error() string {
+	return fmt.Sprintf("index %d out of range [0:%d]", e.Index, e.Len)
+}
+
+// At returns the element of xs at index i, or an Err holding an
+// [*OutOfRangeError] if i is out of range.
+func At[T any](xs []T, i int) gofp.Result[T] {
+	if i < 0 || i >= len(xs) {
+		return gofp.Err[T](&OutOfRangeError{Index: i, Len: len(xs)})
+	}
+	return gofp.Ok(xs[i])
+}
+
+// Slice returns xs[i:j], or an Err holding an [*OutOfRangeError] for the first
+// of i and j that is out of range. As with a slice expression, j may equal the
+// length of xs, and i must not exceed j.
+func Slice[T any](xs []T, i, j int) gofp.Result[[]T] {
+	switch {
+	case i < 0 || i > len(xs):
+		return gofp.Err[[]T](&OutOfRangeError{Index: i, Len: len(xs)})
+	case j < i || j > len(xs):
+		return gofp.Err[[]T](&OutOfRangeError{Index: j, Len: len(xs)})
+	}
+	return gofp.Ok(xs[i:j])
+}
+
+// Head returns the first element of xs, or None if xs is empty.
+func Head[T any](xs []T) gofp.Option[T] {
+	if len(xs) == 0 {
+		return gofp.None[T]()
+	}
+	return gofp.Some(xs[0])
+}
+
+// Last returns the last element of xs, or None if xs is empty.
+func Last[T any](xs []T) gofp.Option[T] {
+	if len(xs) == 0 {
+		return gofp.None[T]()
+	}
+	return gofp.Some(xs[len(xs)-1])
+}
+
+// Init returns every element of xs except the last, or None if xs is empty.
+// The returned slice shares its backing array with xs.
+func Init[T any](xs []T) gofp.Option[[]T] {
+	if len(xs) == 0 {
+		return gofp.None[[]T]()
+	}
+	return gofp.Some(xs[:len(xs)-1])
+}
+
+// Tail returns every element of xs except the first, or None if xs is empty.
+// The returned slice shares its backing array with xs.
+func Tail[T any](xs []T) gofp.Option[[]T] {
+	if len(xs) == 0 {
+		return gofp.None[[]T]()
+	}
+	return gofp.Some(xs[1:])
+}
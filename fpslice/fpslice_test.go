@@ -0,0 +1,97 @@
+package fpslice_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/tomasbasham/gofp/fpslice"
+)
+
+func TestAt(t *testing.T) {
+	xs := []string{"a", "b", "c"}
+
+	t.Run("in range", func(t *testing.T) {
+		if got := fpslice.At(xs, 1); got.UnwrapOr("") != "b" {
+			t.Errorf("expected Ok(b), got %v", got)
+		}
+	})
+
+	for _, i := range []int{-1, 3} {
+		got := fpslice.At(xs, i)
+		var oor *fpslice.OutOfRangeError
+		if !got.IsErr() || !errors.As(got.UnwrapErr(), &oor) {
+			t.Fatalf("expected OutOfRangeError, got %v", got)
+		}
+		if oor.Index != i || oor.Len != 3 {
+			t.Errorf("expected index %d and length 3, got %+v", i, oor)
+		}
+	}
+}
+
+func TestSlice(t *testing.T) {
+	xs := []int{1, 2, 3, 4}
+
+	tests := map[string]struct {
+		i, j  int
+		want  []int
+		index int
+	}{
+		"middle":         {i: 1, j: 3, want: []int{2, 3}},
+		"whole":          {i: 0, j: 4, want: []int{1, 2, 3, 4}},
+		"empty at end":   {i: 4, j: 4, want: []int{}},
+		"negative start": {i: -1, j: 2, index: -1},
+		"end too large":  {i: 0, j: 5, index: 5},
+		"end before":     {i: 3, j: 2, index: 2},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := fpslice.Slice(xs, tt.i, tt.j)
+			if tt.want != nil {
+				if !slices.Equal(got.UnwrapOr(nil), tt.want) || got.IsErr() {
+					t.Errorf("expected Ok(%v), got %v", tt.want, got)
+				}
+				return
+			}
+			var oor *fpslice.OutOfRangeError
+			if !got.IsErr() || !errors.As(got.UnwrapErr(), &oor) || oor.Index != tt.index {
+				t.Errorf("expected OutOfRangeError at %d, got %v", tt.index, got)
+			}
+		})
+	}
+}
+
+func TestHeadLast(t *testing.T) {
+	xs := []int{1, 2, 3}
+
+	if got := fpslice.Head(xs); got.UnwrapOr(0) != 1 {
+		t.Errorf("expected Some(1), got %v", got)
+	}
+	if got := fpslice.Last(xs); got.UnwrapOr(0) != 3 {
+		t.Errorf("expected Some(3), got %v", got)
+	}
+	if got := fpslice.Head([]int{}); !got.IsNone() {
+		t.Errorf("expected None, got %v", got)
+	}
+	if got := fpslice.Last[int](nil); !got.IsNone() {
+		t.Errorf("expected None, got %v", got)
+	}
+}
+
+func TestInitTail(t *testing.T) {
+	xs := []int{1, 2, 3}
+
+	if got := fpslice.Init(xs); !slices.Equal(got.UnwrapOr(nil), []int{1, 2}) {
+		t.Errorf("expected Some([1 2]), got %v", got)
+	}
+	if got := fpslice.Tail(xs); !slices.Equal(got.UnwrapOr(nil), []int{2, 3}) {
+		t.Errorf("expected Some([2 3]), got %v", got)
+	}
+	if got := fpslice.Init([]int{}); !got.IsNone() {
+		t.Errorf("expected None, got %v", got)
+	}
+	if got := fpslice.Tail([]int{7}); !got.IsSome() || len(got.Unwrap()) != 0 {
+		t.Errorf("expected Some([]), got %v", got)
+	}
+}
@@ -0,0 +1,31 @@
+package fsfp_test
+
+import (
+	"fmt"
+	"path"
+	"testing/fstest"
+
+	"github.com/tomasbasham/gofp/fsfp"
+)
+
+func ExampleWalk() {
+	fsys := fstest.MapFS{
+		"README.md":   {},
+		"cmd/main.go": {},
+		"lib/lib.go":  {},
+	}
+
+	for r := range fsfp.Walk(fsys, ".") {
+		entry, ok := r.TryUnwrap()
+		if !ok {
+			fmt.Println("error:", r.UnwrapErr())
+			continue
+		}
+		if path.Ext(entry.Path) == ".go" {
+			fmt.Println(entry.Path)
+		}
+	}
+	// Output:
+	// cmd/main.go
+	// lib/lib.go
+}
@@ -0,0 +1,55 @@
+// Package fsfp walks and matches files in an [fs.FS], producing
+// [gofp.Result] values rather than calling back with an error.
+//
+// [fs.WalkDir] reports each file to a callback, which must decide for every
+// file whether to continue, skip or stop, and handle errors inline. [Walk]
+// instead returns a lazy sequence that may be ranged over, stopped early with
+// break, and processed with ordinary loops or sequence combinators.
+package fsfp
+
+import (
+	"io/fs"
+	"iter"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// Entry is a file or directory found by [Walk].
+type Entry struct {
+	fs.DirEntry
+
+	// Path is the path of the entry, which is root joined with the names of
+	// the directories leading to it. It is suitable for passing to the
+	// functions of the fs package along with the walked file system.
+	Path string
+}
+
+// Walk lazily walks the file tree of fsys rooted at root, yielding an Ok for
+// each file or directory, including root itself, in lexical order. Nothing is
+// read from fsys until the sequence is ranged over, and the walk stops as soon
+// as the loop over it does.
+//
+// An error reading root or a directory is yielded as an Err holding the
+// error, which is usually a [*fs.PathError]. The walk then continues with the
+// remaining entries, so a single unreadable directory does not hide the rest
+// of the tree. See [fs.WalkDir].
+func Walk(fsys fs.FS, root string) iter.Seq[gofp.Result[Entry]] {
+	return func(yield func(gofp.Result[Entry]) bool) {
+		fs.WalkDir(fsys, root, func(path string, d fs.DirEntry, err error) error {
+			r := gofp.Ok(Entry{DirEntry: d, Path: path})
+			if err != nil {
+				r = gofp.Err[Entry](err)
+			}
+			if !yield(r) {
+				return fs.SkipAll
+			}
+			return nil
+		})
+	}
+}
+
+// Glob returns the names of the files in fsys matching pattern, or an Err if
+// pattern is malformed. See [fs.Glob].
+func Glob(fsys fs.FS, pattern string) gofp.Result[[]string] {
+	return gofp.FromReturn(fs.Glob(fsys, pattern))
+}
@@ -0,0 +1,104 @@
+package fsfp_test
+
+import (
+	"errors"
+	"io/fs"
+	"iter"
+	"path"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/fsfp"
+)
+
+var fsys = fstest.MapFS{
+	"go.mod":            {Data: []byte("module example")},
+	"main.go":           {Data: []byte("package main")},
+	"internal/a/a.go":   {Data: []byte("package a")},
+	"internal/b/b.go":   {Data: []byte("package b")},
+	"internal/b/README": {Data: []byte("b")},
+}
+
+func paths(t *testing.T, seq iter.Seq[gofp.Result[fsfp.Entry]]) ([]string, []error) {
+	t.Helper()
+	var (
+		ps   []string
+		errs []error
+	)
+	for r := range seq {
+		if e, ok := r.TryUnwrap(); ok {
+			ps = append(ps, e.Path)
+		} else {
+			errs = append(errs, r.UnwrapErr())
+		}
+	}
+	return ps, errs
+}
+
+func TestWalk(t *testing.T) {
+	t.Run("walks in lexical order", func(t *testing.T) {
+		got, errs := paths(t, fsfp.Walk(fsys, "internal"))
+		want := []string{"internal", "internal/a", "internal/a/a.go", "internal/b", "internal/b/README", "internal/b/b.go"}
+		if !slices.Equal(got, want) || len(errs) > 0 {
+			t.Errorf("expected %v, got %v and errors %v", want, got, errs)
+		}
+	})
+
+	t.Run("stops when the loop stops", func(t *testing.T) {
+		var got []string
+		for r := range fsfp.Walk(fsys, ".") {
+			e := r.UnwrapOr(fsfp.Entry{})
+			if path.Ext(e.Path) == ".go" {
+				got = append(got, e.Path)
+				break
+			}
+		}
+		if !slices.Equal(got, []string{"internal/a/a.go"}) {
+			t.Errorf("expected [internal/a/a.go], got %v", got)
+		}
+	})
+
+	t.Run("missing root", func(t *testing.T) {
+		_, errs := paths(t, fsfp.Walk(fsys, "missing"))
+		if len(errs) != 1 || !errors.Is(errs[0], fs.ErrNotExist) {
+			t.Errorf("expected ErrNotExist, got %v", errs)
+		}
+	})
+
+	t.Run("continues after unreadable directories", func(t *testing.T) {
+		got, errs := paths(t, fsfp.Walk(failingFS{fsys, "internal/a"}, "internal"))
+		want := []string{"internal", "internal/a", "internal/b", "internal/b/README", "internal/b/b.go"}
+		if !slices.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if len(errs) != 1 || !errors.Is(errs[0], fs.ErrPermission) {
+			t.Errorf("expected ErrPermission, got %v", errs)
+		}
+	})
+}
+
+// failingFS is a file system whose directory at path cannot be read.
+type failingFS struct {
+	fstest.MapFS
+	path string
+}
+
+func (f failingFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name == f.path {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrPermission}
+	}
+	return f.MapFS.ReadDir(name)
+}
+
+func TestGlob(t *testing.T) {
+	got := fsfp.Glob(fsys, "internal/*/*.go")
+	if !slices.Equal(got.UnwrapOr(nil), []string{"internal/a/a.go", "internal/b/b.go"}) {
+		t.Errorf("expected [internal/a/a.go internal/b/b.go], got %v", got)
+	}
+
+	if r := fsfp.Glob(fsys, "["); !errors.Is(r.UnwrapErr(), path.ErrBadPattern) {
+		t.Errorf("expected ErrBadPattern, got %v", r)
+	}
+}
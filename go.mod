@@ -1,3 +1,3 @@
 module github.com/tomasbasham/gofp
 
-go 1.18
+go 1.24.0
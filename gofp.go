@@ -10,3 +10,15 @@ type Unit struct{}
 
 // UnitValue is the only value of type [Unit].
 var UnitValue = Unit{}
+
+// Coalesce returns the first of the given values that is not the zero value of
+// its type, or the zero value if all of them are.
+func Coalesce[T comparable](values ...T) T {
+	var zero T
+	for _, v := range values {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
@@ -0,0 +1,21 @@
+package gofp_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestCoalesce(t *testing.T) {
+	t.Run("returns first non-zero value", func(t *testing.T) {
+		if got := gofp.Coalesce("", "env", "default"); got != "env" {
+			t.Errorf("expected env, got %q", got)
+		}
+	})
+
+	t.Run("returns zero value when all values are zero", func(t *testing.T) {
+		if got := gofp.Coalesce(0, 0); got != 0 {
+			t.Errorf("expected 0, got %d", got)
+		}
+	})
+}
@@ -0,0 +1,10 @@
+module github.com/tomasbasham/gofp/gofpcmp
+
+go 1.24.0
+
+require (
+	github.com/google/go-cmp v0.7.0
+	github.com/tomasbasham/gofp v0.0.0-00010101000000-000000000000
+)
+
+replace github.com/tomasbasham/gofp => ../
@@ -0,0 +1,116 @@
+// Package gofpcmp provides options for comparing gofp containers with the
+// github.com/google/go-cmp/cmp package.
+//
+// [gofp.Option], [gofp.Result] and [gofp.Either] hold their values in
+// unexported fields, so cmp panics when it encounters them, and options such as
+// cmpopts.IgnoreUnexported silently treat all values of a type as equal. The
+// options returned by [Options] transform each container into an equivalent
+// value with exported fields before it is compared, so that containers nested
+// anywhere within compared values are diffed by their contents:
+//
+//	if diff := cmp.Diff(want, got, gofpcmp.Options()); diff != "" {
+//		t.Errorf("mismatch (-want +got):\n%s", diff)
+//	}
+//
+// The package is a module of its own, so that programs using gofp do not
+// depend on go-cmp:
+//
+//	go get github.com/tomasbasham/gofp/gofpcmp
+package gofpcmp
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/tomasbasham/gofp"
+)
+
+var pkgPath = reflect.TypeFor[gofp.Unit]().PkgPath()
+
+type option struct {
+	Some  bool
+	Value any
+}
+
+type result struct {
+	Ok    bool
+	Value any
+
+	// Err holds the message of the error of an Err, since errors are commonly
+	// pointers to types with unexported fields.
+	Err string
+}
+
+type either struct {
+	Left  any
+	Right any
+
+	IsLeft bool
+}
+
+// Options returns a [cmp.Option] that compares [gofp.Option], [gofp.Result]
+// and [gofp.Either] values by their contents.
+//
+// Two Options are equal if both are None, or both are Some holding equal
+// values. Two Results are equal if both are Ok holding equal values, or both
+// are Err holding errors with the same message; their stack traces are not
+// compared. Two Eithers are equal if both are Left or both are Right, holding
+// equal values.
+func Options() cmp.Option {
+	return cmp.Options{
+		transformer("Option", func(v reflect.Value) any {
+			value, ok := tryUnwrap(v, "TryUnwrap")
+			if !ok {
+				return option{}
+			}
+			return option{Some: true, Value: value}
+		}),
+		transformer("Result", func(v reflect.Value) any {
+			if value, ok := tryUnwrap(v, "TryUnwrap"); ok {
+				return result{Ok: true, Value: value}
+			}
+			err := call(v, "UnwrapErr")[0].Interface().(error)
+			return result{Err: err.Error()}
+		}),
+		transformer("Either", func(v reflect.Value) any {
+			if value, ok := tryUnwrap(v, "TryUnwrapLeft"); ok {
+				return either{Left: value, IsLeft: true}
+			}
+			value, _ := tryUnwrap(v, "TryUnwrap")
+			return either{Right: value}
+		}),
+	}
+}
+
+// transformer returns a [cmp.Option] that applies f to every value of an
+// instantiation of the named generic type from the gofp package.
+func transformer(name string, f func(reflect.Value) any) cmp.Option {
+	return cmp.FilterPath(func(p cmp.Path) bool {
+		return isInstance(p.Last().Type(), name)
+	}, cmp.Transformer("gofpcmp."+name, func(v any) any {
+		return f(reflect.ValueOf(v))
+	}))
+}
+
+func isInstance(t reflect.Type, name string) bool {
+	if t == nil || t.Kind() != reflect.Struct || t.PkgPath() != pkgPath {
+		return false
+	}
+	base, _, _ := strings.Cut(t.Name(), "[")
+	return base == name
+}
+
+// tryUnwrap calls a method of v with the signature func() (T, bool).
+func tryUnwrap(v reflect.Value, method string) (any, bool) {
+	out := call(v, method)
+	if !out[1].Bool() {
+		return nil, false
+	}
+	return out[0].Interface(), true
+}
+
+func call(v reflect.Value, method string) []reflect.Value {
+	return v.MethodByName(method).Call(nil)
+}
@@ -0,0 +1,96 @@
+package gofpcmp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/gofpcmp"
+)
+
+type user struct {
+	Name     string
+	Nickname gofp.Option[string]
+	Age      gofp.Result[int]
+	Contact  gofp.Either[string, int]
+	Friends  []gofp.Option[user]
+}
+
+func TestOptions(t *testing.T) {
+	base := user{
+		Name:     "Alice",
+		Nickname: gofp.Some("Al"),
+		Age:      gofp.Ok(30),
+		Contact:  gofp.Left[string, int]("alice@example.com"),
+	}
+
+	tests := map[string]struct {
+		modify func(u user) user
+		equal  bool
+	}{
+		"identical": {
+			modify: func(u user) user { return u },
+			equal:  true,
+		},
+		"different Some values": {
+			modify: func(u user) user { u.Nickname = gofp.Some("Ally"); return u },
+		},
+		"Some and None": {
+			modify: func(u user) user { u.Nickname = gofp.None[string](); return u },
+		},
+		"different Ok values": {
+			modify: func(u user) user { u.Age = gofp.Ok(31); return u },
+		},
+		"Ok and Err": {
+			modify: func(u user) user { u.Age = gofp.Err[int](errors.New("unknown")); return u },
+		},
+		"Left and Right": {
+			modify: func(u user) user { u.Contact = gofp.Right[string](5550100); return u },
+		},
+		"nested containers": {
+			modify: func(u user) user {
+				u.Friends = []gofp.Option[user]{gofp.Some(user{Name: "Bob", Nickname: gofp.Some("B")})}
+				return u
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := cmp.Equal(base, tt.modify(base), gofpcmp.Options())
+			if got != tt.equal {
+				t.Errorf("expected %v, got %v", tt.equal, got)
+			}
+		})
+	}
+}
+
+func TestOptions_Err(t *testing.T) {
+	t.Run("equal messages are equal", func(t *testing.T) {
+		a := gofp.Err[int](errors.New("not found"))
+		b := gofp.Err[int](errors.New("not found"))
+		if !cmp.Equal(a, b, gofpcmp.Options()) {
+			t.Errorf("expected errors with equal messages to be equal")
+		}
+	})
+
+	t.Run("different messages are not equal", func(t *testing.T) {
+		a := gofp.Err[int](errors.New("not found"))
+		b := gofp.Err[int](errors.New("forbidden"))
+		if cmp.Equal(a, b, gofpcmp.Options()) {
+			t.Errorf("expected errors with different messages not to be equal")
+		}
+	})
+}
+
+func TestOptions_Diff(t *testing.T) {
+	want := user{Name: "Alice", Nickname: gofp.Some("Al")}
+	got := user{Name: "Alice", Nickname: gofp.None[string]()}
+
+	diff := cmp.Diff(want, got, gofpcmp.Options())
+	if diff == "" {
+		t.Fatal("expected a diff, got none")
+	}
+}
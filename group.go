@@ -0,0 +1,107 @@
+package gofp
+
+import (
+	"context"
+	"sync"
+)
+
+// Group runs a collection of computations concurrently and collects their
+// results. It mirrors errgroup.Group, but each computation returns a [Result]
+// holding a typed value. A zero Group is valid and does not cancel on error.
+//
+// Type parameter T represents the value type.
+type Group[T any] struct {
+	cancel func(error)
+
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	results []Result[T]
+	err     Option[Result[[]T]]
+}
+
+// GroupWithContext returns a new [Group] and an associated context derived
+// from ctx. The derived context is canceled the first time a computation
+// passed to [Group.Go] returns an Err, or the first time [Group.Wait] or
+// [Group.WaitAll] returns, whichever occurs first.
+func GroupWithContext[T any](ctx context.Context) (*Group[T], context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Group[T]{cancel: cancel}, ctx
+}
+
+// GroupWithCancelToken returns a new [Group] and an associated
+// [*CancelToken] derived from parent, which may be nil. The derived token is
+// canceled the first time a computation passed to [Group.Go] returns an Err,
+// with its error as the cause, or the first time [Group.Wait] or
+// [Group.WaitAll] returns, whichever occurs first. It is the counterpart of
+// [GroupWithContext] for code that does not use contexts.
+func GroupWithCancelToken[T any](parent *CancelToken) (*Group[T], *CancelToken) {
+	tok, cancel := NewCancelToken(parent)
+	return &Group[T]{cancel: cancel}, tok
+}
+
+// Go runs the given computation in a new goroutine. The order in which
+// computations are passed to Go determines the order of the values returned by
+// [Group.Wait] and [Group.WaitAll].
+func (g *Group[T]) Go(fn func() Result[T]) {
+	g.mu.Lock()
+	i := len(g.results)
+	g.results = append(g.results, Result[T]{})
+	g.mu.Unlock()
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		r := fn()
+
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		g.results[i] = r
+		if r.isErr && g.err.IsNone() {
+			g.err = Some(Result[[]T]{err: r.err, isErr: true, stack: r.stack, meta: r.meta})
+			if g.cancel != nil {
+				g.cancel(r.err)
+			}
+		}
+	}()
+}
+
+// Wait blocks until all computations have completed. If every computation
+// returned Ok, it returns Ok with a slice of all values. Otherwise it returns
+// the first Err to have occurred.
+func (g *Group[T]) Wait() Result[[]T] {
+	g.wait()
+	if r, ok := g.err.TryUnwrap(); ok {
+		return r
+	}
+	values := make([]T, len(g.results))
+	for i, r := range g.results {
+		values[i] = r.value
+	}
+	return Ok(values)
+}
+
+// WaitAll blocks until all computations have completed and returns the values
+// of those that returned Ok and the errors of those that returned an Err, each
+// in the order the computations were passed to [Group.Go].
+func (g *Group[T]) WaitAll() ([]T, []error) {
+	g.wait()
+	var (
+		values []T
+		errs   []error
+	)
+	for _, r := range g.results {
+		if r.isErr {
+			errs = append(errs, r.err)
+			continue
+		}
+		values = append(values, r.value)
+	}
+	return values, errs
+}
+
+func (g *Group[T]) wait() {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(nil)
+	}
+}
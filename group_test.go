@@ -0,0 +1,103 @@
+package gofp_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestGroup_Wait(t *testing.T) {
+	t.Run("returns values in order", func(t *testing.T) {
+		var g gofp.Group[int]
+		for i := 0; i < 5; i++ {
+			g.Go(func() gofp.Result[int] {
+				time.Sleep(time.Duration(5-i) * time.Millisecond)
+				return gofp.Ok(i)
+			})
+		}
+		got := g.Wait()
+		if !reflect.DeepEqual(got.Unwrap(), []int{0, 1, 2, 3, 4}) {
+			t.Errorf("expected [0 1 2 3 4], got %v", got)
+		}
+	})
+
+	t.Run("returns first error", func(t *testing.T) {
+		errFailed := errors.New("failed")
+		var g gofp.Group[int]
+		g.Go(func() gofp.Result[int] { return gofp.Ok(1) })
+		g.Go(func() gofp.Result[int] { return gofp.Err[int](errFailed) })
+		if got := g.Wait(); got.UnwrapErr() != errFailed {
+			t.Errorf("expected %v, got %v", errFailed, got)
+		}
+	})
+}
+
+func TestGroup_WaitAll(t *testing.T) {
+	errFailed := errors.New("failed")
+	var g gofp.Group[int]
+	g.Go(func() gofp.Result[int] { return gofp.Ok(1) })
+	g.Go(func() gofp.Result[int] { return gofp.Err[int](errFailed) })
+	g.Go(func() gofp.Result[int] { return gofp.Ok(3) })
+
+	values, errs := g.WaitAll()
+	if !reflect.DeepEqual(values, []int{1, 3}) {
+		t.Errorf("expected [1 3], got %v", values)
+	}
+	if len(errs) != 1 || errs[0] != errFailed {
+		t.Errorf("expected [%v], got %v", errFailed, errs)
+	}
+}
+
+func TestGroupWithContext(t *testing.T) {
+	errFailed := errors.New("failed")
+	g, ctx := gofp.GroupWithContext[int](context.Background())
+	g.Go(func() gofp.Result[int] { return gofp.Err[int](errFailed) })
+	g.Go(func() gofp.Result[int] {
+		<-ctx.Done()
+		return gofp.Err[int](ctx.Err())
+	})
+
+	if got := g.Wait(); got.UnwrapErr() != errFailed {
+		t.Errorf("expected %v, got %v", errFailed, got)
+	}
+	if context.Cause(ctx) != errFailed {
+		t.Errorf("expected context cause %v, got %v", errFailed, context.Cause(ctx))
+	}
+}
+
+func TestGroupWithCancelToken(t *testing.T) {
+	t.Run("cancels the token on the first Err", func(t *testing.T) {
+		errFailed := errors.New("failed")
+		g, tok := gofp.GroupWithCancelToken[int](nil)
+		g.Go(func() gofp.Result[int] { return gofp.Err[int](errFailed) })
+		g.Go(func() gofp.Result[int] {
+			<-tok.Done()
+			return gofp.Err[int](tok.IsCanceled().Unwrap())
+		})
+
+		if got := g.Wait(); got.UnwrapErr() != errFailed {
+			t.Errorf("expected %v, got %v", errFailed, got)
+		}
+		if cause := tok.IsCanceled(); cause.Unwrap() != errFailed {
+			t.Errorf("expected cause %v, got %v", errFailed, cause)
+		}
+	})
+
+	t.Run("is canceled with its parent", func(t *testing.T) {
+		parent, cancel := gofp.NewCancelToken(nil)
+		g, tok := gofp.GroupWithCancelToken[int](parent)
+		g.Go(func() gofp.Result[int] {
+			<-tok.Done()
+			return gofp.Err[int](tok.IsCanceled().Unwrap())
+		})
+		cancel(nil)
+
+		if got := g.Wait(); got.UnwrapErr() != gofp.ErrCanceled {
+			t.Errorf("expected %v, got %v", gofp.ErrCanceled, got)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+module github.com/tomasbasham/gofp/grpcfp
+
+go 1.24.0
+
+require (
+	github.com/tomasbasham/gofp v0.0.0-00010101000000-000000000000
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260120221211-b8f7ae30c516
+	google.golang.org/grpc v1.80.0
+)
+
+require (
+	golang.org/x/net v0.50.0 // indirect
+	golang.org/x/sys v0.41.0 // indirect
+	golang.org/x/text v0.34.0 // indirect
+	google.golang.org/protobuf v1.36.11 // indirect
+)
+
+replace github.com/tomasbasham/gofp => ../
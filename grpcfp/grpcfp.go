@@ -0,0 +1,151 @@
+// Package grpcfp translates between [gofp.CodedError] values and gRPC
+// statuses.
+//
+// On the server, [UnaryServerInterceptor] and [StreamServerInterceptor]
+// convert an error returned by a handler into a [status.Status] whose code is
+// that of the first [*gofp.CodedError] in the error's tree, as given by
+// [gofp.Code.GRPCCode]. Handlers may therefore return the same classified
+// errors that they would return to any other caller, rather than constructing
+// statuses themselves. Codes registered with [gofp.RegisterCode] are
+// translated using their registered gRPC code.
+//
+// On the client, [UnaryClientInterceptor] and [StreamClientInterceptor] convert
+// a status back into a [*gofp.CodedError], and [Result] converts the return
+// values of a generated client method into a [gofp.Result]:
+//
+//	user := grpcfp.Result(client.GetUser(ctx, req))
+//
+// The code and metadata of an error are sent to the client in an
+// [errdetails.ErrorInfo], so that application defined codes, which share a
+// gRPC code with a canonical code, survive the round trip.
+//
+// The package is a module of its own, so that programs using gofp without
+// gRPC do not depend on it:
+//
+//	go get github.com/tomasbasham/gofp/grpcfp
+package grpcfp
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// Domain is the domain of the [errdetails.ErrorInfo] that carries the code and
+// metadata of an error.
+const Domain = "github.com/tomasbasham/gofp"
+
+// ToStatus returns the [status.Status] describing err. An error containing a
+// [*gofp.CodedError] is given its code and metadata, and any other error that
+// already carries a status, such as one returned by another gRPC call, keeps
+// it. All other errors are [codes.Unknown]. A nil error has an OK status.
+func ToStatus(err error) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+
+	var coded *gofp.CodedError
+	if !errors.As(err, &coded) {
+		return status.Convert(err)
+	}
+
+	msg := err.Error()
+	if err == error(coded) {
+		// The code is carried by the status, so is not repeated in its message.
+		msg = strings.TrimPrefix(msg, string(coded.Code)+": ")
+	}
+
+	s := status.New(codes.Code(coded.Code.GRPCCode()), msg)
+	detailed, detailsErr := s.WithDetails(&errdetails.ErrorInfo{
+		Reason:   string(coded.Code),
+		Domain:   Domain,
+		Metadata: coded.Metadata,
+	})
+	if detailsErr != nil {
+		return s
+	}
+	return detailed
+}
+
+// FromStatus returns a [*gofp.CodedError] describing a status error, such as
+// one returned by a gRPC call. Its code and metadata are those sent by a
+// server using this package, or otherwise the canonical code of the status,
+// as given by [gofp.CodeFromGRPCCode]. Errors that do not carry a status are
+// returned unchanged, and a nil error or OK status returns nil.
+func FromStatus(err error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	if s.Code() == codes.OK {
+		return nil
+	}
+
+	coded := gofp.NewCodedError(gofp.CodeFromGRPCCode(uint32(s.Code())), s.Message())
+	for _, detail := range s.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetDomain() == Domain {
+			coded.Code = gofp.Code(info.GetReason())
+			coded.Metadata = info.GetMetadata()
+			break
+		}
+	}
+	return coded
+}
+
+// Result converts the return values of a gRPC client method into a
+// [gofp.Result], converting a status error into a [*gofp.CodedError] using
+// [FromStatus].
+func Result[T any](resp T, err error) gofp.Result[T] {
+	return gofp.FromReturn(resp, FromStatus(err))
+}
+
+// UnaryServerInterceptor returns a [grpc.UnaryServerInterceptor] that converts
+// errors returned by handlers into statuses using [ToStatus].
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, toStatusError(err)
+	}
+}
+
+// StreamServerInterceptor returns a [grpc.StreamServerInterceptor] that
+// converts errors returned by handlers into statuses using [ToStatus].
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return toStatusError(handler(srv, ss))
+	}
+}
+
+// UnaryClientInterceptor returns a [grpc.UnaryClientInterceptor] that
+// converts status errors returned by calls into [*gofp.CodedError] values using
+// [FromStatus].
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return FromStatus(invoker(ctx, method, req, reply, cc, opts...))
+	}
+}
+
+// StreamClientInterceptor returns a [grpc.StreamClientInterceptor] that
+// converts a status error returned when opening a stream into a
+// [*gofp.CodedError] using [FromStatus]. Errors returned by the methods of the
+// stream itself are not converted.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		return cs, FromStatus(err)
+	}
+}
+
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return ToStatus(err).Err()
+}
@@ -0,0 +1,135 @@
+package grpcfp_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+	"github.com/tomasbasham/gofp/grpcfp"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{"nil", nil, codes.OK, ""},
+		{"coded", gofp.NewCodedError(gofp.CodeNotFound, "user 1"), codes.NotFound, "user 1"},
+		{"wrapped", fmt.Errorf("loading: %w", gofp.NewCodedError(gofp.CodeNotFound, "user 1")), codes.NotFound, "loading: NOT_FOUND: user 1"},
+		{"status", status.Error(codes.Unavailable, "down"), codes.Unavailable, "down"},
+		{"plain", errors.New("boom"), codes.Unknown, "boom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := grpcfp.ToStatus(tt.err)
+			if s.Code() != tt.wantCode || s.Message() != tt.wantMsg {
+				t.Errorf("expected %v %q, got %v %q", tt.wantCode, tt.wantMsg, s.Code(), s.Message())
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Run("canonical code", func(t *testing.T) {
+		sent := gofp.NewCodedError(gofp.CodeNotFound, "user 1").WithMetadata("id", "1")
+		err := grpcfp.FromStatus(grpcfp.ToStatus(sent).Err())
+
+		var coded *gofp.CodedError
+		if !errors.As(err, &coded) {
+			t.Fatalf("expected CodedError, got %v", err)
+		}
+		if coded.Code != gofp.CodeNotFound || coded.Message != "user 1" || coded.Metadata["id"] != "1" {
+			t.Errorf("expected %v with metadata, got %v %v", sent, coded, coded.Metadata)
+		}
+	})
+
+	t.Run("application defined code", func(t *testing.T) {
+		insufficient := gofp.Code("GRPCFP_INSUFFICIENT_FUNDS")
+		gofp.RegisterCode(insufficient, 422, uint32(codes.FailedPrecondition))
+
+		s := grpcfp.ToStatus(gofp.NewCodedError(insufficient, "balance too low"))
+		if s.Code() != codes.FailedPrecondition {
+			t.Errorf("expected FailedPrecondition, got %v", s.Code())
+		}
+		if got := gofp.CodeOf(grpcfp.FromStatus(s.Err())); got != insufficient {
+			t.Errorf("expected %v, got %v", insufficient, got)
+		}
+	})
+
+	t.Run("status from another server", func(t *testing.T) {
+		err := grpcfp.FromStatus(status.Error(codes.PermissionDenied, "denied"))
+		if gofp.CodeOf(err) != gofp.CodePermissionDenied || err.Error() != "PERMISSION_DENIED: denied" {
+			t.Errorf("expected PERMISSION_DENIED: denied, got %v", err)
+		}
+	})
+
+	t.Run("non-status errors", func(t *testing.T) {
+		plain := errors.New("boom")
+		if err := grpcfp.FromStatus(plain); err != plain {
+			t.Errorf("expected error to be unchanged, got %v", err)
+		}
+		if err := grpcfp.FromStatus(nil); err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+	})
+}
+
+func TestResult(t *testing.T) {
+	assert.OkEqual(t, grpcfp.Result("reply", nil), "reply")
+
+	r := grpcfp.Result("", status.Error(codes.NotFound, "missing"))
+	if gofp.CodeOf(assert.Err(t, r)) != gofp.CodeNotFound {
+		t.Errorf("expected NOT_FOUND, got %v", r)
+	}
+}
+
+func TestInterceptors(t *testing.T) {
+	ctx := context.Background()
+	notFound := gofp.NewCodedError(gofp.CodeNotFound, "user 1")
+
+	t.Run("unary server", func(t *testing.T) {
+		_, err := grpcfp.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+			return nil, notFound
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("stream server", func(t *testing.T) {
+		err := grpcfp.StreamServerInterceptor()(nil, nil, &grpc.StreamServerInfo{}, func(any, grpc.ServerStream) error {
+			return notFound
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("unary client", func(t *testing.T) {
+		err := grpcfp.UnaryClientInterceptor()(ctx, "/svc/Method", nil, nil, nil, func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+			return grpcfp.ToStatus(notFound).Err()
+		})
+		if gofp.CodeOf(err) != gofp.CodeNotFound {
+			t.Errorf("expected NOT_FOUND, got %v", err)
+		}
+	})
+
+	t.Run("stream client", func(t *testing.T) {
+		_, err := grpcfp.StreamClientInterceptor()(ctx, &grpc.StreamDesc{}, nil, "/svc/Method", func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+			return nil, grpcfp.ToStatus(notFound).Err()
+		})
+		if gofp.CodeOf(err) != gofp.CodeNotFound {
+			t.Errorf("expected NOT_FOUND, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package gofp
+
+// GuardOption returns Some with the given value if the condition holds,
+// otherwise None.
+func GuardOption[T any](cond bool, v T) Option[T] {
+	if !cond {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// GuardResult returns Ok with the given value if the condition holds, otherwise
+// an Err with the given error.
+func GuardResult[T any](cond bool, v T, err error) Result[T] {
+	if !cond {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// When runs the given action only if the condition holds. Otherwise it returns
+// Ok with [UnitValue] without running the action.
+func When(cond bool, fn func() Result[Unit]) Result[Unit] {
+	if !cond {
+		return Ok(UnitValue)
+	}
+	return fn()
+}
+
+// Unless runs the given action only if the condition does not hold. Otherwise
+// it returns Ok with [UnitValue] without running the action.
+func Unless(cond bool, fn func() Result[Unit]) Result[Unit] {
+	return When(!cond, fn)
+}
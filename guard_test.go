@@ -0,0 +1,47 @@
+package gofp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestGuardOption(t *testing.T) {
+	if got := gofp.GuardOption(true, 1); got.Unwrap() != 1 {
+		t.Errorf("expected Some(1), got %v", got)
+	}
+	if got := gofp.GuardOption(false, 1); !got.IsNone() {
+		t.Errorf("expected None, got %v", got)
+	}
+}
+
+func TestGuardResult(t *testing.T) {
+	errGuard := errors.New("guard failed")
+	if got := gofp.GuardResult(true, 1, errGuard); got.Unwrap() != 1 {
+		t.Errorf("expected Ok(1), got %v", got)
+	}
+	if got := gofp.GuardResult(false, 1, errGuard); got.UnwrapErr() != errGuard {
+		t.Errorf("expected Err(%v), got %v", errGuard, got)
+	}
+}
+
+func TestWhen(t *testing.T) {
+	errAction := errors.New("action failed")
+	action := func() gofp.Result[gofp.Unit] {
+		return gofp.Err[gofp.Unit](errAction)
+	}
+
+	if got := gofp.When(true, action); got.UnwrapErr() != errAction {
+		t.Errorf("expected action to run, got %v", got)
+	}
+	if got := gofp.When(false, action); !got.IsOk() {
+		t.Errorf("expected action not to run, got %v", got)
+	}
+	if got := gofp.Unless(true, action); !got.IsOk() {
+		t.Errorf("expected action not to run, got %v", got)
+	}
+	if got := gofp.Unless(false, action); got.UnwrapErr() != errAction {
+		t.Errorf("expected action to run, got %v", got)
+	}
+}
@@ -0,0 +1,35 @@
+package httpfpclient_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/httpfpclient"
+)
+
+func ExampleGet() {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/1" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"name":"alice"}`)
+	}))
+	defer srv.Close()
+
+	type User struct {
+		Name string `json:"name"`
+	}
+
+	ctx := context.Background()
+	fmt.Println(httpfpclient.Get(ctx, srv.URL+"/users/1", httpfpclient.JSON[User]()).UnwrapOr(User{}).Name)
+
+	r := httpfpclient.Get(ctx, srv.URL+"/users/2", httpfpclient.JSON[User]())
+	fmt.Println(gofp.CodeOf(r.UnwrapErr()))
+	// Output:
+	// alice
+	// NOT_FOUND
+}
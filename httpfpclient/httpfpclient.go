@@ -0,0 +1,337 @@
+// Package httpfpclient makes HTTP requests whose results are [gofp.Result]
+// values with classified errors.
+//
+// Calling an API usually takes the same steps: build a request, send it, check
+// the status, decode the body and close it. [Get] and [Do] perform all of
+// them, so that the common case is a single call:
+//
+//	user := httpfpclient.Get(ctx, "https://api.example.com/users/1", httpfpclient.JSON[User]())
+//
+// A response with a status outside the 2xx range is an Err holding a
+// [*gofp.CodedError] whose code is derived from the status using
+// [gofp.CodeFromHTTPStatus], and whose cause is a [*StatusError] describing
+// the response. Errors may therefore be handled by their code without
+// inspecting the response.
+//
+// Requests are sent by a [Transport], which may be wrapped by [Middleware]
+// such as [Retry], [Breaker] and [Trace] to add behaviour to every request.
+package httpfpclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// ErrBreakerOpen is the error returned by a [Breaker] for a request that it
+// rejects without sending.
+var ErrBreakerOpen = gofp.NewCodedError(gofp.CodeUnavailable, "circuit breaker open")
+
+// maxErrorBody is the number of bytes of the body of an unsuccessful response
+// retained by a [StatusError].
+const maxErrorBody = 4096
+
+// StatusError describes a response whose status was not in the 2xx range.
+type StatusError struct {
+	// StatusCode is the status code of the response, such as 404.
+	StatusCode int
+
+	// Status is the status line of the response, such as "404 Not Found".
+	Status string
+
+	// Body holds up to the first 4KiB of the body of the response, which often
+	// describes the error.
+	Body []byte
+}
+
+// Error implements the error interface.
+func (e *StatusError) Error() string {
+	return "unexpected status " + e.Status
+}
+
+// Transport sends a request and returns its response, or an Err if no
+// response was received. Responses of any status are Ok.
+type Transport func(*http.Request) gofp.Result[*http.Response]
+
+// FromClient returns a [Transport] that sends requests using c.
+func FromClient(c *http.Client) Transport {
+	return func(req *http.Request) gofp.Result[*http.Response] {
+		return gofp.FromReturn(c.Do(req))
+	}
+}
+
+// DefaultTransport sends requests using [http.DefaultClient]. It is used by
+// [Get].
+var DefaultTransport = FromClient(http.DefaultClient)
+
+// Middleware wraps a [Transport] to add behaviour to every request it sends.
+type Middleware func(Transport) Transport
+
+// Chain wraps t with each middleware in turn, so that the first middleware is
+// the outermost and sees each request first.
+func Chain(t Transport, middleware ...Middleware) Transport {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		t = middleware[i](t)
+	}
+	return t
+}
+
+// Decoder reads a value from the body of a response.
+//
+// Type parameter T represents the decoded value type.
+type Decoder[T any] func(io.Reader) gofp.Result[T]
+
+// JSON returns a [Decoder] that decodes a JSON body into a value of type T.
+// A body that cannot be decoded is an Err classified as [gofp.CodeDataLoss].
+func JSON[T any]() Decoder[T] {
+	return func(r io.Reader) gofp.Result[T] {
+		var v T
+		if err := json.NewDecoder(r).Decode(&v); err != nil {
+			return gofp.Err[T](gofp.NewCodedError(gofp.CodeDataLoss, "decoding response").WithCause(err))
+		}
+		return gofp.Ok(v)
+	}
+}
+
+// Get sends a GET request for url using [DefaultTransport], and decodes the
+// body of a successful response. See [Do].
+func Get[T any](ctx context.Context, url string, decode Decoder[T]) gofp.Result[T] {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return gofp.Err[T](gofp.NewCodedError(gofp.CodeInvalidArgument, "building request").WithCause(err))
+	}
+	return Do(DefaultTransport, req, decode)
+}
+
+// Do sends the request using t and decodes the body of the response, which is
+// always closed. If no response is received the result is an Err classified
+// by the context of the request, or as [gofp.CodeUnavailable]. If the status
+// of the response is outside the 2xx range the body is not decoded, and the
+// result is an Err classified by the status, whose cause is a [*StatusError].
+func Do[T any](t Transport, req *http.Request, decode Decoder[T]) gofp.Result[T] {
+	return gofp.ResultFlatMap(send(t, req), func(resp *http.Response) gofp.Result[T] {
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+			cause := &StatusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: body}
+			return gofp.Err[T](gofp.NewCodedError(gofp.CodeFromHTTPStatus(resp.StatusCode), req.Method+" "+req.URL.Redacted()).WithCause(cause))
+		}
+		return decode(resp.Body)
+	})
+}
+
+func send(t Transport, req *http.Request) gofp.Result[*http.Response] {
+	return t(req).OrElse(func(err error) gofp.Result[*http.Response] {
+		code := gofp.CodeUnavailable
+		switch {
+		case errors.Is(err, context.Canceled):
+			code = gofp.CodeCanceled
+		case errors.Is(err, context.DeadlineExceeded):
+			code = gofp.CodeDeadlineExceeded
+		}
+		return gofp.Err[*http.Response](gofp.NewCodedError(code, req.Method+" "+req.URL.Redacted()).WithCause(err))
+	})
+}
+
+// Retry returns a [Middleware] that sends a request up to attempts times
+// while no response is received or the response has a status of 429, 502, 503
+// or 504. The delay before each retry starts at backoff and doubles after each
+// attempt. Retrying stops early if the context of the request is done, with
+// the error given by [gofp.ContextError].
+//
+// A request with a body is retried only if it has a GetBody function, as set
+// by [http.NewRequest] for common body types, so that the body may be sent
+// again. A request rejected by a [Breaker] with [ErrBreakerOpen] is not
+// retried.
+func Retry(attempts int, backoff time.Duration) Middleware {
+	return func(next Transport) Transport {
+		return func(req *http.Request) gofp.Result[*http.Response] {
+			r := next(req)
+			for attempt := 1; attempt < attempts && retryable(r) && rewindable(req); attempt++ {
+				if resp, ok := r.TryUnwrap(); ok {
+					io.Copy(io.Discard, resp.Body)
+					resp.Body.Close()
+				}
+
+				select {
+				case <-req.Context().Done():
+					return gofp.Err[*http.Response](gofp.ContextError(req.Context()))
+				case <-time.After(backoff << (attempt - 1)):
+				}
+
+				retry, err := rewind(req)
+				if err != nil {
+					return gofp.Err[*http.Response](err)
+				}
+				r = next(retry)
+			}
+			return r
+		}
+	}
+}
+
+func retryable(r gofp.Result[*http.Response]) bool {
+	resp, ok := r.TryUnwrap()
+	if !ok {
+		return !errors.Is(r.UnwrapErr(), ErrBreakerOpen)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func rewindable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewind returns a copy of the request whose body may be read again.
+func rewind(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("rewinding request body: %w", err)
+		}
+		retry.Body = body
+	}
+	return retry, nil
+}
+
+// Breaker returns a [Middleware] that stops sending requests to a failing
+// server. A request fails if no response is received or the response has a
+// status of 500 or above, but not if its own context is done. After threshold
+// consecutive failures the circuit opens, and requests are rejected with
+// [ErrBreakerOpen] for the cooldown. A single request is then sent as a
+// trial, whilst others are still rejected, and the circuit closes if it
+// succeeds or opens again if it fails.
+//
+// Each [Transport] wrapped by the middleware has a circuit of its own, shared
+// by every request sent through it. Placed outside [Retry] each request
+// counts once however many attempts it takes, and placed inside it every
+// attempt counts.
+func Breaker(threshold int, cooldown time.Duration) Middleware {
+	return func(next Transport) Transport {
+		c := &circuit{threshold: threshold, cooldown: cooldown}
+		return func(req *http.Request) gofp.Result[*http.Response] {
+			if !c.allow() {
+				return gofp.Err[*http.Response](ErrBreakerOpen)
+			}
+			r := next(req)
+			if req.Context().Err() != nil {
+				c.release()
+			} else {
+				c.record(failed(r))
+			}
+			return r
+		}
+	}
+}
+
+// circuit holds the state of a [Breaker] for a single [Transport]. The circuit
+// is open whilst failures is at least threshold.
+type circuit struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+// allow reports whether a request may be sent, starting a trial if the
+// circuit is open and its cooldown has passed.
+func (c *circuit) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case c.failures < c.threshold:
+		return true
+	case c.trial || time.Now().Before(c.openUntil):
+		return false
+	}
+	c.trial = true
+	return true
+}
+
+// record records the outcome of a request that was allowed.
+func (c *circuit) record(failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.trial = false
+	if !failed {
+		c.failures = 0
+		return
+	}
+	c.failures++
+	if c.failures >= c.threshold {
+		c.openUntil = time.Now().Add(c.cooldown)
+	}
+}
+
+// release ends a request that was allowed without recording its outcome, so
+// that another request may be sent as a trial.
+func (c *circuit) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.trial = false
+}
+
+func failed(r gofp.Result[*http.Response]) bool {
+	resp, ok := r.TryUnwrap()
+	return !ok || resp.StatusCode >= 500
+}
+
+// TraceInfo describes a request sent through a [Trace] middleware.
+type TraceInfo struct {
+	Method string
+	URL    string
+
+	// Start is the time at which the request was sent.
+	Start time.Time
+
+	// Duration is the time taken to receive the response headers, or to fail.
+	Duration time.Duration
+
+	// StatusCode is the status code of the response, or zero if no response
+	// was received.
+	StatusCode int
+
+	// Err is the error that prevented a response from being received, if any.
+	Err error
+}
+
+// Trace returns a [Middleware] that calls hook once for every request sent
+// through it, after its response is received or it fails. Placed inside
+// [Retry] it reports every attempt, and placed outside it reports only the
+// final one.
+func Trace(hook func(TraceInfo)) Middleware {
+	return func(next Transport) Transport {
+		return func(req *http.Request) gofp.Result[*http.Response] {
+			start := time.Now()
+			r := next(req)
+			info := TraceInfo{
+				Method:   req.Method,
+				URL:      req.URL.Redacted(),
+				Start:    start,
+				Duration: time.Since(start),
+			}
+			if resp, ok := r.TryUnwrap(); ok {
+				info.StatusCode = resp.StatusCode
+			} else {
+				info.Err = r.UnwrapErr()
+			}
+			hook(info)
+			return r
+		}
+	}
+}
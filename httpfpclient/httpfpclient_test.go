@@ -0,0 +1,225 @@
+package httpfpclient_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+	"github.com/tomasbasham/gofp/httpfpclient"
+)
+
+type user struct {
+	Name string `json:"name"`
+}
+
+func newServer(t *testing.T, failures int32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"alice"}`)
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no such user", http.StatusNotFound)
+	})
+	mux.HandleFunc("/invalid", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":`)
+	})
+	mux.HandleFunc("/flaky", func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"name":"bob"}`)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestGet(t *testing.T) {
+	srv, _ := newServer(t, 0)
+	ctx := context.Background()
+
+	t.Run("decodes successful responses", func(t *testing.T) {
+		got := httpfpclient.Get(ctx, srv.URL+"/user", httpfpclient.JSON[user]())
+		assert.OkEqual(t, got, user{Name: "alice"})
+	})
+
+	t.Run("classifies unsuccessful responses", func(t *testing.T) {
+		got := httpfpclient.Get(ctx, srv.URL+"/missing", httpfpclient.JSON[user]())
+		err := assert.Err(t, got)
+		if gofp.CodeOf(err) != gofp.CodeNotFound {
+			t.Errorf("expected NOT_FOUND, got %v", err)
+		}
+		var status *httpfpclient.StatusError
+		if !errors.As(err, &status) || status.StatusCode != 404 || !strings.Contains(string(status.Body), "no such user") {
+			t.Errorf("expected StatusError for 404, got %v", err)
+		}
+	})
+
+	t.Run("classifies undecodable responses", func(t *testing.T) {
+		got := httpfpclient.Get(ctx, srv.URL+"/invalid", httpfpclient.JSON[user]())
+		if code := gofp.CodeOf(assert.Err(t, got)); code != gofp.CodeDataLoss {
+			t.Errorf("expected DATA_LOSS, got %v", code)
+		}
+	})
+
+	t.Run("classifies failed requests", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		got := httpfpclient.Get(canceled, srv.URL+"/user", httpfpclient.JSON[user]())
+		if code := gofp.CodeOf(assert.Err(t, got)); code != gofp.CodeCanceled {
+			t.Errorf("expected CANCELED, got %v", code)
+		}
+		assert.ErrIs(t, got, context.Canceled)
+	})
+
+	t.Run("invalid url", func(t *testing.T) {
+		got := httpfpclient.Get(ctx, "://", httpfpclient.JSON[user]())
+		if code := gofp.CodeOf(assert.Err(t, got)); code != gofp.CodeInvalidArgument {
+			t.Errorf("expected INVALID_ARGUMENT, got %v", code)
+		}
+	})
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("retries until success", func(t *testing.T) {
+		srv, calls := newServer(t, 2)
+		transport := httpfpclient.Chain(httpfpclient.FromClient(srv.Client()), httpfpclient.Retry(3, time.Millisecond))
+
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+"/flaky", nil)
+		got := httpfpclient.Do(transport, req, httpfpclient.JSON[user]())
+		assert.OkEqual(t, got, user{Name: "bob"})
+		if calls.Load() != 3 {
+			t.Errorf("expected 3 calls, got %d", calls.Load())
+		}
+	})
+
+	t.Run("gives up after attempts", func(t *testing.T) {
+		srv, calls := newServer(t, 5)
+		transport := httpfpclient.Chain(httpfpclient.FromClient(srv.Client()), httpfpclient.Retry(2, time.Millisecond))
+
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+"/flaky", nil)
+		got := httpfpclient.Do(transport, req, httpfpclient.JSON[user]())
+		if code := gofp.CodeOf(assert.Err(t, got)); code != gofp.CodeUnavailable {
+			t.Errorf("expected UNAVAILABLE, got %v", code)
+		}
+		if calls.Load() != 2 {
+			t.Errorf("expected 2 calls, got %d", calls.Load())
+		}
+	})
+
+	t.Run("resends bodies", func(t *testing.T) {
+		var bodies []string
+		attempts := 0
+		transport := httpfpclient.Retry(2, time.Millisecond)(func(req *http.Request) gofp.Result[*http.Response] {
+			attempts++
+			data, _ := io.ReadAll(req.Body)
+			bodies = append(bodies, string(data))
+			return gofp.Err[*http.Response](errors.New("connection reset"))
+		})
+
+		req, _ := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("payload"))
+		transport(req)
+		if attempts != 2 || bodies[0] != "payload" || bodies[1] != "payload" {
+			t.Errorf("expected body to be sent twice, got %q", bodies)
+		}
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		transport := httpfpclient.Retry(3, time.Hour)(func(*http.Request) gofp.Result[*http.Response] {
+			cancel()
+			return gofp.Err[*http.Response](errors.New("connection reset"))
+		})
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", nil)
+		assert.ErrIs(t, transport(req), gofp.ErrCanceled)
+	})
+}
+
+func TestBreaker(t *testing.T) {
+	var (
+		calls int
+		fail  = true
+	)
+	transport := httpfpclient.Breaker(2, 20*time.Millisecond)(func(*http.Request) gofp.Result[*http.Response] {
+		calls++
+		if fail {
+			return gofp.Err[*http.Response](errors.New("connection refused"))
+		}
+		return gofp.Ok(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody})
+	})
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		transport(req)
+		transport(req)
+		assert.ErrIs(t, transport(req), httpfpclient.ErrBreakerOpen)
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("opens again when the trial fails", func(t *testing.T) {
+		time.Sleep(30 * time.Millisecond)
+		assert.Err(t, transport(req))
+		assert.ErrIs(t, transport(req), httpfpclient.ErrBreakerOpen)
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("closes when the trial succeeds", func(t *testing.T) {
+		time.Sleep(30 * time.Millisecond)
+		fail = false
+		assert.Ok(t, transport(req))
+		assert.Ok(t, transport(req))
+		if calls != 5 {
+			t.Errorf("expected 5 calls, got %d", calls)
+		}
+	})
+
+	t.Run("is not retried when open", func(t *testing.T) {
+		var calls atomic.Int32
+		transport := httpfpclient.Chain(func(*http.Request) gofp.Result[*http.Response] {
+			calls.Add(1)
+			return gofp.Err[*http.Response](errors.New("connection refused"))
+		}, httpfpclient.Retry(5, time.Millisecond), httpfpclient.Breaker(1, time.Hour))
+
+		assert.ErrIs(t, transport(req), httpfpclient.ErrBreakerOpen)
+		if calls.Load() != 1 {
+			t.Errorf("expected 1 call, got %d", calls.Load())
+		}
+	})
+}
+
+func TestTrace(t *testing.T) {
+	srv, _ := newServer(t, 1)
+
+	var traces []httpfpclient.TraceInfo
+	transport := httpfpclient.Chain(httpfpclient.FromClient(srv.Client()),
+		httpfpclient.Retry(2, time.Millisecond),
+		httpfpclient.Trace(func(info httpfpclient.TraceInfo) { traces = append(traces, info) }),
+	)
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/flaky", nil)
+	assert.Ok(t, httpfpclient.Do(transport, req, httpfpclient.JSON[user]()))
+
+	if len(traces) != 2 || traces[0].StatusCode != 503 || traces[1].StatusCode != 200 {
+		t.Errorf("expected a trace of each attempt, got %+v", traces)
+	}
+	if traces[0].Method != http.MethodGet || traces[0].URL != srv.URL+"/flaky" {
+		t.Errorf("expected trace to describe the request, got %+v", traces[0])
+	}
+}
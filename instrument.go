@@ -0,0 +1,45 @@
+package gofp
+
+import (
+	"runtime"
+	"time"
+)
+
+// StageInfo describes a single measured stage of a computation. It is reported
+// by the Instrument functions of the monad packages.
+type StageInfo struct {
+	// Start is the time at which the stage began running.
+	Start time.Time
+
+	// Duration is the wall time taken to run the stage.
+	Duration time.Duration
+
+	// Allocs is the number of heap objects allocated whilst the stage ran.
+	Allocs uint64
+
+	// Bytes is the number of heap bytes allocated whilst the stage ran.
+	Bytes uint64
+}
+
+// Measure runs f and returns a [StageInfo] describing its wall time and heap
+// allocations. Allocations are read from process-wide memory statistics, so
+// they include any allocations made concurrently by other goroutines.
+//
+// Measuring allocations briefly stops the world and is therefore intended for
+// diagnostics rather than production use.
+func Measure(f func()) StageInfo {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+
+	f()
+
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+	return StageInfo{
+		Start:    start,
+		Duration: elapsed,
+		Allocs:   after.Mallocs - before.Mallocs,
+		Bytes:    after.TotalAlloc - before.TotalAlloc,
+	}
+}
@@ -0,0 +1,38 @@
+package gofp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+)
+
+var sink []byte
+
+func TestMeasure(t *testing.T) {
+	t.Run("reports wall time", func(t *testing.T) {
+		info := gofp.Measure(func() {
+			time.Sleep(5 * time.Millisecond)
+		})
+		if info.Duration < 5*time.Millisecond {
+			t.Errorf("expected at least 5ms, got %v", info.Duration)
+		}
+		if info.Start.IsZero() {
+			t.Error("expected start time to be set")
+		}
+	})
+
+	t.Run("reports allocations", func(t *testing.T) {
+		info := gofp.Measure(func() {
+			for i := 0; i < 10; i++ {
+				sink = make([]byte, 1024)
+			}
+		})
+		if info.Allocs < 10 {
+			t.Errorf("expected at least 10 allocations, got %d", info.Allocs)
+		}
+		if info.Bytes < 10*1024 {
+			t.Errorf("expected at least 10240 bytes, got %d", info.Bytes)
+		}
+	})
+}
@@ -0,0 +1,20 @@
+package journal_test
+
+import (
+	"fmt"
+
+	"github.com/tomasbasham/gofp/journal"
+)
+
+func ExampleJournal_Append() {
+	j := journal.New[int]()
+	j, deposit := j.Append(75)
+	j, _ = j.Append(-50)
+
+	balance := journal.Fold(j, 0, func(acc int, e journal.Entry[int]) int {
+		return acc + e.Value
+	})
+	fmt.Println(j.At(deposit), balance)
+	// Output:
+	// Some(75) 25
+}
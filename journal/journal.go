@@ -0,0 +1,145 @@
+// Package journal implements an immutable, append-only journal of entries.
+//
+// A [Journal] records a sequence of entries, each identified by a
+// monotonically increasing [ID]. Appending to a journal returns a new journal
+// and never modifies the original, so earlier versions remain valid and can be
+// queried at any time. Journals can be folded into a summary value and
+// compacted to discard entries that have already been summarised.
+package journal
+
+import (
+	"sync"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// ID uniquely identifies an entry within a [Journal].
+type ID uint64
+
+// Entry is a single entry in a [Journal].
+//
+// Type parameter E represents the entry value type.
+type Entry[E any] struct {
+	ID    ID
+	Value E
+}
+
+// log is the storage shared between journals derived from one another. Only
+// the journal at the tip of the log may append in place; any other journal
+// copies its entries before appending.
+type log[E any] struct {
+	mu      sync.Mutex
+	entries []Entry[E]
+}
+
+// Journal is an immutable, append-only sequence of entries. The zero value is
+// an empty journal ready to use.
+//
+// Type parameter E represents the entry value type.
+type Journal[E any] struct {
+	log   *log[E]
+	n     int
+	first ID
+}
+
+// New returns an empty [Journal].
+func New[E any]() Journal[E] {
+	return Journal[E]{}
+}
+
+// Append returns a new [Journal] with the given value appended, along with the
+// [ID] assigned to the new entry. The receiver is not modified.
+func (j Journal[E]) Append(value E) (Journal[E], ID) {
+	id := j.first + ID(j.n)
+	entry := Entry[E]{ID: id, Value: value}
+
+	var entries []Entry[E]
+	if j.log != nil {
+		j.log.mu.Lock()
+		defer j.log.mu.Unlock()
+		if len(j.log.entries) == j.n {
+			j.log.entries = append(j.log.entries, entry)
+			return Journal[E]{log: j.log, n: j.n + 1, first: j.first}, id
+		}
+		entries = j.log.entries[:j.n]
+	}
+
+	copied := make([]Entry[E], j.n, j.n+1)
+	copy(copied, entries)
+	copied = append(copied, entry)
+	return Journal[E]{log: &log[E]{entries: copied}, n: j.n + 1, first: j.first}, id
+}
+
+// At returns the value of the entry with the given [ID], or None if the
+// journal has no such entry.
+func (j Journal[E]) At(id ID) gofp.Option[E] {
+	if id < j.first || id >= j.first+ID(j.n) {
+		return gofp.None[E]()
+	}
+	return gofp.Some(j.view()[id-j.first].Value)
+}
+
+// Len returns the number of entries in the [Journal].
+func (j Journal[E]) Len() int {
+	return j.n
+}
+
+// Last returns the most recent entry, or None if the journal is empty.
+func (j Journal[E]) Last() gofp.Option[Entry[E]] {
+	if j.n == 0 {
+		return gofp.None[Entry[E]]()
+	}
+	return gofp.Some(j.view()[j.n-1])
+}
+
+// Entries returns a copy of the entries of the [Journal] in the order they were
+// appended.
+func (j Journal[E]) Entries() []Entry[E] {
+	entries := make([]Entry[E], j.n)
+	copy(entries, j.view())
+	return entries
+}
+
+func (j Journal[E]) view() []Entry[E] {
+	if j.log == nil {
+		return nil
+	}
+	j.log.mu.Lock()
+	defer j.log.mu.Unlock()
+	return j.log.entries[:j.n:j.n]
+}
+
+// Fold combines the entries of a [Journal], in the order they were appended,
+// into a single value.
+func Fold[E, S any](j Journal[E], initial S, f func(S, Entry[E]) S) S {
+	acc := initial
+	for _, e := range j.view() {
+		acc = f(acc, e)
+	}
+	return acc
+}
+
+// Compact folds all entries with an [ID] less than the given [ID] into a
+// snapshot value, and returns it along with a new [Journal] holding only the
+// remaining entries. The IDs of the remaining entries are preserved, and new
+// entries continue to receive increasing IDs.
+func Compact[E, S any](j Journal[E], before ID, initial S, f func(S, Entry[E]) S) (S, Journal[E]) {
+	entries := j.view()
+	cut := 0
+	for cut < len(entries) && entries[cut].ID < before {
+		cut++
+	}
+
+	snapshot := initial
+	for _, e := range entries[:cut] {
+		snapshot = f(snapshot, e)
+	}
+
+	remaining := make([]Entry[E], len(entries)-cut)
+	copy(remaining, entries[cut:])
+	return snapshot, Journal[E]{
+		log:   &log[E]{entries: remaining},
+		n:     len(remaining),
+		first: j.first + ID(cut),
+	}
+}
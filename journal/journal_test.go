@@ -0,0 +1,111 @@
+package journal_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/gofp/journal"
+)
+
+func values(j journal.Journal[int]) []int {
+	var vs []int
+	for _, e := range j.Entries() {
+		vs = append(vs, e.Value)
+	}
+	return vs
+}
+
+func TestJournal_Append(t *testing.T) {
+	t.Run("assigns increasing IDs", func(t *testing.T) {
+		j := journal.New[int]()
+		j, id1 := j.Append(10)
+		j, id2 := j.Append(20)
+		if id1 != 0 || id2 != 1 {
+			t.Errorf("expected IDs 0 and 1, got %d and %d", id1, id2)
+		}
+		if j.Len() != 2 {
+			t.Errorf("expected 2 entries, got %d", j.Len())
+		}
+	})
+
+	t.Run("does not modify the original journal", func(t *testing.T) {
+		base, _ := journal.New[int]().Append(1)
+		j1, _ := base.Append(2)
+		j2, _ := base.Append(3)
+
+		if !reflect.DeepEqual(values(base), []int{1}) {
+			t.Errorf("expected base [1], got %v", values(base))
+		}
+		if !reflect.DeepEqual(values(j1), []int{1, 2}) {
+			t.Errorf("expected j1 [1 2], got %v", values(j1))
+		}
+		if !reflect.DeepEqual(values(j2), []int{1, 3}) {
+			t.Errorf("expected j2 [1 3], got %v", values(j2))
+		}
+	})
+}
+
+func TestJournal_At(t *testing.T) {
+	j, id := journal.New[string]().Append("a")
+	if j.At(id).Unwrap() != "a" {
+		t.Errorf("expected Some(a), got %v", j.At(id))
+	}
+	if !j.At(id + 1).IsNone() {
+		t.Errorf("expected None, got %v", j.At(id+1))
+	}
+}
+
+func TestJournal_Last(t *testing.T) {
+	var j journal.Journal[int]
+	if !j.Last().IsNone() {
+		t.Error("expected None for empty journal")
+	}
+	j, _ = j.Append(1)
+	j, _ = j.Append(2)
+	if j.Last().Unwrap().Value != 2 {
+		t.Errorf("expected last value 2, got %v", j.Last())
+	}
+}
+
+func TestFold(t *testing.T) {
+	var j journal.Journal[int]
+	for _, v := range []int{75, -50, 100} {
+		j, _ = j.Append(v)
+	}
+	balance := journal.Fold(j, 0, func(acc int, e journal.Entry[int]) int {
+		return acc + e.Value
+	})
+	if balance != 125 {
+		t.Errorf("expected 125, got %d", balance)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	var j journal.Journal[int]
+	var ids []journal.ID
+	for _, v := range []int{1, 2, 3, 4} {
+		var id journal.ID
+		j, id = j.Append(v)
+		ids = append(ids, id)
+	}
+
+	sum := func(acc int, e journal.Entry[int]) int { return acc + e.Value }
+	snapshot, compacted := journal.Compact(j, ids[2], 0, sum)
+	if snapshot != 3 {
+		t.Errorf("expected snapshot 3, got %d", snapshot)
+	}
+	if !reflect.DeepEqual(values(compacted), []int{3, 4}) {
+		t.Errorf("expected [3 4], got %v", values(compacted))
+	}
+	if !compacted.At(ids[0]).IsNone() || compacted.At(ids[3]).Unwrap() != 4 {
+		t.Error("expected compacted entries to keep their IDs")
+	}
+
+	compacted, id := compacted.Append(5)
+	if id != ids[3]+1 {
+		t.Errorf("expected next ID %d, got %d", ids[3]+1, id)
+	}
+	if journal.Fold(compacted, snapshot, sum) != 15 {
+		t.Errorf("expected total 15, got %d", journal.Fold(compacted, snapshot, sum))
+	}
+}
@@ -0,0 +1,40 @@
+package gofp
+
+import "os"
+
+// LookupEnv returns an [Option] holding the value of the environment variable
+// named by key, or None if the variable is not present. A variable that is
+// present but empty is Some. See [os.LookupEnv].
+func LookupEnv(key string) Option[string] {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return None[string]()
+	}
+	return Some(v)
+}
+
+// MapGet returns an [Option] holding the value stored in m under key k, or None
+// if the key is not present. It is equivalent to [OptionFromMap].
+func MapGet[K comparable, V any](m map[K]V, k K) Option[V] {
+	return OptionFromMap(m, k)
+}
+
+// SliceGet returns an [Option] holding the element of xs at index i, or None if
+// i is out of range.
+func SliceGet[T any](xs []T, i int) Option[T] {
+	if i < 0 || i >= len(xs) {
+		return None[T]()
+	}
+	return Some(xs[i])
+}
+
+// FirstMatch returns an [Option] holding the first element of xs that
+// satisfies pred, or None if no element does.
+func FirstMatch[T any](xs []T, pred func(T) bool) Option[T] {
+	for _, x := range xs {
+		if pred(x) {
+			return Some(x)
+		}
+	}
+	return None[T]()
+}
@@ -0,0 +1,57 @@
+package gofp_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+)
+
+func TestLookupEnv(t *testing.T) {
+	t.Setenv("GOFP_TEST_SET", "value")
+	t.Setenv("GOFP_TEST_EMPTY", "")
+
+	assert.SomeEqual(t, gofp.LookupEnv("GOFP_TEST_SET"), "value")
+	assert.SomeEqual(t, gofp.LookupEnv("GOFP_TEST_EMPTY"), "")
+	assert.None(t, gofp.LookupEnv("GOFP_TEST_UNSET"))
+}
+
+func TestMapGet(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+
+	assert.SomeEqual(t, gofp.MapGet(m, "a"), 1)
+	assert.SomeEqual(t, gofp.MapGet(m, "zero"), 0)
+	assert.None(t, gofp.MapGet(m, "b"))
+}
+
+func TestSliceGet(t *testing.T) {
+	xs := []string{"a", "b", "c"}
+
+	tests := map[string]struct {
+		index int
+		want  gofp.Option[string]
+	}{
+		"first":    {0, gofp.Some("a")},
+		"last":     {2, gofp.Some("c")},
+		"negative": {-1, gofp.None[string]()},
+		"past end": {3, gofp.None[string]()},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := gofp.SliceGet(xs, tt.index)
+			if got.String() != tt.want.String() {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFirstMatch(t *testing.T) {
+	xs := []int{1, 4, 6, 9}
+
+	assert.SomeEqual(t, gofp.FirstMatch(xs, func(x int) bool { return x%2 == 0 }), 4)
+
+	var big gofp.Predicate[int] = func(x int) bool { return x > 10 }
+	assert.None(t, gofp.FirstMatch(xs, big))
+}
@@ -0,0 +1,71 @@
+package gofp
+
+// meta is the metadata attached to a [Result]. It is an immutable list of
+// entries, most recently attached first, so that attaching an entry never
+// affects the Results that share the rest of the list.
+type meta struct {
+	key, value any
+	next       *meta
+}
+
+// lookup returns the value of the most recently attached entry with the given
+// key. It is safe to call on a nil list, which holds no entries.
+func (m *meta) lookup(key any) (any, bool) {
+	for ; m != nil; m = m.next {
+		if m.key == key {
+			return m.value, true
+		}
+	}
+	return nil, false
+}
+
+// over returns a list holding the entries of m followed by those of base, so
+// that the entries of m take precedence.
+func (m *meta) over(base *meta) *meta {
+	switch {
+	case m == nil:
+		return base
+	case base == nil:
+		return m
+	}
+	return &meta{key: m.key, value: m.value, next: m.next.over(base)}
+}
+
+// underMeta returns the [Result] with the given metadata beneath its own, so
+// that metadata attached earlier in a pipeline is carried into the Results
+// produced by later stages.
+func (r Result[T]) underMeta(base *meta) Result[T] {
+	r.meta = r.meta.over(base)
+	return r
+}
+
+// WithMeta returns a copy of the [Result] with the given metadata attached,
+// replacing any value previously attached with the same key. Metadata is
+// carried through [Result.Map], [Result.FlatMap] and the other combinators,
+// so that values such as request identifiers or retry counts may accompany a
+// Result through a pipeline without becoming part of its value.
+//
+// As with [context.Context] values, the key must be comparable, and should be
+// of an unexported type to avoid collisions between packages. Metadata is not
+// included in the binary encoding of a Result.
+func (r Result[T]) WithMeta(key, value any) Result[T] {
+	r.meta = &meta{key: key, value: value, next: r.meta}
+	return r
+}
+
+// MetaOf returns the metadata attached to a [Result] with the given key, or
+// None if there is none or it is not of type V.
+//
+// Type parameter V represents the type of the metadata.
+// Type parameter T represents the value type of the Result.
+func MetaOf[V, T any](r Result[T], key any) Option[V] {
+	v, ok := r.meta.lookup(key)
+	if !ok {
+		return None[V]()
+	}
+	typed, ok := v.(V)
+	if !ok {
+		return None[V]()
+	}
+	return Some(typed)
+}
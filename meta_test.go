@@ -0,0 +1,83 @@
+package gofp_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+)
+
+type metaKey string
+
+const (
+	requestID metaKey = "request-id"
+	retries   metaKey = "retries"
+)
+
+func TestResult_WithMeta(t *testing.T) {
+	t.Run("attaches metadata", func(t *testing.T) {
+		r := gofp.Ok(1).WithMeta(requestID, "abc")
+		assert.SomeEqual(t, gofp.MetaOf[string](r, requestID), "abc")
+		assert.None(t, gofp.MetaOf[int](r, retries))
+	})
+
+	t.Run("returns None for a value of another type", func(t *testing.T) {
+		r := gofp.Ok(1).WithMeta(retries, "two")
+		assert.None(t, gofp.MetaOf[int](r, retries))
+	})
+
+	t.Run("replaces metadata with the same key", func(t *testing.T) {
+		r := gofp.Ok(1).WithMeta(retries, 1)
+		replaced := r.WithMeta(retries, 2)
+		assert.SomeEqual(t, gofp.MetaOf[int](replaced, retries), 2)
+		assert.SomeEqual(t, gofp.MetaOf[int](r, retries), 1)
+	})
+
+	t.Run("does not affect the value", func(t *testing.T) {
+		r := gofp.Ok(1).WithMeta(requestID, "abc")
+		assert.OkEqual(t, r, 1)
+		if got := r.String(); got != "Ok(1)" {
+			t.Errorf("expected Ok(1), got %s", got)
+		}
+	})
+}
+
+func TestResult_WithMeta_preserved(t *testing.T) {
+	errBoom := errors.New("boom")
+	ok := gofp.Ok(1).WithMeta(requestID, "abc")
+	failed := gofp.Err[int](errBoom).WithMeta(requestID, "abc")
+
+	tests := map[string]gofp.Result[string]{
+		"ResultMap":     gofp.ResultMap(ok, strconv.Itoa),
+		"ResultMap Err": gofp.ResultMap(failed, strconv.Itoa),
+		"ResultFlatMap": gofp.ResultFlatMap(ok, func(n int) gofp.Result[string] {
+			return gofp.Ok(strconv.Itoa(n))
+		}),
+		"ResultApply": gofp.ResultApply(ok, gofp.Ok(strconv.Itoa)),
+		"Wrap":        gofp.ResultMap(failed.Wrap("context"), strconv.Itoa),
+		"WithCode":    gofp.ResultMap(failed.WithCode(gofp.CodeInternal), strconv.Itoa),
+		"Ensure":      gofp.ResultMap(ok.Ensure(errBoom, func(int) bool { return false }), strconv.Itoa),
+		"OrElse": gofp.ResultMap(failed.OrElse(func(error) gofp.Result[int] {
+			return gofp.Ok(2)
+		}), strconv.Itoa),
+		"Recover": gofp.ResultMap(failed.Recover(func(error) int { return 2 }), strconv.Itoa),
+	}
+
+	for name, r := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.SomeEqual(t, gofp.MetaOf[string](r, requestID), "abc")
+		})
+	}
+}
+
+func TestResult_FlatMap_meta(t *testing.T) {
+	r := gofp.Ok(1).WithMeta(requestID, "abc").WithMeta(retries, 0)
+	got := r.FlatMap(func(n int) gofp.Result[int] {
+		return gofp.Ok(n+1).WithMeta(retries, 2)
+	})
+
+	assert.SomeEqual(t, gofp.MetaOf[string](got, requestID), "abc")
+	assert.SomeEqual(t, gofp.MetaOf[int](got, retries), 2)
+}
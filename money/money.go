@@ -0,0 +1,189 @@
+// Package money implements an exact monetary amount type.
+//
+// A [Money] value holds an amount in the minor units of its currency (for
+// example cents for USD) as an int64, so arithmetic is exact and free from
+// floating-point rounding. Operations that could silently produce an incorrect
+// result, such as combining different currencies or overflowing, return a
+// [gofp.Result] instead.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/tomasbasham/gofp"
+)
+
+var (
+	// ErrCurrencyMismatch is returned when combining amounts in different
+	// currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+
+	// ErrOverflow is returned when an operation overflows an int64.
+	ErrOverflow = errors.New("amount overflow")
+)
+
+// Currency is an ISO 4217 currency code together with the number of decimal
+// places used by its minor unit.
+type Currency struct {
+	Code     string
+	Exponent int
+}
+
+// Common currencies.
+var (
+	EUR = Currency{Code: "EUR", Exponent: 2}
+	GBP = Currency{Code: "GBP", Exponent: 2}
+	JPY = Currency{Code: "JPY", Exponent: 0}
+	USD = Currency{Code: "USD", Exponent: 2}
+)
+
+// Money is an amount in the minor units of a [Currency].
+type Money struct {
+	amount   int64
+	currency Currency
+}
+
+// New returns a [Money] holding the given amount in minor units of the given
+// currency.
+func New(amount int64, currency Currency) Money {
+	return Money{amount: amount, currency: currency}
+}
+
+// Zero returns a zero amount in the given currency.
+func Zero(currency Currency) Money {
+	return New(0, currency)
+}
+
+// Amount returns the amount in minor units.
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+// Currency returns the currency of the amount.
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// IsNegative reports whether the amount is less than zero.
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+func (m Money) String() string {
+	if m.currency.Exponent <= 0 {
+		return fmt.Sprintf("%d %s", m.amount, m.currency.Code)
+	}
+
+	sign, amount := "", m.amount
+	if amount < 0 {
+		sign = "-"
+	}
+	scale := uint64(math.Pow10(m.currency.Exponent))
+	abs := uint64(amount)
+	if amount < 0 {
+		abs = uint64(-(amount + 1)) + 1
+	}
+	return fmt.Sprintf("%s%d.%0*d %s", sign, abs/scale, m.currency.Exponent, abs%scale, m.currency.Code)
+}
+
+// Add returns the sum of two amounts, or an Err if their currencies differ or
+// the sum overflows.
+func (m Money) Add(other Money) gofp.Result[Money] {
+	if m.currency != other.currency {
+		return gofp.Err[Money](mismatch(m, other))
+	}
+	sum := m.amount + other.amount
+	if (other.amount > 0 && sum < m.amount) || (other.amount < 0 && sum > m.amount) {
+		return gofp.Err[Money](fmt.Errorf("%w: %v + %v", ErrOverflow, m, other))
+	}
+	return gofp.Ok(New(sum, m.currency))
+}
+
+// Sub returns the difference of two amounts, or an Err if their currencies
+// differ or the difference overflows.
+func (m Money) Sub(other Money) gofp.Result[Money] {
+	if other.amount == math.MinInt64 {
+		return gofp.Err[Money](fmt.Errorf("%w: %v - %v", ErrOverflow, m, other))
+	}
+	return m.Add(New(-other.amount, other.currency))
+}
+
+// Mul returns the amount multiplied by a factor, or an Err if the product
+// overflows.
+func (m Money) Mul(factor int64) gofp.Result[Money] {
+	if m.amount == 0 || factor == 0 {
+		return gofp.Ok(Zero(m.currency))
+	}
+	product := m.amount * factor
+	if product/factor != m.amount || (factor == -1 && m.amount == math.MinInt64) {
+		return gofp.Err[Money](fmt.Errorf("%w: %v * %d", ErrOverflow, m, factor))
+	}
+	return gofp.Ok(New(product, m.currency))
+}
+
+// Negate returns the amount with its sign reversed, or an Err if the negation
+// overflows.
+func (m Money) Negate() gofp.Result[Money] {
+	if m.amount == math.MinInt64 {
+		return gofp.Err[Money](fmt.Errorf("%w: -(%v)", ErrOverflow, m))
+	}
+	return gofp.Ok(New(-m.amount, m.currency))
+}
+
+// Compare compares two amounts in the same currency, returning -1, 0 or +1, or
+// an Err if their currencies differ.
+func (m Money) Compare(other Money) gofp.Result[int] {
+	if m.currency != other.currency {
+		return gofp.Err[int](mismatch(m, other))
+	}
+	switch {
+	case m.amount < other.amount:
+		return gofp.Ok(-1)
+	case m.amount > other.amount:
+		return gofp.Ok(1)
+	default:
+		return gofp.Ok(0)
+	}
+}
+
+// Sum returns the total of the given amounts in the given currency, or the
+// first Err encountered.
+func Sum(currency Currency, amounts ...Money) gofp.Result[Money] {
+	total := gofp.Ok(Zero(currency))
+	for _, m := range amounts {
+		total = total.FlatMap(func(t Money) gofp.Result[Money] {
+			return t.Add(m)
+		})
+	}
+	return total
+}
+
+func mismatch(a, b Money) error {
+	return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.currency.Code, b.currency.Code)
+}
+
+// Monoid is a Monoid for [gofp.Result] values holding amounts in a single
+// currency. The empty value is Ok with a zero amount and values are combined by
+// addition, with the first Err taking precedence.
+type Monoid struct {
+	Currency Currency
+}
+
+// Empty returns Ok with a zero amount.
+func (m Monoid) Empty() gofp.Result[Money] {
+	return gofp.Ok(Zero(m.Currency))
+}
+
+// Append returns the sum of two amounts.
+func (m Monoid) Append(a, b gofp.Result[Money]) gofp.Result[Money] {
+	return a.FlatMap(func(x Money) gofp.Result[Money] {
+		return b.FlatMap(x.Add)
+	})
+}
@@ -0,0 +1,111 @@
+package money_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/money"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+var _ writer.Monoid[gofp.Result[money.Money]] = money.Monoid{}
+
+func TestMoney_String(t *testing.T) {
+	tests := []struct {
+		m    money.Money
+		want string
+	}{
+		{money.New(1234, money.USD), "12.34 USD"},
+		{money.New(-5, money.EUR), "-0.05 EUR"},
+		{money.New(500, money.JPY), "500 JPY"},
+		{money.New(math.MinInt64, money.GBP), "-92233720368547758.08 GBP"},
+	}
+	for _, tt := range tests {
+		if got := tt.m.String(); got != tt.want {
+			t.Errorf("expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestMoney_Add(t *testing.T) {
+	t.Run("adds amounts in the same currency", func(t *testing.T) {
+		got := money.New(100, money.USD).Add(money.New(50, money.USD))
+		if got.Unwrap() != money.New(150, money.USD) {
+			t.Errorf("expected 1.50 USD, got %v", got)
+		}
+	})
+
+	t.Run("rejects different currencies", func(t *testing.T) {
+		got := money.New(100, money.USD).Add(money.New(50, money.EUR))
+		if !errors.Is(got.UnwrapErr(), money.ErrCurrencyMismatch) {
+			t.Errorf("expected ErrCurrencyMismatch, got %v", got)
+		}
+	})
+
+	t.Run("detects overflow", func(t *testing.T) {
+		got := money.New(math.MaxInt64, money.USD).Add(money.New(1, money.USD))
+		if !errors.Is(got.UnwrapErr(), money.ErrOverflow) {
+			t.Errorf("expected ErrOverflow, got %v", got)
+		}
+	})
+}
+
+func TestMoney_Sub(t *testing.T) {
+	got := money.New(100, money.USD).Sub(money.New(150, money.USD))
+	if got.Unwrap() != money.New(-50, money.USD) {
+		t.Errorf("expected -0.50 USD, got %v", got)
+	}
+
+	got = money.New(0, money.USD).Sub(money.New(math.MinInt64, money.USD))
+	if !errors.Is(got.UnwrapErr(), money.ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", got)
+	}
+}
+
+func TestMoney_Mul(t *testing.T) {
+	if got := money.New(250, money.USD).Mul(3); got.Unwrap() != money.New(750, money.USD) {
+		t.Errorf("expected 7.50 USD, got %v", got)
+	}
+	if got := money.New(math.MaxInt64/2+1, money.USD).Mul(2); !errors.Is(got.UnwrapErr(), money.ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", got)
+	}
+	if got := money.New(math.MinInt64, money.USD).Mul(-1); !errors.Is(got.UnwrapErr(), money.ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", got)
+	}
+}
+
+func TestMoney_Negate(t *testing.T) {
+	if got := money.New(150, money.USD).Negate(); got.Unwrap() != money.New(-150, money.USD) {
+		t.Errorf("expected -1.50 USD, got %v", got)
+	}
+	if got := money.New(math.MinInt64, money.USD).Negate(); !errors.Is(got.UnwrapErr(), money.ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", got)
+	}
+}
+
+func TestSum(t *testing.T) {
+	got := money.Sum(money.GBP, money.New(100, money.GBP), money.New(250, money.GBP))
+	if got.Unwrap() != money.New(350, money.GBP) {
+		t.Errorf("expected 3.50 GBP, got %v", got)
+	}
+
+	got = money.Sum(money.GBP, money.New(100, money.USD))
+	if !errors.Is(got.UnwrapErr(), money.ErrCurrencyMismatch) {
+		t.Errorf("expected ErrCurrencyMismatch, got %v", got)
+	}
+}
+
+func TestMonoid(t *testing.T) {
+	m := money.Monoid{Currency: money.USD}
+	w := writer.ParSequence[gofp.Result[money.Money]]([]writer.Writer[gofp.Result[money.Money], string]{
+		writer.TellWithValue[gofp.Result[money.Money]]("a", gofp.Ok(money.New(100, money.USD)), m),
+		writer.TellWithValue[gofp.Result[money.Money]]("b", gofp.Ok(money.New(25, money.USD)), m),
+	}, m)
+
+	_, total := w.Run()
+	if total.Unwrap() != money.New(125, money.USD) {
+		t.Errorf("expected 1.25 USD, got %v", total)
+	}
+}
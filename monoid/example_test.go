@@ -0,0 +1,36 @@
+package monoid_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/monoid"
+)
+
+func ExampleProduct() {
+	m := monoid.NewProduct[[]string, int](monoid.Slice[string]{}, monoid.Sum[int]{})
+
+	out := m.Empty()
+	out = m.Append(out, gofp.NewPair([]string{"fetched users"}, 12))
+	out = m.Append(out, gofp.NewPair([]string{"fetched orders"}, 30))
+
+	fmt.Println(out.First)
+	fmt.Println(out.Second)
+	// Output:
+	// [fetched users fetched orders]
+	// 42
+}
+
+func ExampleEndo() {
+	m := monoid.NewDual[func(string) string](monoid.Endo[string]{})
+
+	format := m.Empty()
+	format = m.Append(format, strings.TrimSpace)
+	format = m.Append(format, strings.ToUpper)
+	format = m.Append(format, func(s string) string { return "# " + s })
+
+	fmt.Println(format("  quarterly report "))
+	// Output:
+	// # QUARTERLY REPORT
+}
@@ -0,0 +1,46 @@
+package monoid
+
+import (
+	"iter"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// FoldMap maps every value of a sequence to a monoid using f, and combines the
+// results in order. It returns the empty value of the monoid if the sequence is
+// empty.
+func FoldMap[A, B any](seq iter.Seq[A], m Monoid[B], f func(A) B) B {
+	acc := m.Empty()
+	for a := range seq {
+		acc = m.Append(acc, f(a))
+	}
+	return acc
+}
+
+// FoldMapSlice maps every element of a slice to a monoid using f, and combines
+// the results in order.
+func FoldMapSlice[A, B any](xs []A, m Monoid[B], f func(A) B) B {
+	acc := m.Empty()
+	for _, x := range xs {
+		acc = m.Append(acc, f(x))
+	}
+	return acc
+}
+
+// FoldMapOption maps the value of a Some [gofp.Option] to a monoid using f. It
+// returns the empty value of the monoid if the option is None.
+func FoldMapOption[A, B any](o gofp.Option[A], m Monoid[B], f func(A) B) B {
+	return gofp.OptionFold(o, m.Empty, f)
+}
+
+// FoldMapEither maps the Right value of a [gofp.Either] to a monoid using f. It
+// returns the empty value of the monoid if the either is Left.
+func FoldMapEither[L, R, B any](e gofp.Either[L, R], m Monoid[B], f func(R) B) B {
+	return gofp.EitherFold(e, func(L) B { return m.Empty() }, f)
+}
+
+// FoldMapResult maps the value of an Ok [gofp.Result] to a monoid using f. It
+// returns the empty value of the monoid if the result is an Err.
+func FoldMapResult[A, B any](r gofp.Result[A], m Monoid[B], f func(A) B) B {
+	return gofp.ResultFold(r, func(error) B { return m.Empty() }, f)
+}
@@ -0,0 +1,61 @@
+package monoid_test
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/monoid"
+)
+
+func TestFoldMap(t *testing.T) {
+	got := monoid.FoldMap(slices.Values([]int{1, 2, 3}), monoid.Slice[string]{}, func(n int) []string {
+		return []string{strconv.Itoa(n)}
+	})
+	if !slices.Equal(got, []string{"1", "2", "3"}) {
+		t.Errorf("expected [1 2 3], got %v", got)
+	}
+}
+
+func TestFoldMapSlice(t *testing.T) {
+	type entry struct{ amount int }
+	entries := []entry{{100}, {-25}, {40}}
+
+	got := monoid.FoldMapSlice(entries, monoid.Sum[int]{}, func(e entry) int { return e.amount })
+	if got != 115 {
+		t.Errorf("expected 115, got %v", got)
+	}
+
+	if got := monoid.FoldMapSlice(nil, monoid.String{}, strconv.Itoa); got != "" {
+		t.Errorf("expected empty string, got %q", got)
+	}
+}
+
+func TestFoldMapOption(t *testing.T) {
+	if got := monoid.FoldMapOption(gofp.Some(2), monoid.Sum[int]{}, func(n int) int { return n * 10 }); got != 20 {
+		t.Errorf("expected 20, got %v", got)
+	}
+	if got := monoid.FoldMapOption(gofp.None[int](), monoid.Sum[int]{}, func(n int) int { return n * 10 }); got != 0 {
+		t.Errorf("expected 0, got %v", got)
+	}
+}
+
+func TestFoldMapEither(t *testing.T) {
+	if got := monoid.FoldMapEither(gofp.Right[string](2), monoid.Sum[int]{}, func(n int) int { return n }); got != 2 {
+		t.Errorf("expected 2, got %v", got)
+	}
+	if got := monoid.FoldMapEither(gofp.Left[string, int]("no"), monoid.Sum[int]{}, func(n int) int { return n }); got != 0 {
+		t.Errorf("expected 0, got %v", got)
+	}
+}
+
+func TestFoldMapResult(t *testing.T) {
+	if got := monoid.FoldMapResult(gofp.Ok("a"), monoid.String{}, func(s string) string { return s + s }); got != "aa" {
+		t.Errorf("expected aa, got %v", got)
+	}
+	if got := monoid.FoldMapResult(gofp.Err[string](errors.New("failed")), monoid.String{}, func(s string) string { return s }); got != "" {
+		t.Errorf("expected empty string, got %q", got)
+	}
+}
@@ -0,0 +1,153 @@
+// Package monoid provides the [Monoid] type class along with common instances
+// and combinators for building new instances from existing ones.
+//
+// A monoid is a type with an associative binary operation and an identity
+// element. Monoids describe how the output of a writer computation is
+// accumulated, so combining monoids makes it possible for a single computation
+// to accumulate several kinds of output at once.
+package monoid
+
+import "github.com/tomasbasham/gofp"
+
+// Monoid represents a type that can be combined with other values of the same
+// type. It requires an empty value and a way to combine two values.
+//
+// Type parameter A represents the value type.
+type Monoid[A any] interface {
+	Empty() A
+	Append(A, A) A
+}
+
+// Sized is a [Monoid] whose values may reserve capacity in advance, such as a
+// slice that is appended to in place. A writer computation that knows roughly
+// how much output it will produce may start from EmptySized rather than Empty
+// to avoid repeatedly growing its output.
+//
+// Type parameter A represents the value type.
+type Sized[A any] interface {
+	Monoid[A]
+
+	// EmptySized returns an empty value with capacity for at least hint
+	// elements.
+	EmptySized(hint int) A
+}
+
+// Slice is a [Monoid] that concatenates slices.
+//
+// Type parameter T represents the element type.
+type Slice[T any] struct{}
+
+// Empty returns an empty slice.
+func (Slice[T]) Empty() []T {
+	return []T{}
+}
+
+// Append returns a new slice holding the elements of a followed by those of b.
+func (Slice[T]) Append(a, b []T) []T {
+	s := make([]T, 0, len(a)+len(b))
+	s = append(s, a...)
+	return append(s, b...)
+}
+
+// String is a [Monoid] that concatenates strings.
+type String struct{}
+
+// Empty returns the empty string.
+func (String) Empty() string {
+	return ""
+}
+
+// Append returns a followed by b.
+func (String) Append(a, b string) string {
+	return a + b
+}
+
+// Sum is a [Monoid] that adds numbers.
+//
+// Type parameter N represents the number type.
+type Sum[N gofp.Number] struct{}
+
+// Empty returns zero.
+func (Sum[N]) Empty() N {
+	return 0
+}
+
+// Append returns a + b.
+func (Sum[N]) Append(a, b N) N {
+	return a + b
+}
+
+// Product is a [Monoid] for [gofp.Pair] values that combines the first and
+// second values of each pair using separate monoids. It allows two kinds of
+// output, such as human-readable logs and structured metrics, to be accumulated
+// side by side.
+//
+// Type parameter A represents the first value type.
+// Type parameter B represents the second value type.
+type Product[A, B any] struct {
+	First  Monoid[A]
+	Second Monoid[B]
+}
+
+// NewProduct returns a [Product] of the given monoids.
+func NewProduct[A, B any](first Monoid[A], second Monoid[B]) Product[A, B] {
+	return Product[A, B]{First: first, Second: second}
+}
+
+// Empty returns a pair of the empty values of both monoids.
+func (p Product[A, B]) Empty() gofp.Pair[A, B] {
+	return gofp.NewPair(p.First.Empty(), p.Second.Empty())
+}
+
+// Append combines two pairs element-wise.
+func (p Product[A, B]) Append(a, b gofp.Pair[A, B]) gofp.Pair[A, B] {
+	return gofp.NewPair(p.First.Append(a.First, b.First), p.Second.Append(a.Second, b.Second))
+}
+
+// Dual is a [Monoid] that combines values using another monoid with the
+// arguments reversed, so that later values are placed before earlier ones.
+//
+// Type parameter A represents the value type.
+type Dual[A any] struct {
+	M Monoid[A]
+}
+
+// NewDual returns the [Dual] of the given monoid.
+func NewDual[A any](m Monoid[A]) Dual[A] {
+	return Dual[A]{M: m}
+}
+
+// Empty returns the empty value of the underlying monoid.
+func (d Dual[A]) Empty() A {
+	return d.M.Empty()
+}
+
+// Append combines b with a using the underlying monoid.
+func (d Dual[A]) Append(a, b A) A {
+	return d.M.Append(b, a)
+}
+
+// Endo is a [Monoid] of functions from a type to itself under composition. The
+// empty value is the identity function, and appending f and g returns a
+// function that applies g and then f. Wrapping Endo in [Dual] applies the
+// functions in the order that they were appended instead.
+//
+// Accumulating Endo values in a writer computation builds up a single
+// transformation, such as the final formatting of a report, during a pass.
+//
+// Type parameter A represents the type of the function argument and result.
+type Endo[A any] struct{}
+
+// Empty returns the identity function.
+func (Endo[A]) Empty() func(A) A {
+	return func(a A) A {
+		return a
+	}
+}
+
+// Append returns the composition of f and g.
+func (Endo[A]) Append(f, g func(A) A) func(A) A {
+	return func(a A) A {
+		return f(g(a))
+	}
+}
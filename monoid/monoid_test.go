@@ -0,0 +1,86 @@
+package monoid_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/monoid"
+)
+
+func TestSlice(t *testing.T) {
+	m := monoid.Slice[int]{}
+
+	a := make([]int, 1, 4)
+	a[0] = 1
+	got := m.Append(a, []int{2})
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", got)
+	}
+
+	// Appending must not write into the spare capacity of the first slice.
+	m.Append(a, []int{3})
+	if got[1] != 2 {
+		t.Errorf("expected earlier result to be unaffected, got %v", got)
+	}
+
+	if got := m.Append(m.Empty(), []int{1}); !slices.Equal(got, []int{1}) {
+		t.Errorf("expected [1], got %v", got)
+	}
+}
+
+func TestString(t *testing.T) {
+	m := monoid.String{}
+	if got := m.Append(m.Append(m.Empty(), "a"), "b"); got != "ab" {
+		t.Errorf("expected ab, got %v", got)
+	}
+}
+
+func TestSum(t *testing.T) {
+	m := monoid.Sum[float64]{}
+	if got := m.Append(m.Append(m.Empty(), 1.5), 2); got != 3.5 {
+		t.Errorf("expected 3.5, got %v", got)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	m := monoid.NewProduct[[]string, int](monoid.Slice[string]{}, monoid.Sum[int]{})
+
+	empty := m.Empty()
+	if len(empty.First) != 0 || empty.Second != 0 {
+		t.Errorf("expected empty pair, got %v", empty)
+	}
+
+	got := m.Append(gofp.NewPair([]string{"a"}, 1), gofp.NewPair([]string{"b"}, 2))
+	if !slices.Equal(got.First, []string{"a", "b"}) || got.Second != 3 {
+		t.Errorf("expected ([a b], 3), got %v", got)
+	}
+}
+
+func TestDual(t *testing.T) {
+	m := monoid.NewDual[string](monoid.String{})
+	if got := m.Append(m.Append(m.Empty(), "a"), "b"); got != "ba" {
+		t.Errorf("expected ba, got %v", got)
+	}
+}
+
+func TestEndo(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+	inc := func(n int) int { return n + 1 }
+
+	t.Run("composes right to left", func(t *testing.T) {
+		m := monoid.Endo[int]{}
+		f := m.Append(m.Append(m.Empty(), double), inc)
+		if got := f(3); got != 8 {
+			t.Errorf("expected 8, got %v", got)
+		}
+	})
+
+	t.Run("composes left to right under Dual", func(t *testing.T) {
+		m := monoid.NewDual[func(int) int](monoid.Endo[int]{})
+		f := m.Append(m.Append(m.Empty(), double), inc)
+		if got := f(3); got != 7 {
+			t.Errorf("expected 7, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package gofp
+
+// The functions in this file are alternative names for existing functions,
+// familiar from languages such as Haskell, Scala and F#. Each behaves exactly
+// as the function it names.
+
+// OptionOf returns an [Option] with a value. It is equivalent to [Some].
+func OptionOf[T any](value T) Option[T] {
+	return Some(value)
+}
+
+// OptionBind is equivalent to [OptionFlatMap].
+func OptionBind[T, U any](o Option[T], fn func(T) Option[U]) Option[U] {
+	return OptionFlatMap(o, fn)
+}
+
+// OptionJoin flattens a nested [Option], returning the inner [Option] if the
+// outer one is Some, or otherwise None.
+func OptionJoin[T any](o Option[Option[T]]) Option[T] {
+	return OptionFlatMap(o, func(inner Option[T]) Option[T] { return inner })
+}
+
+// ResultOf returns a [Result] with a value. It is equivalent to [Ok].
+func ResultOf[T any](value T) Result[T] {
+	return Ok(value)
+}
+
+// ResultBind is equivalent to [ResultFlatMap].
+func ResultBind[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
+	return ResultFlatMap(r, fn)
+}
+
+// ResultJoin flattens a nested [Result], returning the inner [Result] if the
+// outer one is Ok, or otherwise the Err of the outer one.
+func ResultJoin[T any](r Result[Result[T]]) Result[T] {
+	return ResultFlatMap(r, func(inner Result[T]) Result[T] { return inner })
+}
+
+// EitherOf returns an [Either] with a right value. It is equivalent to
+// [Right].
+func EitherOf[T, U any](value U) Either[T, U] {
+	return Right[T](value)
+}
+
+// EitherBind is equivalent to [EitherFlatMap].
+func EitherBind[T, U, V any](e Either[T, U], fn func(U) Either[T, V]) Either[T, V] {
+	return EitherFlatMap(e, fn)
+}
+
+// EitherJoin flattens a nested [Either], returning the inner [Either] if the
+// outer one is Right, or otherwise the left value of the outer one.
+func EitherJoin[T, U any](e Either[T, Either[T, U]]) Either[T, U] {
+	return EitherFlatMap(e, func(inner Either[T, U]) Either[T, U] { return inner })
+}
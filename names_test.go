@@ -0,0 +1,44 @@
+package gofp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+)
+
+func TestOptionNames(t *testing.T) {
+	half := func(x int) gofp.Option[int] {
+		if x%2 != 0 {
+			return gofp.None[int]()
+		}
+		return gofp.Some(x / 2)
+	}
+
+	assert.SomeEqual(t, gofp.OptionBind(gofp.OptionOf(4), half), 2)
+	assert.SomeEqual(t, gofp.OptionJoin(gofp.Some(gofp.Some(1))), 1)
+	assert.None(t, gofp.OptionJoin(gofp.Some(gofp.None[int]())))
+	assert.None(t, gofp.OptionJoin(gofp.None[gofp.Option[int]]()))
+}
+
+func TestResultNames(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	got := gofp.ResultBind(gofp.ResultOf(2), func(x int) gofp.Result[string] {
+		return gofp.Ok("two")
+	})
+	assert.OkEqual(t, got, "two")
+	assert.OkEqual(t, gofp.ResultJoin(gofp.Ok(gofp.Ok(1))), 1)
+	assert.ErrIs(t, gofp.ResultJoin(gofp.Ok(gofp.Err[int](errBoom))), errBoom)
+	assert.ErrIs(t, gofp.ResultJoin(gofp.Err[gofp.Result[int]](errBoom)), errBoom)
+}
+
+func TestEitherNames(t *testing.T) {
+	got := gofp.EitherBind(gofp.EitherOf[string](2), func(x int) gofp.Either[string, int] {
+		return gofp.Right[string](x + 1)
+	})
+	assert.RightEqual(t, got, 3)
+	assert.LeftEqual(t, gofp.EitherJoin(gofp.Right[string](gofp.Left[string, int]("inner"))), "inner")
+	assert.LeftEqual(t, gofp.EitherJoin(gofp.Left[string, gofp.Either[string, int]]("outer")), "outer")
+}
@@ -0,0 +1,72 @@
+package gofp
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// OptionSum returns the sum of all Some values in the slice. None values are
+// skipped. If the slice contains no Some values, None is returned.
+func OptionSum[N Number](xs []Option[N]) Option[N] {
+	var (
+		sum   N
+		found bool
+	)
+	for _, x := range xs {
+		if x.valid {
+			sum += x.value
+			found = true
+		}
+	}
+	if !found {
+		return None[N]()
+	}
+	return Some(sum)
+}
+
+// OptionAvg returns the arithmetic mean of all Some values in the slice. None
+// values are skipped and do not contribute to the count. If the slice contains
+// no Some values, None is returned.
+func OptionAvg[N Number](xs []Option[N]) Option[float64] {
+	var (
+		sum   float64
+		count int
+	)
+	for _, x := range xs {
+		if x.valid {
+			sum += float64(x.value)
+			count++
+		}
+	}
+	if count == 0 {
+		return None[float64]()
+	}
+	return Some(sum / float64(count))
+}
+
+// OptionAdd returns the sum of two [Option] values if both are Some, or None
+// otherwise.
+func OptionAdd[N Number](a, b Option[N]) Option[N] {
+	return optionLift2(a, b, func(x, y N) N { return x + y })
+}
+
+// OptionSub returns the difference of two [Option] values if both are Some, or
+// None otherwise.
+func OptionSub[N Number](a, b Option[N]) Option[N] {
+	return optionLift2(a, b, func(x, y N) N { return x - y })
+}
+
+// OptionMul returns the product of two [Option] values if both are Some, or
+// None otherwise.
+func OptionMul[N Number](a, b Option[N]) Option[N] {
+	return optionLift2(a, b, func(x, y N) N { return x * y })
+}
+
+func optionLift2[T any](a, b Option[T], f func(T, T) T) Option[T] {
+	if !a.valid || !b.valid {
+		return None[T]()
+	}
+	return Some(f(a.value, b.value))
+}
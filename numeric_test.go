@@ -0,0 +1,64 @@
+package gofp_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestOptionSum(t *testing.T) {
+	t.Run("sums Some values", func(t *testing.T) {
+		xs := []gofp.Option[int]{gofp.Some(1), gofp.None[int](), gofp.Some(2)}
+		if got := gofp.OptionSum(xs); got.Unwrap() != 3 {
+			t.Errorf("expected Some(3), got %v", got)
+		}
+	})
+
+	t.Run("returns None when no values are present", func(t *testing.T) {
+		xs := []gofp.Option[int]{gofp.None[int]()}
+		if got := gofp.OptionSum(xs); got.IsSome() {
+			t.Errorf("expected None, got %v", got)
+		}
+	})
+}
+
+func TestOptionAvg(t *testing.T) {
+	t.Run("averages Some values", func(t *testing.T) {
+		xs := []gofp.Option[int]{gofp.Some(1), gofp.None[int](), gofp.Some(2)}
+		if got := gofp.OptionAvg(xs); got.Unwrap() != 1.5 {
+			t.Errorf("expected Some(1.5), got %v", got)
+		}
+	})
+
+	t.Run("returns None for an empty slice", func(t *testing.T) {
+		if got := gofp.OptionAvg[float64](nil); got.IsSome() {
+			t.Errorf("expected None, got %v", got)
+		}
+	})
+}
+
+func TestOptionArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(a, b gofp.Option[int]) gofp.Option[int]
+		want int
+	}{
+		{"add", gofp.OptionAdd[int], 8},
+		{"sub", gofp.OptionSub[int], 2},
+		{"mul", gofp.OptionMul[int], 15},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(gofp.Some(5), gofp.Some(3)); got.Unwrap() != tt.want {
+				t.Errorf("expected Some(%d), got %v", tt.want, got)
+			}
+			if got := tt.fn(gofp.Some(5), gofp.None[int]()); got.IsSome() {
+				t.Errorf("expected None, got %v", got)
+			}
+			if got := tt.fn(gofp.None[int](), gofp.Some(3)); got.IsSome() {
+				t.Errorf("expected None, got %v", got)
+			}
+		})
+	}
+}
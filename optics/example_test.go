@@ -0,0 +1,31 @@
+package optics_test
+
+import (
+	"fmt"
+
+	"github.com/tomasbasham/gofp/optics"
+	"github.com/tomasbasham/gofp/reader"
+)
+
+func ExampleIso() {
+	type Metric struct{ Threshold float64 }
+	type Imperial struct{ ThresholdF float64 }
+
+	iso := optics.NewIso(
+		func(m Metric) Imperial { return Imperial{m.Threshold*9/5 + 32} },
+		func(i Imperial) Metric { return Metric{(i.ThresholdF - 32) * 5 / 9} },
+	)
+
+	// A reader written against the imperial configuration can be run with the
+	// metric configuration by converting the environment through the Iso.
+	alert := reader.New(func(cfg Imperial) string {
+		return fmt.Sprintf("alert above %.0f°F", cfg.ThresholdF)
+	})
+	metric := reader.New(func(cfg Metric) string {
+		return alert.Run(iso.Get(cfg))
+	})
+
+	fmt.Println(metric.Run(Metric{Threshold: 30}))
+	// Output:
+	// alert above 86°F
+}
@@ -0,0 +1,107 @@
+// Package optics provides composable accessors for focusing on and updating
+// parts of immutable data.
+package optics
+
+import "github.com/tomasbasham/gofp"
+
+// Iso is an isomorphism between two types: a pair of functions that convert a
+// value of one type into the other and back again without losing information.
+// Isos allow a value to be viewed and modified in whichever representation is
+// most convenient, such as adapting the environment of a Reader or the state of
+// a State computation between equivalent types.
+//
+// Type parameter A represents the source type.
+// Type parameter B represents the target type.
+type Iso[A, B any] struct {
+	get     func(A) B
+	reverse func(B) A
+}
+
+// NewIso creates an [Iso] from a pair of inverse functions.
+func NewIso[A, B any](get func(A) B, reverseGet func(B) A) Iso[A, B] {
+	return Iso[A, B]{get: get, reverse: reverseGet}
+}
+
+// Get converts a value of the source type into the target type.
+func (i Iso[A, B]) Get(a A) B {
+	return i.get(a)
+}
+
+// ReverseGet converts a value of the target type back into the source type.
+func (i Iso[A, B]) ReverseGet(b B) A {
+	return i.reverse(b)
+}
+
+// Reverse returns the inverse [Iso], converting from the target type to the
+// source type.
+func (i Iso[A, B]) Reverse() Iso[B, A] {
+	return Iso[B, A]{get: i.reverse, reverse: i.get}
+}
+
+// Modify returns a function that applies f to a value viewed through the
+// [Iso], converting it to the target type and back again.
+func (i Iso[A, B]) Modify(f func(B) B) func(A) A {
+	return func(a A) A {
+		return i.reverse(f(i.get(a)))
+	}
+}
+
+// Compose combines two [Iso] values, converting from A to C by way of B.
+func Compose[A, B, C any](ab Iso[A, B], bc Iso[B, C]) Iso[A, C] {
+	return Iso[A, C]{
+		get: func(a A) C {
+			return bc.get(ab.get(a))
+		},
+		reverse: func(c C) A {
+			return ab.reverse(bc.reverse(c))
+		},
+	}
+}
+
+// OptionIso lifts an [Iso] to convert between [gofp.Option] values. None is
+// converted to None in both directions.
+func OptionIso[A, B any](i Iso[A, B]) Iso[gofp.Option[A], gofp.Option[B]] {
+	return Iso[gofp.Option[A], gofp.Option[B]]{
+		get: func(o gofp.Option[A]) gofp.Option[B] {
+			return gofp.OptionMap(o, i.get)
+		},
+		reverse: func(o gofp.Option[B]) gofp.Option[A] {
+			return gofp.OptionMap(o, i.reverse)
+		},
+	}
+}
+
+// SliceIso lifts an [Iso] to convert every element of a slice.
+func SliceIso[A, B any](i Iso[A, B]) Iso[[]A, []B] {
+	return Iso[[]A, []B]{
+		get: func(as []A) []B {
+			return mapSlice(as, i.get)
+		},
+		reverse: func(bs []B) []A {
+			return mapSlice(bs, i.reverse)
+		},
+	}
+}
+
+// PairSwap returns an [Iso] that swaps the values of a [gofp.Pair].
+func PairSwap[A, B any]() Iso[gofp.Pair[A, B], gofp.Pair[B, A]] {
+	return Iso[gofp.Pair[A, B], gofp.Pair[B, A]]{
+		get: func(p gofp.Pair[A, B]) gofp.Pair[B, A] {
+			return gofp.NewPair(p.Second, p.First)
+		},
+		reverse: func(p gofp.Pair[B, A]) gofp.Pair[A, B] {
+			return gofp.NewPair(p.Second, p.First)
+		},
+	}
+}
+
+func mapSlice[A, B any](as []A, f func(A) B) []B {
+	if as == nil {
+		return nil
+	}
+	bs := make([]B, len(as))
+	for i, a := range as {
+		bs[i] = f(a)
+	}
+	return bs
+}
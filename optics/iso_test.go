@@ -0,0 +1,96 @@
+package optics_test
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/optics"
+)
+
+type Celsius float64
+
+type Fahrenheit float64
+
+var celsiusFahrenheit = optics.NewIso(
+	func(c Celsius) Fahrenheit { return Fahrenheit(c*9/5 + 32) },
+	func(f Fahrenheit) Celsius { return Celsius((f - 32) * 5 / 9) },
+)
+
+var intString = optics.NewIso(strconv.Itoa, func(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+})
+
+func TestIso(t *testing.T) {
+	t.Run("converts in both directions", func(t *testing.T) {
+		if got := celsiusFahrenheit.Get(100); got != 212 {
+			t.Errorf("expected 212, got %v", got)
+		}
+		if got := celsiusFahrenheit.ReverseGet(32); got != 0 {
+			t.Errorf("expected 0, got %v", got)
+		}
+	})
+
+	t.Run("reverse swaps directions", func(t *testing.T) {
+		if got := celsiusFahrenheit.Reverse().Get(212); got != 100 {
+			t.Errorf("expected 100, got %v", got)
+		}
+	})
+
+	t.Run("modifies through the target representation", func(t *testing.T) {
+		warmer := celsiusFahrenheit.Modify(func(f Fahrenheit) Fahrenheit { return f + 18 })
+		if got := warmer(0); got != 10 {
+			t.Errorf("expected 10, got %v", got)
+		}
+	})
+}
+
+func TestCompose(t *testing.T) {
+	roundTrip := optics.NewIso(
+		func(c Celsius) int { return int(c) },
+		func(n int) Celsius { return Celsius(n) },
+	)
+	iso := optics.Compose(roundTrip, intString)
+
+	if got := iso.Get(21); got != "21" {
+		t.Errorf("expected \"21\", got %q", got)
+	}
+	if got := iso.ReverseGet("37"); got != 37 {
+		t.Errorf("expected 37, got %v", got)
+	}
+}
+
+func TestOptionIso(t *testing.T) {
+	iso := optics.OptionIso(intString)
+
+	if got := iso.Get(gofp.Some(7)); got.Unwrap() != "7" {
+		t.Errorf("expected Some(7), got %v", got)
+	}
+	if got := iso.ReverseGet(gofp.None[string]()); got.IsSome() {
+		t.Errorf("expected None, got %v", got)
+	}
+}
+
+func TestSliceIso(t *testing.T) {
+	iso := optics.SliceIso(intString)
+
+	if got := iso.Get([]int{1, 2}); !slices.Equal(got, []string{"1", "2"}) {
+		t.Errorf("expected [1 2], got %v", got)
+	}
+	if got := iso.ReverseGet(nil); got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
+
+func TestPairSwap(t *testing.T) {
+	iso := optics.PairSwap[string, int]()
+
+	if got := iso.Get(gofp.NewPair("a", 1)); got != gofp.NewPair(1, "a") {
+		t.Errorf("expected (1, a), got %v", got)
+	}
+	if got := iso.ReverseGet(gofp.NewPair(1, "a")); got != gofp.NewPair("a", 1) {
+		t.Errorf("expected (a, 1), got %v", got)
+	}
+}
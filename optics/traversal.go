@@ -0,0 +1,160 @@
+package optics
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// Traversal focuses on zero or more values of type A within a structure of type
+// S. It allows every focused value to be read or updated at once, without
+// mutating the original structure.
+//
+// Type parameter S represents the structure type.
+// Type parameter A represents the focused value type.
+type Traversal[S, A any] struct {
+	getAll func(S) []A
+	modify func(S, func(A) A) S
+}
+
+// NewTraversal creates a [Traversal] from a function that returns every focused
+// value and a function that returns a copy of the structure with f applied to
+// every focused value.
+func NewTraversal[S, A any](getAll func(S) []A, modifyAll func(s S, f func(A) A) S) Traversal[S, A] {
+	return Traversal[S, A]{getAll: getAll, modify: modifyAll}
+}
+
+// Focus creates a [Traversal] that focuses on exactly one value within a
+// structure, such as a field of a struct. The set function must return an
+// updated copy of the structure.
+func Focus[S, A any](get func(S) A, set func(S, A) S) Traversal[S, A] {
+	return Traversal[S, A]{
+		getAll: func(s S) []A {
+			return []A{get(s)}
+		},
+		modify: func(s S, f func(A) A) S {
+			return set(s, f(get(s)))
+		},
+	}
+}
+
+// GetAll returns every value focused on by the [Traversal].
+func (t Traversal[S, A]) GetAll(s S) []A {
+	return t.getAll(s)
+}
+
+// ModifyAll returns a copy of the structure with f applied to every value
+// focused on by the [Traversal].
+func (t Traversal[S, A]) ModifyAll(s S, f func(A) A) S {
+	return t.modify(s, f)
+}
+
+// SetAll returns a copy of the structure with every value focused on by the
+// [Traversal] replaced with a.
+func (t Traversal[S, A]) SetAll(s S, a A) S {
+	return t.modify(s, func(A) A { return a })
+}
+
+// Modify returns a function that applies f to every value focused on by the
+// [Traversal]. Its result may be passed directly to state.Modify.
+func (t Traversal[S, A]) Modify(f func(A) A) func(S) S {
+	return func(s S) S {
+		return t.modify(s, f)
+	}
+}
+
+// ComposeTraversal combines two [Traversal] values, focusing on every B within
+// every A within S.
+func ComposeTraversal[S, A, B any](sa Traversal[S, A], ab Traversal[A, B]) Traversal[S, B] {
+	return Traversal[S, B]{
+		getAll: func(s S) []B {
+			var bs []B
+			for _, a := range sa.getAll(s) {
+				bs = append(bs, ab.getAll(a)...)
+			}
+			return bs
+		},
+		modify: func(s S, f func(B) B) S {
+			return sa.modify(s, func(a A) A {
+				return ab.modify(a, f)
+			})
+		},
+	}
+}
+
+// Each returns a [Traversal] that focuses on every element of a slice.
+func Each[T any]() Traversal[[]T, T] {
+	return Traversal[[]T, T]{
+		getAll: slices.Clone[[]T],
+		modify: func(xs []T, f func(T) T) []T {
+			return mapSlice(xs, f)
+		},
+	}
+}
+
+// Values returns a [Traversal] that focuses on every value of a map. The order
+// in which values are returned by [Traversal.GetAll] is unspecified.
+func Values[K comparable, V any]() Traversal[map[K]V, V] {
+	return Traversal[map[K]V, V]{
+		getAll: func(m map[K]V) []V {
+			vs := make([]V, 0, len(m))
+			for _, v := range m {
+				vs = append(vs, v)
+			}
+			return vs
+		},
+		modify: func(m map[K]V, f func(V) V) map[K]V {
+			if m == nil {
+				return nil
+			}
+			out := maps.Clone(m)
+			for k, v := range out {
+				out[k] = f(v)
+			}
+			return out
+		},
+	}
+}
+
+// SomeValue returns a [Traversal] that focuses on the value of a
+// [gofp.Option] if it is Some.
+func SomeValue[T any]() Traversal[gofp.Option[T], T] {
+	return Traversal[gofp.Option[T], T]{
+		getAll: func(o gofp.Option[T]) []T {
+			if v, ok := o.TryUnwrap(); ok {
+				return []T{v}
+			}
+			return nil
+		},
+		modify: gofp.OptionMap[T, T],
+	}
+}
+
+// RightValue returns a [Traversal] that focuses on the value of a
+// [gofp.Either] if it is Right.
+func RightValue[L, R any]() Traversal[gofp.Either[L, R], R] {
+	return Traversal[gofp.Either[L, R], R]{
+		getAll: func(e gofp.Either[L, R]) []R {
+			if v, ok := e.TryUnwrap(); ok {
+				return []R{v}
+			}
+			return nil
+		},
+		modify: gofp.EitherMap[L, R, R],
+	}
+}
+
+// OkValue returns a [Traversal] that focuses on the value of a [gofp.Result]
+// if it is Ok.
+func OkValue[T any]() Traversal[gofp.Result[T], T] {
+	return Traversal[gofp.Result[T], T]{
+		getAll: func(r gofp.Result[T]) []T {
+			if v, ok := r.TryUnwrap(); ok {
+				return []T{v}
+			}
+			return nil
+		},
+		modify: gofp.ResultMap[T, T],
+	}
+}
@@ -0,0 +1,114 @@
+package optics_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/optics"
+	"github.com/tomasbasham/gofp/state"
+)
+
+type Entry struct {
+	Description string
+	Amount      int
+}
+
+var amount = optics.Focus(
+	func(e Entry) int { return e.Amount },
+	func(e Entry, amount int) Entry {
+		e.Amount = amount
+		return e
+	},
+)
+
+func double(n int) int { return n * 2 }
+
+func TestFocus(t *testing.T) {
+	e := Entry{Description: "deposit", Amount: 10}
+
+	if got := amount.GetAll(e); !slices.Equal(got, []int{10}) {
+		t.Errorf("expected [10], got %v", got)
+	}
+	if got := amount.SetAll(e, 5); got.Amount != 5 || e.Amount != 10 {
+		t.Errorf("expected a copy with amount 5, got %v", got)
+	}
+}
+
+func TestEach(t *testing.T) {
+	entries := []Entry{{"a", 1}, {"b", 2}}
+	amounts := optics.ComposeTraversal(optics.Each[Entry](), amount)
+
+	if got := amounts.GetAll(entries); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", got)
+	}
+
+	got := amounts.ModifyAll(entries, double)
+	if !slices.Equal(got, []Entry{{"a", 2}, {"b", 4}}) {
+		t.Errorf("expected doubled amounts, got %v", got)
+	}
+	if entries[0].Amount != 1 {
+		t.Error("expected the original slice to be unchanged")
+	}
+}
+
+func TestValues(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	got := optics.Values[string, int]().ModifyAll(m, double)
+	if got["a"] != 2 || got["b"] != 4 {
+		t.Errorf("expected doubled values, got %v", got)
+	}
+	if m["a"] != 1 {
+		t.Error("expected the original map to be unchanged")
+	}
+
+	all := optics.Values[string, int]().GetAll(m)
+	slices.Sort(all)
+	if !slices.Equal(all, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", all)
+	}
+}
+
+func TestContainerTraversals(t *testing.T) {
+	t.Run("option", func(t *testing.T) {
+		tr := optics.SomeValue[int]()
+		if got := tr.ModifyAll(gofp.Some(2), double); got.Unwrap() != 4 {
+			t.Errorf("expected Some(4), got %v", got)
+		}
+		if got := tr.GetAll(gofp.None[int]()); len(got) != 0 {
+			t.Errorf("expected no values, got %v", got)
+		}
+	})
+
+	t.Run("either", func(t *testing.T) {
+		tr := optics.RightValue[string, int]()
+		if got := tr.ModifyAll(gofp.Right[string](2), double); got.Unwrap() != 4 {
+			t.Errorf("expected Right(4), got %v", got)
+		}
+		if got := tr.GetAll(gofp.Left[string, int]("no")); len(got) != 0 {
+			t.Errorf("expected no values, got %v", got)
+		}
+	})
+
+	t.Run("result", func(t *testing.T) {
+		tr := optics.OkValue[int]()
+		if got := tr.GetAll(gofp.Ok(3)); !slices.Equal(got, []int{3}) {
+			t.Errorf("expected [3], got %v", got)
+		}
+		err := errors.New("failed")
+		if got := tr.ModifyAll(gofp.Err[int](err), double); got.UnwrapErr() != err {
+			t.Errorf("expected error to propagate, got %v", got)
+		}
+	})
+}
+
+func TestTraversal_Modify(t *testing.T) {
+	amounts := optics.ComposeTraversal(optics.Each[Entry](), amount)
+
+	_, final := state.Modify(amounts.Modify(double)).Run([]Entry{{"a", 1}, {"b", 2}})
+	if !slices.Equal(final, []Entry{{"a", 2}, {"b", 4}}) {
+		t.Errorf("expected doubled amounts, got %v", final)
+	}
+}
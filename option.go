@@ -1,7 +1,9 @@
 package gofp
 
 import (
+	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -35,6 +37,35 @@ func None[T any]() Option[T] {
 	return Option[T]{}
 }
 
+// OptionFromPtr returns an [Option] holding the value pointed to by p, or None
+// if p is nil.
+func OptionFromPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// OptionFromZero returns an [Option] holding v, or None if v is the zero value
+// of its type.
+func OptionFromZero[T comparable](v T) Option[T] {
+	var zero T
+	if v == zero {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// OptionFromMap returns an [Option] holding the value stored in m under key k,
+// or None if the key is not present.
+func OptionFromMap[K comparable, V any](m map[K]V, k K) Option[V] {
+	v, ok := m[k]
+	if !ok {
+		return None[V]()
+	}
+	return Some(v)
+}
+
 // OptionMap applies a function to transform the value type of an
 // [Option]. Similar to the [Option.Map] method but allows changing the value
 // type.
@@ -80,6 +111,38 @@ func OptionSequence[T any](options []Option[T]) Option[[]T] {
 	return values
 }
 
+// OptionSequenceMapKeyed partitions a map of [Option] values by key, returning
+// the values of every Some under its original key, along with the set of keys
+// whose values were None. Both maps are non-nil.
+func OptionSequenceMapKeyed[K comparable, V any](options map[K]Option[V]) (map[K]V, map[K]struct{}) {
+	values := make(map[K]V, len(options))
+	missing := make(map[K]struct{})
+	for k, o := range options {
+		if !o.valid {
+			missing[k] = struct{}{}
+			continue
+		}
+		values[k] = o.value
+	}
+	return values, missing
+}
+
+// OptionTraverseIndexed applies a function to each element of a slice along
+// with its index. If every element returns Some, it returns Right with a slice
+// of all values, preserving order. Otherwise it stops at the first None and
+// returns Left with the index of the element that caused the failure.
+func OptionTraverseIndexed[T, U any](xs []T, fn func(int, T) Option[U]) Either[int, []U] {
+	values := make([]U, 0, len(xs))
+	for i, x := range xs {
+		o := fn(i, x)
+		if !o.valid {
+			return Left[int, []U](i)
+		}
+		values = append(values, o.value)
+	}
+	return Right[int](values)
+}
+
 // OptionFold applies one of two functions to the value of the [Option]
 // depending on whether it is Some or None.
 func OptionFold[T, R any](o Option[T], none func() R, some func(T) R) R {
@@ -89,9 +152,77 @@ func OptionFold[T, R any](o Option[T], none func() R, some func(T) R) R {
 	return some(o.value)
 }
 
+// OptionZip combines two [Option] values into an [Option] of a [Pair] if both
+// are Some, or otherwise returns None.
+func OptionZip[T, U any](a Option[T], b Option[U]) Option[Pair[T, U]] {
+	return OptionZipWith(a, b, NewPair[T, U])
+}
+
+// OptionZipWith combines the values of two [Option] values using the given
+// function if both are Some, or otherwise returns None. Similar to the
+// [Option.ZipWith] method but allows changing the value types.
+func OptionZipWith[T, U, R any](a Option[T], b Option[U], fn func(T, U) R) Option[R] {
+	if !a.valid || !b.valid {
+		return None[R]()
+	}
+	return Some(fn(a.value, b.value))
+}
+
+// OptionMapOr applies a function to the value of an [Option] if it is Some, or
+// otherwise returns the given default value. Similar to the [Option.MapOr]
+// method but allows changing the value type.
+func OptionMapOr[T, U any](o Option[T], defaultValue U, fn func(T) U) U {
+	if !o.valid {
+		return defaultValue
+	}
+	return fn(o.value)
+}
+
+// OptionMapOrElse applies a function to the value of an [Option] if it is
+// Some, or otherwise returns the result of calling the default function.
+// Similar to the [Option.MapOrElse] method but allows changing the value type.
+func OptionMapOrElse[T, U any](o Option[T], defaultFn func() U, fn func(T) U) U {
+	return OptionFold(o, defaultFn, fn)
+}
+
+// OptionCompare compares two [Option] values using cmp to compare their values.
+// None is ordered before any Some value, and two None values are equal. The
+// result follows the convention of [cmp.Compare].
+func OptionCompare[T any](a, b Option[T], cmp func(T, T) int) int {
+	switch {
+	case !a.valid && !b.valid:
+		return 0
+	case !a.valid:
+		return -1
+	case !b.valid:
+		return 1
+	}
+	return cmp(a.value, b.value)
+}
+
+// OptionComparator lifts a [Comparator] on T into a [Comparator] on [Option]
+// values, as described by [OptionCompare]. The result may be passed directly
+// to [slices.SortFunc].
+func OptionComparator[T any](c Comparator[T]) Comparator[Option[T]] {
+	return func(a, b Option[T]) int {
+		return OptionCompare(a, b, c)
+	}
+}
+
+// FirstSome returns the first of the given [Option] values that is Some, or
+// None if none of them are. This is useful for expressing fallback chains.
+func FirstSome[T any](options ...Option[T]) Option[T] {
+	for _, o := range options {
+		if o.valid {
+			return o
+		}
+	}
+	return None[T]()
+}
+
 func (o Option[T]) String() string {
 	if o.valid {
-		return fmt.Sprintf("Some(%v)", o.value)
+		return fmt.Sprintf("Some(%s)", DebugString(o.value))
 	}
 	return "None"
 }
@@ -106,6 +237,24 @@ func (o Option[T]) IsNone() bool {
 	return !o.valid
 }
 
+// IsSomeAnd returns true if the [Option] is Some and its value satisfies the
+// given predicate.
+func (o Option[T]) IsSomeAnd(fn func(T) bool) bool {
+	return o.valid && fn(o.value)
+}
+
+// MapOr applies a function to the value of the [Option] if it is Some, or
+// otherwise returns the given default value.
+func (o Option[T]) MapOr(defaultValue T, fn func(T) T) T {
+	return OptionMapOr(o, defaultValue, fn)
+}
+
+// MapOrElse applies a function to the value of the [Option] if it is Some, or
+// otherwise returns the result of calling the default function.
+func (o Option[T]) MapOrElse(defaultFn func() T, fn func(T) T) T {
+	return OptionMapOrElse(o, defaultFn, fn)
+}
+
 // TryUnwrap returns the value of the [Option] and a boolean indicating whether
 // the [Option] is Some.
 func (o Option[T]) TryUnwrap() (T, bool) {
@@ -142,6 +291,25 @@ func (o Option[T]) UnwrapOrElse(fn func() T) T {
 	return o.value
 }
 
+// UnwrapOrDefault returns the value of the [Option] or the default value of its
+// type, as given by [DefaultOf], if the [Option] is None.
+func (o Option[T]) UnwrapOrDefault() T {
+	if !o.valid {
+		return DefaultOf[T]()
+	}
+	return o.value
+}
+
+// ToPtr returns a pointer to a copy of the value of the [Option], or nil if it
+// is None.
+func (o Option[T]) ToPtr() *T {
+	if !o.valid {
+		return nil
+	}
+	v := o.value
+	return &v
+}
+
 // And returns the receiver [Options] if it is None, otherwise it returns the
 // given [Option].
 func (o Option[T]) And(opt Option[T]) Option[T] {
@@ -178,6 +346,25 @@ func (o Option[T]) OrElse(fn func() Option[T]) Option[T] {
 	return fn()
 }
 
+// Xor returns whichever of the receiver [Option] and the given [Option] is
+// Some if exactly one of them is, otherwise it returns None.
+func (o Option[T]) Xor(opt Option[T]) Option[T] {
+	switch {
+	case o.valid && !opt.valid:
+		return o
+	case !o.valid && opt.valid:
+		return opt
+	default:
+		return None[T]()
+	}
+}
+
+// ZipWith combines the values of the receiver [Option] and the given [Option]
+// using the given function if both are Some, or otherwise returns None.
+func (o Option[T]) ZipWith(opt Option[T], fn func(T, T) T) Option[T] {
+	return OptionZipWith(o, opt, fn)
+}
+
 // Filter converts a Some value to None if it doesn't satisfy the given
 // predicate.
 func (o Option[T]) Filter(fn func(T) bool) Option[T] {
@@ -187,6 +374,13 @@ func (o Option[T]) Filter(fn func(T) bool) Option[T] {
 	return o
 }
 
+// Insert replaces the contents of the [Option] with Some holding the given
+// value, discarding any previous value, and returns the value.
+func (o *Option[T]) Insert(value T) T {
+	*o = Some(value)
+	return value
+}
+
 func (o Option[T]) MarshalJSON() ([]byte, error) {
 	if !o.valid {
 		return nil, nil
@@ -195,7 +389,7 @@ func (o Option[T]) MarshalJSON() ([]byte, error) {
 }
 
 func (o *Option[T]) UnmarshalJSON(data []byte) error {
-	if len(data) == 0 || string(data) == "null" {
+	if isJSONNull(data) {
 		*o = None[T]()
 		return nil
 	}
@@ -208,9 +402,55 @@ func (o *Option[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// NullableOption is a variant of [Option] that serializes None as JSON null.
+// isJSONNull reports whether data is empty or the JSON null literal, ignoring
+// surrounding whitespace.
+func isJSONNull(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) == 0 || string(data) == "null"
+}
+
+// NullableOption is a variant of [Option] that serializes None as JSON null,
+// and as an empty XML element with the attribute xsi:nil="true".
 type NullableOption[T any] Option[T]
 
+// MarshalXML encodes the value of a Some as the element start. None is encoded
+// as nothing at all, so that the element is omitted.
+func (o Option[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.valid {
+		return nil
+	}
+	return e.EncodeElement(o.value, start)
+}
+
+// UnmarshalXML decodes the element start as a Some. An element with the
+// attribute xsi:nil="true" is decoded as None. Since an absent element leaves
+// a field unchanged, the zero value of an [Option] field is None.
+func (o *Option[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if isXMLNil(start) {
+		*o = None[T]()
+		return d.Skip()
+	}
+	var value T
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
+
+// xsiNamespace is the XML Schema instance namespace, which defines the nil
+// attribute.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+func isXMLNil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") {
+			return attr.Value == "true" || attr.Value == "1"
+		}
+	}
+	return false
+}
+
 func (o NullableOption[T]) MarshalJSON() ([]byte, error) {
 	if !o.valid {
 		return []byte("null"), nil
@@ -219,7 +459,7 @@ func (o NullableOption[T]) MarshalJSON() ([]byte, error) {
 }
 
 func (o *NullableOption[T]) UnmarshalJSON(data []byte) error {
-	if len(data) == 0 || string(data) == "null" {
+	if isJSONNull(data) {
 		*o = NullableOption[T](None[T]())
 		return nil
 	}
@@ -231,3 +471,21 @@ func (o *NullableOption[T]) UnmarshalJSON(data []byte) error {
 	*o = NullableOption[T](Some(value))
 	return nil
 }
+
+// MarshalXML encodes the value of a Some as the element start. None is encoded
+// as an empty element with the attribute xsi:nil="true".
+func (o NullableOption[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.valid {
+		start.Attr = append(start.Attr,
+			xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+			xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+		)
+		return e.EncodeElement("", start)
+	}
+	return e.EncodeElement(o.value, start)
+}
+
+// UnmarshalXML decodes the element start as for [Option.UnmarshalXML].
+func (o *NullableOption[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return (*Option[T])(o).UnmarshalXML(d, start)
+}
@@ -1,6 +1,11 @@
 package gofp_test
 
 import (
+	"cmp"
+	"encoding/xml"
+	"maps"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/tomasbasham/gofp"
@@ -23,6 +28,35 @@ func TestNone(t *testing.T) {
 	}
 }
 
+func TestOptionFromPtr(t *testing.T) {
+	v := 42
+	if got := gofp.OptionFromPtr(&v); got.Unwrap() != 42 {
+		t.Errorf("expected Some(42), got %v", got)
+	}
+	if got := gofp.OptionFromPtr[int](nil); got.IsSome() {
+		t.Errorf("expected None, got %v", got)
+	}
+}
+
+func TestOptionFromZero(t *testing.T) {
+	if got := gofp.OptionFromZero("test"); got.Unwrap() != "test" {
+		t.Errorf("expected Some(test), got %v", got)
+	}
+	if got := gofp.OptionFromZero(""); got.IsSome() {
+		t.Errorf("expected None, got %v", got)
+	}
+}
+
+func TestOptionFromMap(t *testing.T) {
+	m := map[string]int{"a": 0}
+	if got := gofp.OptionFromMap(m, "a"); got.IsNone() || got.Unwrap() != 0 {
+		t.Errorf("expected Some(0), got %v", got)
+	}
+	if got := gofp.OptionFromMap(m, "b"); got.IsSome() {
+		t.Errorf("expected None, got %v", got)
+	}
+}
+
 func TestOptionMap(t *testing.T) {
 	t.Run("maps Some value", func(t *testing.T) {
 		o := gofp.Some("test")
@@ -189,6 +223,92 @@ func TestOptionFlat_Map(t *testing.T) {
 	})
 }
 
+func TestOptionTraverseIndexed(t *testing.T) {
+	t.Run("collects all Some values", func(t *testing.T) {
+		got := gofp.OptionTraverseIndexed([]int{1, 2}, func(i, x int) gofp.Option[int] {
+			return gofp.Some(i + x)
+		})
+		values := got.Unwrap()
+		if len(values) != 2 || values[0] != 1 || values[1] != 3 {
+			t.Errorf("expected [1 3], got %v", got)
+		}
+	})
+
+	t.Run("reports index of first None", func(t *testing.T) {
+		got := gofp.OptionTraverseIndexed([]int{1, -1, -2}, func(_, x int) gofp.Option[int] {
+			if x < 0 {
+				return gofp.None[int]()
+			}
+			return gofp.Some(x)
+		})
+		if got.UnwrapLeft() != 1 {
+			t.Errorf("expected Left(1), got %v", got)
+		}
+	})
+}
+
+func TestOptionSequenceMapKeyed(t *testing.T) {
+	values, missing := gofp.OptionSequenceMapKeyed(map[string]gofp.Option[int]{
+		"a": gofp.Some(1),
+		"b": gofp.None[int](),
+		"c": gofp.Some(3),
+	})
+
+	if want := map[string]int{"a": 1, "c": 3}; !maps.Equal(values, want) {
+		t.Errorf("expected values %v, got %v", want, values)
+	}
+	if want := map[string]struct{}{"b": {}}; !maps.Equal(missing, want) {
+		t.Errorf("expected missing keys %v, got %v", want, missing)
+	}
+}
+
+func TestOptionCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b gofp.Option[int]
+		want int
+	}{
+		{"both None", gofp.None[int](), gofp.None[int](), 0},
+		{"None before Some", gofp.None[int](), gofp.Some(1), -1},
+		{"Some after None", gofp.Some(1), gofp.None[int](), 1},
+		{"compares values", gofp.Some(1), gofp.Some(2), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gofp.OptionCompare(tt.a, tt.b, cmp.Compare[int]); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOptionComparator(t *testing.T) {
+	xs := []gofp.Option[int]{gofp.Some(3), gofp.None[int](), gofp.Some(1)}
+	slices.SortFunc(xs, gofp.OptionComparator(gofp.Natural[int]()).Reversed())
+
+	want := []gofp.Option[int]{gofp.Some(3), gofp.Some(1), gofp.None[int]()}
+	if !slices.Equal(xs, want) {
+		t.Errorf("expected %v, got %v", want, xs)
+	}
+}
+
+func TestFirstSome(t *testing.T) {
+	t.Run("returns first Some value", func(t *testing.T) {
+		got := gofp.FirstSome(gofp.None[int](), gofp.Some(1), gofp.Some(2))
+		if got.Unwrap() != 1 {
+			t.Errorf("expected 1, got %v", got)
+		}
+	})
+
+	t.Run("returns None when all values are None", func(t *testing.T) {
+		got := gofp.FirstSome(gofp.None[int](), gofp.None[int]())
+		if !got.IsNone() {
+			t.Error("expected None")
+		}
+	})
+}
+
 func TestOption_String(t *testing.T) {
 	t.Run("formats Some value", func(t *testing.T) {
 		o := gofp.Some("test")
@@ -203,6 +323,13 @@ func TestOption_String(t *testing.T) {
 			t.Error("expected None")
 		}
 	})
+
+	t.Run("formats map keys in order", func(t *testing.T) {
+		o := gofp.Some(map[int]string{2: "a", 10: "b", 1: "c"})
+		if got := o.String(); got != "Some(map[1:c 2:a 10:b])" {
+			t.Errorf("expected Some(map[1:c 2:a 10:b]), got %q", got)
+		}
+	})
 }
 
 func TestOption_UnwrapOr(t *testing.T) {
@@ -237,6 +364,26 @@ func TestOption_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestOption_ToPtr(t *testing.T) {
+	t.Run("returns pointer to a copy for Some", func(t *testing.T) {
+		o := gofp.Some(42)
+		p := o.ToPtr()
+		if p == nil || *p != 42 {
+			t.Fatalf("expected pointer to 42, got %v", p)
+		}
+		*p = 0
+		if o.Unwrap() != 42 {
+			t.Error("expected option to be unaffected by writes through the pointer")
+		}
+	})
+
+	t.Run("returns nil for None", func(t *testing.T) {
+		if p := gofp.None[int]().ToPtr(); p != nil {
+			t.Errorf("expected nil, got %v", p)
+		}
+	})
+}
+
 func TestOption_And(t *testing.T) {
 	t.Run("returns second option when first is Some", func(t *testing.T) {
 		o1 := gofp.Some("first")
@@ -461,3 +608,248 @@ func TestOption_UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+type xmlPerson struct {
+	XMLName  xml.Name                 `xml:"person"`
+	Name     string                   `xml:"name"`
+	Nickname gofp.Option[string]      `xml:"nickname"`
+	Age      gofp.NullableOption[int] `xml:"age"`
+}
+
+func TestOption_MarshalXML(t *testing.T) {
+	t.Run("marshals Some values", func(t *testing.T) {
+		p := xmlPerson{Name: "Alice", Nickname: gofp.Some("Al"), Age: gofp.NullableOption[int](gofp.Some(30))}
+		got, err := xml.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `<person><name>Alice</name><nickname>Al</nickname><age>30</age></person>`
+		if string(got) != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	})
+
+	t.Run("omits None and marks NullableOption nil", func(t *testing.T) {
+		p := xmlPerson{Name: "Alice"}
+		got, err := xml.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `<person><name>Alice</name><age xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></age></person>`
+		if string(got) != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	})
+}
+
+func TestOption_UnmarshalXML(t *testing.T) {
+	t.Run("unmarshals Some values", func(t *testing.T) {
+		var p xmlPerson
+		data := `<person><name>Alice</name><nickname>Al</nickname><age>30</age></person>`
+		if err := xml.Unmarshal([]byte(data), &p); err != nil {
+			t.Fatal(err)
+		}
+		if p.Nickname.UnwrapOr("") != "Al" {
+			t.Errorf("expected Some(Al), got %v", p.Nickname)
+		}
+		if gofp.Option[int](p.Age).UnwrapOr(0) != 30 {
+			t.Errorf("expected Some(30), got %v", gofp.Option[int](p.Age))
+		}
+	})
+
+	t.Run("unmarshals missing and nil elements as None", func(t *testing.T) {
+		var p xmlPerson
+		data := `<person xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><name>Alice</name><age xsi:nil="true"/></person>`
+		if err := xml.Unmarshal([]byte(data), &p); err != nil {
+			t.Fatal(err)
+		}
+		if p.Nickname.IsSome() {
+			t.Errorf("expected None, got %v", p.Nickname)
+		}
+		if gofp.Option[int](p.Age).IsSome() {
+			t.Errorf("expected None, got %v", gofp.Option[int](p.Age))
+		}
+	})
+
+	t.Run("unmarshals nil element of Option as None", func(t *testing.T) {
+		var o gofp.Option[int]
+		if err := xml.Unmarshal([]byte(`<v xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></v>`), &o); err != nil {
+			t.Fatal(err)
+		}
+		if o.IsSome() {
+			t.Errorf("expected None, got %v", o)
+		}
+	})
+}
+
+func TestOption_IsSomeAnd(t *testing.T) {
+	positive := func(x int) bool { return x > 0 }
+
+	if !gofp.Some(1).IsSomeAnd(positive) {
+		t.Error("expected true for Some satisfying predicate")
+	}
+	if gofp.Some(-1).IsSomeAnd(positive) {
+		t.Error("expected false for Some not satisfying predicate")
+	}
+	if gofp.None[int]().IsSomeAnd(positive) {
+		t.Error("expected false for None")
+	}
+}
+
+func TestOption_MapOr(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+
+	if got := gofp.Some(2).MapOr(0, double); got != 4 {
+		t.Errorf("expected 4, got %v", got)
+	}
+	if got := gofp.None[int]().MapOr(-1, double); got != -1 {
+		t.Errorf("expected -1, got %v", got)
+	}
+	if got := gofp.None[int]().MapOrElse(func() int { return 7 }, double); got != 7 {
+		t.Errorf("expected 7, got %v", got)
+	}
+}
+
+func TestOptionMapOr(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	if got := gofp.OptionMapOr(gofp.Some("abc"), 0, length); got != 3 {
+		t.Errorf("expected 3, got %v", got)
+	}
+	if got := gofp.OptionMapOr(gofp.None[string](), -1, length); got != -1 {
+		t.Errorf("expected -1, got %v", got)
+	}
+	if got := gofp.OptionMapOrElse(gofp.Some("ab"), func() int { return -1 }, length); got != 2 {
+		t.Errorf("expected 2, got %v", got)
+	}
+}
+
+func TestOption_Xor(t *testing.T) {
+	some1, some2, none := gofp.Some(1), gofp.Some(2), gofp.None[int]()
+
+	tests := map[string]struct {
+		a, b gofp.Option[int]
+		want gofp.Option[int]
+	}{
+		"Some and None": {some1, none, some1},
+		"None and Some": {none, some2, some2},
+		"Some and Some": {some1, some2, none},
+		"None and None": {none, none, none},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.a.Xor(tt.b)
+			if got.String() != tt.want.String() {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOption_ZipWith(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+
+	if got := gofp.Some(1).ZipWith(gofp.Some(2), add); got.UnwrapOr(0) != 3 {
+		t.Errorf("expected Some(3), got %v", got)
+	}
+	if got := gofp.Some(1).ZipWith(gofp.None[int](), add); !got.IsNone() {
+		t.Errorf("expected None, got %v", got)
+	}
+}
+
+func TestOptionZip(t *testing.T) {
+	got := gofp.OptionZip(gofp.Some("a"), gofp.Some(1))
+	if got.UnwrapOr(gofp.Pair[string, int]{}) != gofp.NewPair("a", 1) {
+		t.Errorf("expected Some((a, 1)), got %v", got)
+	}
+	if got := gofp.OptionZip(gofp.None[string](), gofp.Some(1)); !got.IsNone() {
+		t.Errorf("expected None, got %v", got)
+	}
+
+	repeat := gofp.OptionZipWith(gofp.Some("ab"), gofp.Some(2), strings.Repeat)
+	if repeat.UnwrapOr("") != "abab" {
+		t.Errorf("expected Some(abab), got %v", repeat)
+	}
+}
+
+func TestOption_Insert(t *testing.T) {
+	o := gofp.None[int]()
+	if got := o.Insert(5); got != 5 {
+		t.Errorf("expected 5, got %v", got)
+	}
+	if o.UnwrapOr(0) != 5 {
+		t.Errorf("expected Some(5), got %v", o)
+	}
+
+	o.Insert(6)
+	if o.UnwrapOr(0) != 6 {
+		t.Errorf("expected Some(6), got %v", o)
+	}
+}
+
+func FuzzOptionJSON(f *testing.F) {
+	for _, seed := range []string{``, `null`, ` null `, `""`, `"test"`, `"é"`, `1`, `{}`} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		o := gofp.Some("stale")
+		if err := o.UnmarshalJSON(data); err != nil {
+			return
+		}
+		var fresh gofp.Option[string]
+		fresh.UnmarshalJSON(data)
+		if o != fresh {
+			t.Fatalf("expected %v from %q regardless of previous state, got %v", fresh, data, o)
+		}
+		if trimmed := strings.TrimSpace(string(data)); (trimmed == "" || trimmed == "null") && o.IsSome() {
+			t.Fatalf("expected None from %q, got %v", data, o)
+		}
+
+		encoded, err := o.MarshalJSON()
+		if err != nil {
+			t.Fatalf("expected no error marshalling %v, got %v", o, err)
+		}
+		var decoded gofp.Option[string]
+		if err := decoded.UnmarshalJSON(encoded); err != nil {
+			t.Fatalf("expected no error unmarshalling %q, got %v", encoded, err)
+		}
+		if decoded != o {
+			t.Fatalf("expected %v to round trip, got %v", o, decoded)
+		}
+	})
+}
+
+func FuzzNullableOptionJSON(f *testing.F) {
+	for _, seed := range []string{``, `null`, ` null `, `0`, `-12`, `1e3`, `"1"`} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		o := gofp.NullableOption[int](gofp.Some(-1))
+		if err := o.UnmarshalJSON(data); err != nil {
+			return
+		}
+		var fresh gofp.NullableOption[int]
+		fresh.UnmarshalJSON(data)
+		if o != fresh {
+			t.Fatalf("expected %v from %q regardless of previous state, got %v", gofp.Option[int](fresh), data, gofp.Option[int](o))
+		}
+		if trimmed := strings.TrimSpace(string(data)); (trimmed == "" || trimmed == "null") && gofp.Option[int](o).IsSome() {
+			t.Fatalf("expected None from %q, got %v", data, gofp.Option[int](o))
+		}
+
+		encoded, err := o.MarshalJSON()
+		if err != nil {
+			t.Fatalf("expected no error marshalling %v, got %v", gofp.Option[int](o), err)
+		}
+		var decoded gofp.NullableOption[int]
+		if err := decoded.UnmarshalJSON(encoded); err != nil {
+			t.Fatalf("expected no error unmarshalling %q, got %v", encoded, err)
+		}
+		if decoded != o {
+			t.Fatalf("expected %v to round trip, got %v", gofp.Option[int](o), gofp.Option[int](decoded))
+		}
+	})
+}
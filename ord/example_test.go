@@ -0,0 +1,25 @@
+package ord_test
+
+import (
+	"fmt"
+
+	"github.com/tomasbasham/gofp/ord"
+)
+
+func ExampleMaxOf() {
+	fmt.Println(ord.MaxOf([]int{3, 7, 1}))
+	fmt.Println(ord.MaxOf([]int{}))
+	// Output:
+	// Some(7)
+	// None
+}
+
+func ExampleOrdering_Then() {
+	type version struct{ major, minor int }
+	cmp := func(a, b version) ord.Ordering {
+		return ord.Compare(a.major, b.major).Then(ord.Compare(a.minor, b.minor))
+	}
+	fmt.Println(cmp(version{1, 2}, version{1, 3}))
+	// Output:
+	// Less
+}
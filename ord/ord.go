@@ -0,0 +1,122 @@
+// Package ord provides generic comparison and ordering helpers.
+//
+// Comparators are functions of the form func(a, b T) int, as taken by
+// [slices.SortFunc] and given by [cmp.Compare] and [gofp.Comparator]. The
+// [Ordering] type describes the result of comparing two values and forms a
+// Monoid under [OrderingMonoid], allowing comparators over multiple keys to be
+// composed, with earlier keys taking precedence over later ones.
+package ord
+
+import (
+	"cmp"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// Ordering is the result of comparing two values. Converted to an int, it is a
+// valid result for a comparator.
+type Ordering int
+
+const (
+	// Less indicates that the first value is less than the second.
+	Less Ordering = -1
+
+	// Equal indicates that both values are equal.
+	Equal Ordering = 0
+
+	// Greater indicates that the first value is greater than the second.
+	Greater Ordering = 1
+)
+
+func (o Ordering) String() string {
+	switch o {
+	case Less:
+		return "Less"
+	case Greater:
+		return "Greater"
+	default:
+		return "Equal"
+	}
+}
+
+// Then returns the receiver [Ordering] unless it is [Equal], in which case it
+// returns the given [Ordering]. This is useful for comparing values by multiple
+// keys.
+func (o Ordering) Then(other Ordering) Ordering {
+	if o != Equal {
+		return o
+	}
+	return other
+}
+
+// Reverse returns the opposite [Ordering].
+func (o Ordering) Reverse() Ordering {
+	return -o
+}
+
+// OrderingMonoid is a Monoid for [Ordering] values. The empty value is [Equal]
+// and values are combined using [Ordering.Then].
+type OrderingMonoid struct{}
+
+// Empty returns [Equal].
+func (OrderingMonoid) Empty() Ordering {
+	return Equal
+}
+
+// Append combines two [Ordering] values, preferring the first unless it is
+// [Equal].
+func (OrderingMonoid) Append(a, b Ordering) Ordering {
+	return a.Then(b)
+}
+
+// Compare returns the [Ordering] of two values, as given by [cmp.Compare].
+func Compare[T cmp.Ordered](a, b T) Ordering {
+	return Ordering(cmp.Compare(a, b))
+}
+
+// Clamp restricts a value to the inclusive range [lo, hi].
+func Clamp[T cmp.Ordered](x, lo, hi T) T {
+	return min(max(x, lo), hi)
+}
+
+// MinBy returns the smallest value of a slice according to the given
+// comparator, or None if the slice is empty. If several values are equally
+// small, the first is returned.
+func MinBy[T any](xs []T, compare func(a, b T) int) gofp.Option[T] {
+	if len(xs) == 0 {
+		return gofp.None[T]()
+	}
+	m := xs[0]
+	for _, x := range xs[1:] {
+		if compare(x, m) < 0 {
+			m = x
+		}
+	}
+	return gofp.Some(m)
+}
+
+// MaxBy returns the largest value of a slice according to the given
+// comparator, or None if the slice is empty. If several values are equally
+// large, the first is returned.
+func MaxBy[T any](xs []T, compare func(a, b T) int) gofp.Option[T] {
+	if len(xs) == 0 {
+		return gofp.None[T]()
+	}
+	m := xs[0]
+	for _, x := range xs[1:] {
+		if compare(x, m) > 0 {
+			m = x
+		}
+	}
+	return gofp.Some(m)
+}
+
+// MinOf returns the smallest value of a slice, or None if the slice is empty.
+func MinOf[T cmp.Ordered](xs []T) gofp.Option[T] {
+	return MinBy(xs, cmp.Compare[T])
+}
+
+// MaxOf returns the largest value of a slice, or None if the slice is empty.
+func MaxOf[T cmp.Ordered](xs []T) gofp.Option[T] {
+	return MaxBy(xs, cmp.Compare[T])
+}
@@ -0,0 +1,105 @@
+package ord_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/ord"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+var _ writer.Monoid[ord.Ordering] = ord.OrderingMonoid{}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b int
+		want ord.Ordering
+	}{
+		{1, 2, ord.Less},
+		{2, 2, ord.Equal},
+		{3, 2, ord.Greater},
+	}
+	for _, tt := range tests {
+		if got := ord.Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%d, %d): expected %v, got %v", tt.a, tt.b, tt.want, got)
+		}
+	}
+}
+
+func TestOrdering_Then(t *testing.T) {
+	if got := ord.Equal.Then(ord.Less); got != ord.Less {
+		t.Errorf("expected Less, got %v", got)
+	}
+	if got := ord.Greater.Then(ord.Less); got != ord.Greater {
+		t.Errorf("expected Greater, got %v", got)
+	}
+}
+
+func TestOrderingMonoid(t *testing.T) {
+	m := ord.OrderingMonoid{}
+	if got := m.Append(m.Empty(), ord.Greater); got != ord.Greater {
+		t.Errorf("expected Greater, got %v", got)
+	}
+	if got := m.Append(ord.Less, m.Empty()); got != ord.Less {
+		t.Errorf("expected Less, got %v", got)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		x, want int
+	}{
+		{-5, 0},
+		{5, 5},
+		{15, 10},
+	}
+	for _, tt := range tests {
+		if got := ord.Clamp(tt.x, 0, 10); got != tt.want {
+			t.Errorf("Clamp(%d, 0, 10): expected %d, got %d", tt.x, tt.want, got)
+		}
+	}
+}
+
+func TestMaxOf(t *testing.T) {
+	t.Run("returns largest value", func(t *testing.T) {
+		got := ord.MaxOf([]int{3, 7, 1})
+		if got.Unwrap() != 7 {
+			t.Errorf("expected 7, got %v", got)
+		}
+	})
+
+	t.Run("returns None for empty slice", func(t *testing.T) {
+		got := ord.MaxOf([]int{})
+		if !got.IsNone() {
+			t.Errorf("expected None, got %v", got)
+		}
+	})
+}
+
+func TestMinBy(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{{"bob", 30}, {"alice", 30}, {"carol", 25}}
+	byAgeThenName := func(a, b person) int {
+		return int(ord.Compare(a.age, b.age).Then(ord.Compare(a.name, b.name)))
+	}
+
+	got := ord.MinBy(people, byAgeThenName)
+	if got.Unwrap().name != "carol" {
+		t.Errorf("expected carol, got %v", got)
+	}
+
+	got = ord.MaxBy(people, byAgeThenName)
+	if got.Unwrap().name != "bob" {
+		t.Errorf("expected bob, got %v", got)
+	}
+}
+
+func TestMaxBy_Comparator(t *testing.T) {
+	got := ord.MaxBy([]int{3, 7, 1}, gofp.Natural[int]().Reversed())
+	if got.Unwrap() != 1 {
+		t.Errorf("expected 1, got %v", got)
+	}
+}
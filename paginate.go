@@ -0,0 +1,73 @@
+package gofp
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrMaxPages is yielded by [PaginateSeq], and returned by [Paginate], when
+// the maximum number of pages have been fetched but the source has more.
+var ErrMaxPages = errors.New("paginated source has more than the maximum pages")
+
+// Page is a single page of items returned by a paginated source, along with the
+// cursor of the next page, if there is one.
+//
+// Type parameter T represents the item type.
+// Type parameter C represents the cursor type.
+type Page[T, C any] struct {
+	Items []T
+	Next  Option[C]
+}
+
+// PaginateSeq lazily fetches pages from a paginated source, yielding each item
+// as an Ok. The first page is fetched with a None cursor, and each subsequent
+// page with the cursor of the page before it. Fetching stops when a page has no
+// next cursor, or when the fetch function returns an Err, which is yielded as
+// the final value.
+//
+// A maxPages less than or equal to zero places no limit on the number of pages
+// fetched. Otherwise, once maxPages pages have been fetched, an Err holding
+// [ErrMaxPages] is yielded as the final value if the last page has a next
+// cursor, so that a truncated sequence can be told apart from a complete one.
+func PaginateSeq[T, C any](fetch func(cursor Option[C]) Result[Page[T, C]], maxPages int) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		cursor := None[C]()
+		for pages := 1; ; pages++ {
+			r := fetch(cursor)
+			if r.isErr {
+				yield(Result[T]{err: r.err, isErr: true, stack: r.stack, meta: r.meta})
+				return
+			}
+			for _, item := range r.value.Items {
+				if !yield(Ok(item)) {
+					return
+				}
+			}
+			if r.value.Next.IsNone() {
+				return
+			}
+			if maxPages > 0 && pages >= maxPages {
+				yield(Err[T](ErrMaxPages))
+				return
+			}
+			cursor = r.value.Next
+		}
+	}
+}
+
+// Paginate eagerly fetches all pages from a paginated source and returns their
+// items in order. It returns the first Err returned by the fetch function, if
+// any, or an Err holding [ErrMaxPages] if the source has more than maxPages
+// pages, in which case none of the items are returned; [PaginateSeq] yields the
+// items fetched before it. See [PaginateSeq] for a description of how pages
+// are fetched.
+func Paginate[T, C any](fetch func(cursor Option[C]) Result[Page[T, C]], maxPages int) Result[[]T] {
+	items := []T{}
+	for r := range PaginateSeq(fetch, maxPages) {
+		if r.isErr {
+			return Result[[]T]{err: r.err, isErr: true, stack: r.stack, meta: r.meta}
+		}
+		items = append(items, r.value)
+	}
+	return Ok(items)
+}
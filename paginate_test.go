@@ -0,0 +1,104 @@
+package gofp_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// pages is a paginated source of three pages, using the page number as the
+// cursor.
+func pages(fetched *int) func(gofp.Option[int]) gofp.Result[gofp.Page[string, int]] {
+	data := [][]string{{"a", "b"}, {"c"}, {"d", "e"}}
+	return func(cursor gofp.Option[int]) gofp.Result[gofp.Page[string, int]] {
+		*fetched++
+		n := cursor.UnwrapOr(0)
+		next := gofp.GuardOption(n+1 < len(data), n+1)
+		return gofp.Ok(gofp.Page[string, int]{Items: data[n], Next: next})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	t.Run("fetches all pages", func(t *testing.T) {
+		fetched := 0
+		got := gofp.Paginate(pages(&fetched), 0)
+		if !reflect.DeepEqual(got.Unwrap(), []string{"a", "b", "c", "d", "e"}) {
+			t.Errorf("unexpected items %v", got)
+		}
+		if fetched != 3 {
+			t.Errorf("expected 3 pages fetched, got %d", fetched)
+		}
+	})
+
+	t.Run("stops at max pages", func(t *testing.T) {
+		fetched := 0
+		got := gofp.Paginate(pages(&fetched), 2)
+		if !errors.Is(got.UnwrapErr(), gofp.ErrMaxPages) {
+			t.Errorf("expected %v, got %v", gofp.ErrMaxPages, got)
+		}
+		if fetched != 2 {
+			t.Errorf("expected 2 pages fetched, got %d", fetched)
+		}
+	})
+
+	t.Run("fetches exactly max pages", func(t *testing.T) {
+		fetched := 0
+		got := gofp.Paginate(pages(&fetched), 3)
+		if !reflect.DeepEqual(got.Unwrap(), []string{"a", "b", "c", "d", "e"}) {
+			t.Errorf("unexpected items %v", got)
+		}
+	})
+
+	t.Run("returns fetch error", func(t *testing.T) {
+		errFetch := errors.New("fetch failed")
+		got := gofp.Paginate(func(cursor gofp.Option[int]) gofp.Result[gofp.Page[string, int]] {
+			if cursor.IsSome() {
+				return gofp.Err[gofp.Page[string, int]](errFetch)
+			}
+			return gofp.Ok(gofp.Page[string, int]{Items: []string{"a"}, Next: gofp.Some(1)})
+		}, 0)
+		if got.UnwrapErr() != errFetch {
+			t.Errorf("expected %v, got %v", errFetch, got)
+		}
+	})
+}
+
+func TestPaginateSeq(t *testing.T) {
+	fetched := 0
+	var got []string
+	for r := range gofp.PaginateSeq(pages(&fetched), 0) {
+		item := r.Unwrap()
+		if item == "c" {
+			break
+		}
+		got = append(got, item)
+	}
+	if !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("unexpected items %v", got)
+	}
+	if fetched != 2 {
+		t.Errorf("expected 2 pages fetched, got %d", fetched)
+	}
+}
+
+func TestPaginateSeq_maxPages(t *testing.T) {
+	fetched := 0
+	var got []string
+	var err error
+	for r := range gofp.PaginateSeq(pages(&fetched), 2) {
+		item, e := r.ToReturn()
+		if e != nil {
+			err = e
+			continue
+		}
+		got = append(got, item)
+	}
+	if !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("unexpected items %v", got)
+	}
+	if err != gofp.ErrMaxPages {
+		t.Errorf("expected %v, got %v", gofp.ErrMaxPages, err)
+	}
+}
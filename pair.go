@@ -0,0 +1,51 @@
+package gofp
+
+import "fmt"
+
+// Pair is a type that holds two values of possibly different types.
+//
+// Type parameter A represents the first value type.
+// Type parameter B represents the second value type.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair returns a [Pair] holding the given values.
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Unpack returns both values of the [Pair].
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%s, %s)", DebugString(p.First), DebugString(p.Second))
+}
+
+// Triple is a type that holds three values of possibly different types.
+//
+// Type parameter A represents the first value type.
+// Type parameter B represents the second value type.
+// Type parameter C represents the third value type.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple returns a [Triple] holding the given values.
+func NewTriple[A, B, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
+
+// Unpack returns all three values of the [Triple].
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%s, %s, %s)", DebugString(t.First), DebugString(t.Second), DebugString(t.Third))
+}
@@ -0,0 +1,29 @@
+package gofp_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestPair(t *testing.T) {
+	p := gofp.NewPair("a", 1)
+	first, second := p.Unpack()
+	if first != "a" || second != 1 {
+		t.Errorf("expected (a, 1), got (%v, %v)", first, second)
+	}
+	if p.String() != "(a, 1)" {
+		t.Errorf("expected (a, 1), got %s", p)
+	}
+}
+
+func TestTriple(t *testing.T) {
+	tr := gofp.NewTriple("a", 1, true)
+	first, second, third := tr.Unpack()
+	if first != "a" || second != 1 || !third {
+		t.Errorf("expected (a, 1, true), got (%v, %v, %v)", first, second, third)
+	}
+	if tr.String() != "(a, 1, true)" {
+		t.Errorf("expected (a, 1, true), got %s", tr)
+	}
+}
@@ -0,0 +1,173 @@
+package gofp
+
+import (
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// ParTraverse applies a function to each element of a slice concurrently,
+// running at most limit calls at once, or one per element if limit is not
+// positive. If every element returns Ok, it returns Ok with a slice of all
+// values in the order of the input, regardless of the order in which the calls
+// complete.
+//
+// Once an element returns an Err no further calls are started, although those
+// already running are allowed to complete. Since elements are started in
+// order, every element before a failed one is always run, so the result is an
+// Err wrapping the error of the first failed element in an [*IndexError],
+// whichever failed first in time.
+//
+// A panic in fn likewise stops further calls from being started, and is raised
+// again on the calling goroutine once those already running have completed.
+func ParTraverse[T, U any](xs []T, limit int, fn func(T) Result[U]) Result[[]U] {
+	return ParTraverseToken(nil, xs, limit, fn)
+}
+
+// ParTraverseToken applies a function to each element of a slice concurrently,
+// as [ParTraverse] does, except that no further calls are started once tok is
+// canceled. In that case the result is an Err holding the cause of the
+// cancellation, unless an element that was run failed. tok may be nil.
+func ParTraverseToken[T, U any](tok *CancelToken, xs []T, limit int, fn func(T) Result[U]) Result[[]U] {
+	results := make([]Result[U], len(xs))
+	canceled := func() bool { return tok.IsCanceled().IsSome() }
+	started := parEach(len(xs), limit, canceled, func(i int) bool {
+		results[i] = fn(xs[i])
+		return !results[i].isErr
+	})
+
+	values := make([]U, len(xs))
+	for i, r := range results[:started] {
+		if r.isErr {
+			return Result[[]U]{err: &IndexError{Index: i, Err: r.err}, isErr: true, stack: r.stack, meta: r.meta}
+		}
+		values[i] = r.value
+	}
+	if started < len(xs) {
+		return Err[[]U](tok.IsCanceled().Unwrap())
+	}
+	return Ok(values)
+}
+
+// ParTraverseUnordered applies a function to each element of a slice
+// concurrently, as [ParTraverse] does, but yields each result along with the
+// index of its element as soon as it completes, so that a consumer may act on
+// fast results without waiting for slow ones. Every element is run regardless
+// of errors. Breaking out of the loop, or a panic in its body, stops further
+// calls from being started, and waits for those already running to complete.
+// A panic in fn does the same, and is raised again from the loop.
+func ParTraverseUnordered[T, U any](xs []T, limit int, fn func(T) Result[U]) iter.Seq2[int, Result[U]] {
+	return ParTraverseUnorderedToken(nil, xs, limit, fn)
+}
+
+// ParTraverseUnorderedToken applies a function to each element of a slice
+// concurrently, yielding each result as soon as it completes, as
+// [ParTraverseUnordered] does, except that canceling tok also stops further
+// calls from being started and ends the loop once those already running have
+// completed. Whether the loop ended because tok was canceled may be
+// determined with [CancelToken.IsCanceled]. tok may be nil.
+func ParTraverseUnorderedToken[T, U any](tok *CancelToken, xs []T, limit int, fn func(T) Result[U]) iter.Seq2[int, Result[U]] {
+	return func(yield func(int, Result[U]) bool) {
+		type indexed struct {
+			i int
+			r Result[U]
+		}
+		out := make(chan indexed)
+		done := make(chan struct{})
+		stop := func() bool {
+			return isClosed(done) || tok.IsCanceled().IsSome()
+		}
+
+		var panicked any
+		go func() {
+			defer close(out)
+			defer func() {
+				panicked = recover()
+			}()
+			parEach(len(xs), limit, stop, func(i int) bool {
+				select {
+				case out <- indexed{i, fn(xs[i])}:
+					return true
+				case <-done:
+					return false
+				}
+			})
+		}()
+
+		// Stop the workers however the loop ends, including when yield
+		// panics, and wait for calls that are already running to complete.
+		// Closing out publishes any panic in fn, which is then raised here.
+		defer func() {
+			close(done)
+			for range out {
+			}
+			if panicked != nil {
+				panic(panicked)
+			}
+		}()
+
+		for v := range out {
+			if !yield(v.i, v.r) {
+				return
+			}
+		}
+	}
+}
+
+// parEach calls f with each index in [0, n), running at most limit calls at
+// once, or n if limit is not positive. Indices are started in order, and no
+// further indices are started once f returns false or stop returns true. It
+// returns once every started call has completed, with the number of indices
+// that were started, all of which precede those that were not. If a call to f
+// panics, no further indices are started, and the first panic is raised again
+// once every started call has completed.
+func parEach(n, limit int, stop func() bool, f func(int) bool) int {
+	workers := n
+	if limit > 0 {
+		workers = min(limit, n)
+	}
+
+	var (
+		next    atomic.Int64
+		stopped atomic.Bool
+		wg      sync.WaitGroup
+
+		panicOnce sync.Once
+		panicked  any
+	)
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if p := recover(); p != nil {
+					stopped.Store(true)
+					panicOnce.Do(func() { panicked = p })
+				}
+			}()
+			for !stopped.Load() && !stop() {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				if !f(i) {
+					stopped.Store(true)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if panicked != nil {
+		panic(panicked)
+	}
+	return min(int(next.Load()), n)
+}
+
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,213 @@
+package gofp_test
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// delayed returns a function that doubles its input after sleeping for a
+// duration that decreases with the input, so that later elements complete
+// first.
+func delayed(n int) func(int) gofp.Result[int] {
+	return func(x int) gofp.Result[int] {
+		time.Sleep(time.Duration(n-x) * 10 * time.Millisecond)
+		return gofp.Ok(x * 2)
+	}
+}
+
+func TestParTraverse(t *testing.T) {
+	t.Run("preserves input order", func(t *testing.T) {
+		got := gofp.ParTraverse([]int{0, 1, 2, 3, 4}, 0, delayed(5))
+		if want := []int{0, 2, 4, 6, 8}; !reflect.DeepEqual(got.Unwrap(), want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("limits concurrency", func(t *testing.T) {
+		var running, peak atomic.Int32
+		got := gofp.ParTraverse(make([]int, 20), 3, func(int) gofp.Result[int] {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			return gofp.Ok(0)
+		})
+
+		if got.IsErr() {
+			t.Fatalf("expected Ok, got %v", got)
+		}
+		if p := peak.Load(); p > 3 {
+			t.Errorf("expected at most 3 concurrent calls, got %d", p)
+		}
+	})
+
+	t.Run("returns the error of the first failed element", func(t *testing.T) {
+		err1 := errors.New("error 1")
+		err3 := errors.New("error 3")
+		got := gofp.ParTraverse([]int{0, 1, 2, 3}, 0, func(x int) gofp.Result[int] {
+			switch x {
+			case 1:
+				time.Sleep(10 * time.Millisecond)
+				return gofp.Err[int](err1)
+			case 3:
+				return gofp.Err[int](err3)
+			}
+			return gofp.Ok(x)
+		})
+
+		var indexErr *gofp.IndexError
+		if !errors.As(got.UnwrapErr(), &indexErr) || indexErr.Index != 1 || indexErr.Err != err1 {
+			t.Errorf("expected error at index 1, got %v", got.UnwrapErr())
+		}
+	})
+
+	t.Run("stops starting calls after an error", func(t *testing.T) {
+		var calls atomic.Int32
+		got := gofp.ParTraverse(make([]int, 10), 1, func(int) gofp.Result[int] {
+			if calls.Add(1) == 2 {
+				return gofp.Err[int](errors.New("error"))
+			}
+			return gofp.Ok(0)
+		})
+
+		if got.IsOk() {
+			t.Fatalf("expected Err, got %v", got)
+		}
+		if n := calls.Load(); n != 2 {
+			t.Errorf("expected 2 calls, got %d", n)
+		}
+	})
+
+	t.Run("raises a panic in fn on the caller", func(t *testing.T) {
+		var calls atomic.Int32
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Errorf("expected the panic to propagate, got %v", p)
+			}
+			if n := calls.Load(); n != 2 {
+				t.Errorf("expected 2 calls, got %d", n)
+			}
+		}()
+		gofp.ParTraverse(make([]int, 10), 1, func(int) gofp.Result[int] {
+			if calls.Add(1) == 2 {
+				panic("boom")
+			}
+			return gofp.Ok(0)
+		})
+		t.Error("expected ParTraverse to panic")
+	})
+
+	t.Run("returns an empty slice for no elements", func(t *testing.T) {
+		got := gofp.ParTraverse(nil, 0, delayed(0))
+		if len(got.Unwrap()) != 0 {
+			t.Errorf("expected empty slice, got %v", got)
+		}
+	})
+}
+
+func TestParTraverseUnordered(t *testing.T) {
+	t.Run("yields every result with its index", func(t *testing.T) {
+		var order []int
+		got := make([]int, 5)
+		for i, r := range gofp.ParTraverseUnordered([]int{0, 1, 2, 3, 4}, 0, delayed(5)) {
+			order = append(order, i)
+			got[i] = r.Unwrap()
+		}
+
+		if want := []int{0, 2, 4, 6, 8}; !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if want := []int{4, 3, 2, 1, 0}; !reflect.DeepEqual(order, want) {
+			t.Errorf("expected results in completion order %v, got %v", want, order)
+		}
+	})
+
+	t.Run("runs every element regardless of errors", func(t *testing.T) {
+		var errs int
+		for _, r := range gofp.ParTraverseUnordered([]int{0, 1, 2}, 0, func(int) gofp.Result[int] {
+			return gofp.Err[int](errors.New("error"))
+		}) {
+			if r.IsErr() {
+				errs++
+			}
+		}
+		if errs != 3 {
+			t.Errorf("expected 3 errors, got %d", errs)
+		}
+	})
+
+	t.Run("stops starting calls when the loop breaks", func(t *testing.T) {
+		var calls atomic.Int32
+		var seen []int
+		for i := range gofp.ParTraverseUnordered(make([]int, 100), 2, func(int) gofp.Result[int] {
+			calls.Add(1)
+			return gofp.Ok(0)
+		}) {
+			seen = append(seen, i)
+			if len(seen) == 3 {
+				break
+			}
+		}
+
+		// Calls already running when the loop broke are allowed to complete.
+		if n := calls.Load(); n > 6 {
+			t.Errorf("expected calls to stop shortly after the loop broke, got %d", n)
+		}
+		slices.Sort(seen)
+		if seen = slices.Compact(seen); len(seen) != 3 {
+			t.Errorf("expected 3 distinct indices, got %v", seen)
+		}
+	})
+	t.Run("raises a panic in fn from the loop", func(t *testing.T) {
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Errorf("expected the panic to propagate, got %v", p)
+			}
+		}()
+		for range gofp.ParTraverseUnordered(make([]int, 10), 2, func(int) gofp.Result[int] {
+			panic("boom")
+		}) {
+		}
+		t.Error("expected the loop to panic")
+	})
+
+	t.Run("stops its goroutines when the loop panics", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+		var calls atomic.Int32
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected the panic to propagate")
+				}
+			}()
+			for range gofp.ParTraverseUnordered(make([]int, 100), 2, func(int) gofp.Result[int] {
+				calls.Add(1)
+				return gofp.Ok(0)
+			}) {
+				panic("boom")
+			}
+		}()
+
+		if n := calls.Load(); n > 4 {
+			t.Errorf("expected calls to stop shortly after the panic, got %d", n)
+		}
+		for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before; {
+			if time.Now().After(deadline) {
+				t.Fatalf("expected goroutines to stop, %d still running", runtime.NumGoroutine()-before)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+}
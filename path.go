@@ -0,0 +1,32 @@
+package gofp
+
+// Go methods cannot introduce type parameters, so a navigation through values
+// of different types cannot be written as a chain of method calls. The
+// OptionPath functions instead take each step of the navigation in turn, which
+// expresses an optional chain such as a?.b?.c without nesting a call to
+// [OptionFlatMap] for each level. A step returning a pointer may be adapted
+// with [OptionFromPtr].
+
+// OptionPath2 navigates from the value of o through two steps, returning None
+// as soon as o or any step is None.
+func OptionPath2[A, B, C any](o Option[A], f func(A) Option[B], g func(B) Option[C]) Option[C] {
+	return OptionFlatMap(OptionFlatMap(o, f), g)
+}
+
+// OptionPath3 navigates from the value of o through three steps, returning
+// None as soon as o or any step is None.
+func OptionPath3[A, B, C, D any](o Option[A], f func(A) Option[B], g func(B) Option[C], h func(C) Option[D]) Option[D] {
+	return OptionFlatMap(OptionPath2(o, f, g), h)
+}
+
+// OptionPath4 navigates from the value of o through four steps, returning
+// None as soon as o or any step is None.
+func OptionPath4[A, B, C, D, E any](o Option[A], f func(A) Option[B], g func(B) Option[C], h func(C) Option[D], i func(D) Option[E]) Option[E] {
+	return OptionFlatMap(OptionPath3(o, f, g, h), i)
+}
+
+// OptionPath5 navigates from the value of o through five steps, returning
+// None as soon as o or any step is None.
+func OptionPath5[A, B, C, D, E, F any](o Option[A], f func(A) Option[B], g func(B) Option[C], h func(C) Option[D], i func(D) Option[E], j func(E) Option[F]) Option[F] {
+	return OptionFlatMap(OptionPath4(o, f, g, h, i), j)
+}
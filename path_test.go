@@ -0,0 +1,55 @@
+package gofp_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+)
+
+type (
+	pathCompany struct{ CEO *pathPerson }
+	pathPerson  struct{ Address gofp.Option[pathAddress] }
+	pathAddress struct{ City string }
+)
+
+func ceo(c pathCompany) gofp.Option[pathPerson] { return gofp.OptionFromPtr(c.CEO) }
+
+func address(p pathPerson) gofp.Option[pathAddress] { return p.Address }
+
+func city(a pathAddress) gofp.Option[string] { return gofp.OptionFromZero(a.City) }
+
+func TestOptionPath(t *testing.T) {
+	company := pathCompany{CEO: &pathPerson{Address: gofp.Some(pathAddress{City: "London"})}}
+
+	t.Run("Some when every step is Some", func(t *testing.T) {
+		assert.SomeEqual(t, gofp.OptionPath3(gofp.Some(company), ceo, address, city), "London")
+	})
+
+	t.Run("None when the root is None", func(t *testing.T) {
+		assert.None(t, gofp.OptionPath3(gofp.None[pathCompany](), ceo, address, city))
+	})
+
+	t.Run("None when an intermediate step is None", func(t *testing.T) {
+		assert.None(t, gofp.OptionPath3(gofp.Some(pathCompany{}), ceo, address, city))
+	})
+
+	t.Run("stops at the first None", func(t *testing.T) {
+		called := false
+		length := func(s string) gofp.Option[int] {
+			called = true
+			return gofp.Some(len(s))
+		}
+		unset := pathCompany{CEO: &pathPerson{}}
+		assert.None(t, gofp.OptionPath4(gofp.Some(unset), ceo, address, city, length))
+		if called {
+			t.Error("expected steps after None not to be called")
+		}
+	})
+
+	t.Run("arities", func(t *testing.T) {
+		inc := func(n int) gofp.Option[int] { return gofp.Some(n + 1) }
+		assert.SomeEqual(t, gofp.OptionPath2(gofp.Some(0), inc, inc), 2)
+		assert.SomeEqual(t, gofp.OptionPath5(gofp.Some(0), inc, inc, inc, inc, inc), 5)
+	})
+}
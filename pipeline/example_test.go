@@ -0,0 +1,36 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/pipeline"
+)
+
+func ExamplePipeline_String() {
+	trim := pipeline.Describe("trim", func(s string) gofp.Result[string] {
+		return gofp.Ok(strings.TrimSpace(s))
+	})
+	lower := pipeline.Describe("lower", func(s string) gofp.Result[string] {
+		return gofp.Ok(strings.ToLower(s))
+	})
+	length := pipeline.Describe("length", func(s string) gofp.Result[int] {
+		return gofp.Ok(len(s))
+	})
+
+	normalise := pipeline.Group("normalise", pipeline.Then(trim, lower))
+	p := pipeline.Zip("summary", normalise, length, func(s string, n int) string {
+		return fmt.Sprintf("%s (%d)", s, n)
+	})
+
+	fmt.Print(p)
+	fmt.Println(p.Run("  Hello ").Unwrap())
+	// Output:
+	// summary (zip)
+	// ├── normalise
+	// │   ├── trim
+	// │   └── lower
+	// └── length
+	// hello (8)
+}
@@ -0,0 +1,173 @@
+// Package pipeline provides a builder for composing named stages into a
+// pipeline that can describe its own structure.
+//
+// Deeply nested FlatMap chains are difficult to read once composed, as the
+// closures that make up each stage carry no information about what they do.
+// A [Pipeline] retains the name of every stage and the way in which stages
+// were combined, so that [Pipeline.String] can print the composition as a
+// tree and [Pipeline.DOT] can render it as a Graphviz graph.
+package pipeline
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tomasbasham/gofp"
+)
+
+type kind int
+
+const (
+	stageKind kind = iota
+	thenKind
+	zipKind
+	groupKind
+)
+
+type node struct {
+	name     string
+	kind     kind
+	children []*node
+}
+
+func (n *node) label() string {
+	switch n.kind {
+	case thenKind:
+		return "then"
+	case zipKind:
+		return n.name + " (zip)"
+	default:
+		return n.name
+	}
+}
+
+// Pipeline is a computation from A to B composed of named stages, any of which
+// may fail.
+//
+// Type parameter A represents the input type.
+// Type parameter B represents the output type.
+type Pipeline[A, B any] struct {
+	f    func(A) gofp.Result[B]
+	node *node
+}
+
+// Describe creates a [Pipeline] consisting of a single stage with the given
+// name.
+func Describe[A, B any](name string, f func(A) gofp.Result[B]) Pipeline[A, B] {
+	return Pipeline[A, B]{
+		f:    f,
+		node: &node{name: name, kind: stageKind},
+	}
+}
+
+// Map creates a [Pipeline] that applies a named transformation, which cannot
+// fail, to the output of p.
+func Map[A, B, C any](p Pipeline[A, B], name string, f func(B) C) Pipeline[A, C] {
+	return Then(p, Describe(name, func(b B) gofp.Result[C] {
+		return gofp.Ok(f(b))
+	}))
+}
+
+// Then composes two pipelines so that the output of p becomes the input of q.
+// The second pipeline is only run if the first succeeds.
+func Then[A, B, C any](p Pipeline[A, B], q Pipeline[B, C]) Pipeline[A, C] {
+	return Pipeline[A, C]{
+		f: func(a A) gofp.Result[C] {
+			return gofp.ResultFlatMap(p.f(a), q.f)
+		},
+		node: &node{kind: thenKind, children: slices.Concat(steps(p.node), steps(q.node))},
+	}
+}
+
+// Zip creates a named [Pipeline] that runs both p and q with the same input and
+// combines their outputs using f. If either pipeline fails, the first error
+// encountered is returned.
+func Zip[A, B, C, U any](name string, p Pipeline[A, B], q Pipeline[A, C], f func(B, C) U) Pipeline[A, U] {
+	return Pipeline[A, U]{
+		f: func(a A) gofp.Result[U] {
+			return gofp.ResultFlatMap(p.f(a), func(b B) gofp.Result[U] {
+				return gofp.ResultMap(q.f(a), func(c C) U {
+					return f(b, c)
+				})
+			})
+		},
+		node: &node{name: name, kind: zipKind, children: []*node{p.node, q.node}},
+	}
+}
+
+// Group names a [Pipeline] so that its stages are shown beneath a single node
+// when described.
+func Group[A, B any](name string, p Pipeline[A, B]) Pipeline[A, B] {
+	return Pipeline[A, B]{
+		f:    p.f,
+		node: &node{name: name, kind: groupKind, children: steps(p.node)},
+	}
+}
+
+// steps returns the nodes that make up a sequence, flattening nested calls to
+// [Then] so that a chain of stages is described as a single list.
+func steps(n *node) []*node {
+	if n.kind == thenKind {
+		return n.children
+	}
+	return []*node{n}
+}
+
+// Run executes the [Pipeline] with the given input.
+func (p Pipeline[A, B]) Run(a A) gofp.Result[B] {
+	return p.f(a)
+}
+
+// String returns the structure of the [Pipeline] as a tree, with one stage per
+// line.
+func (p Pipeline[A, B]) String() string {
+	var b strings.Builder
+	b.WriteString(p.node.label())
+	b.WriteByte('\n')
+	writeTree(&b, p.node.children, "")
+	return b.String()
+}
+
+func writeTree(b *strings.Builder, nodes []*node, prefix string) {
+	for i, n := range nodes {
+		branch, indent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, indent = "└── ", "    "
+		}
+		b.WriteString(prefix + branch + n.label() + "\n")
+		writeTree(b, n.children, prefix+indent)
+	}
+}
+
+// DOT returns the structure of the [Pipeline] as a Graphviz digraph. Stages
+// within a sequence are linked in the order that they run, and each composite
+// node is linked to its children.
+func (p Pipeline[A, B]) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph pipeline {\n")
+	b.WriteString("\tnode [shape=box];\n")
+
+	id := 0
+	var walk func(n *node) int
+	walk = func(n *node) int {
+		self := id
+		id++
+		fmt.Fprintf(&b, "\tn%d [label=%q];\n", self, n.label())
+
+		prev := -1
+		for _, c := range n.children {
+			child := walk(c)
+			fmt.Fprintf(&b, "\tn%d -> n%d [style=dashed];\n", self, child)
+			if n.kind != zipKind && prev >= 0 {
+				fmt.Fprintf(&b, "\tn%d -> n%d;\n", prev, child)
+			}
+			prev = child
+		}
+		return self
+	}
+	walk(p.node)
+
+	b.WriteString("}\n")
+	return b.String()
+}
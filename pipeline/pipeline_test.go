@@ -0,0 +1,106 @@
+package pipeline_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/pipeline"
+)
+
+var (
+	parse = pipeline.Describe("parse", func(s string) gofp.Result[int] {
+		return gofp.FromReturn(strconv.Atoi(s))
+	})
+	validate = pipeline.Describe("validate", func(n int) gofp.Result[int] {
+		if n < 0 {
+			return gofp.Err[int](errors.New("negative"))
+		}
+		return gofp.Ok(n)
+	})
+)
+
+func TestRun(t *testing.T) {
+	p := pipeline.Map(pipeline.Then(parse, validate), "double", func(n int) int {
+		return n * 2
+	})
+
+	t.Run("returns the output of the final stage", func(t *testing.T) {
+		if got := p.Run("21"); got.Unwrap() != 42 {
+			t.Errorf("expected 42, got %v", got)
+		}
+	})
+
+	t.Run("stops at the first failing stage", func(t *testing.T) {
+		if got := p.Run("-1"); got.IsOk() || got.UnwrapErr().Error() != "negative" {
+			t.Errorf("expected error 'negative', got %v", got)
+		}
+	})
+}
+
+func TestZip(t *testing.T) {
+	p := pipeline.Zip("both", parse, pipeline.Describe("length", func(s string) gofp.Result[int] {
+		return gofp.Ok(len(s))
+	}), func(n, l int) int {
+		return n + l
+	})
+
+	if got := p.Run("40"); got.Unwrap() != 42 {
+		t.Errorf("expected 42, got %v", got)
+	}
+	if got := p.Run("x"); got.IsOk() {
+		t.Errorf("expected error, got %v", got)
+	}
+}
+
+func TestString(t *testing.T) {
+	t.Run("single stage", func(t *testing.T) {
+		if got := parse.String(); got != "parse\n" {
+			t.Errorf("expected 'parse', got %q", got)
+		}
+	})
+
+	t.Run("flattens sequences", func(t *testing.T) {
+		p := pipeline.Then(pipeline.Then(parse, validate), validate)
+		want := "then\n├── parse\n├── validate\n└── validate\n"
+		if got := p.String(); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("nests groups", func(t *testing.T) {
+		p := pipeline.Then(pipeline.Group("input", pipeline.Then(parse, validate)), validate)
+		want := "then\n├── input\n│   ├── parse\n│   └── validate\n└── validate\n"
+		if got := p.String(); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("does not share stages between pipelines", func(t *testing.T) {
+		base := pipeline.Then(parse, validate)
+		a := pipeline.Map(base, "a", func(n int) int { return n })
+		pipeline.Map(base, "b", func(n int) int { return n })
+		if got := a.String(); !strings.HasSuffix(got, "└── a\n") {
+			t.Errorf("expected final stage 'a', got %q", got)
+		}
+	})
+}
+
+func TestDOT(t *testing.T) {
+	p := pipeline.Then(parse, validate)
+	want := `digraph pipeline {
+	node [shape=box];
+	n0 [label="then"];
+	n1 [label="parse"];
+	n0 -> n1 [style=dashed];
+	n2 [label="validate"];
+	n0 -> n2 [style=dashed];
+	n1 -> n2;
+}
+`
+	if got := p.DOT(); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
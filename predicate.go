@@ -0,0 +1,92 @@
+package gofp
+
+import "cmp"
+
+// Predicate is a function that reports whether a value satisfies some
+// condition. Because it is a function type, a Predicate may be passed anywhere
+// a func(T) bool is expected, such as [Option.Filter] or [Result.Ensure].
+//
+// Type parameter T represents the value type.
+type Predicate[T any] func(T) bool
+
+// Test returns p(v).
+func (p Predicate[T]) Test(v T) bool {
+	return p(v)
+}
+
+// And returns a [Predicate] that is satisfied only if both p and q are. The
+// second predicate is not evaluated if the first is not satisfied.
+func (p Predicate[T]) And(q Predicate[T]) Predicate[T] {
+	return func(v T) bool {
+		return p(v) && q(v)
+	}
+}
+
+// Or returns a [Predicate] that is satisfied if either p or q is. The second
+// predicate is not evaluated if the first is satisfied.
+func (p Predicate[T]) Or(q Predicate[T]) Predicate[T] {
+	return func(v T) bool {
+		return p(v) || q(v)
+	}
+}
+
+// Not returns a [Predicate] that is satisfied only if p is not.
+func (p Predicate[T]) Not() Predicate[T] {
+	return func(v T) bool {
+		return !p(v)
+	}
+}
+
+// PredicateContramap adapts a [Predicate] on T into a [Predicate] on U by
+// applying f to each value before it is tested.
+func PredicateContramap[T, U any](p Predicate[T], f func(U) T) Predicate[U] {
+	return func(u U) bool {
+		return p(f(u))
+	}
+}
+
+// Comparator is a function that compares two values, returning a negative
+// number if a is less than b, zero if they are equal and a positive number if a
+// is greater than b. It has the same shape as [cmp.Compare] and so may be
+// passed to functions such as [slices.SortFunc].
+//
+// Type parameter T represents the value type.
+type Comparator[T any] func(a, b T) int
+
+// Natural returns a [Comparator] that orders values using [cmp.Compare].
+func Natural[T cmp.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
+
+// Compare returns c(a, b).
+func (c Comparator[T]) Compare(a, b T) int {
+	return c(a, b)
+}
+
+// Reversed returns a [Comparator] that orders values in the opposite order to
+// c.
+func (c Comparator[T]) Reversed() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// ThenBy returns a [Comparator] that orders values by c, using next to break
+// ties between values that c considers equal.
+func (c Comparator[T]) ThenBy(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return next(a, b)
+	}
+}
+
+// ComparatorContramap adapts a [Comparator] on T into a [Comparator] on U by
+// applying f to each value before they are compared. It is useful for ordering
+// values by one of their fields.
+func ComparatorContramap[T, U any](c Comparator[T], f func(U) T) Comparator[U] {
+	return func(a, b U) int {
+		return c(f(a), f(b))
+	}
+}
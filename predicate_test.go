@@ -0,0 +1,83 @@
+package gofp_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestPredicate(t *testing.T) {
+	positive := gofp.Predicate[int](func(n int) bool { return n > 0 })
+	even := gofp.Predicate[int](func(n int) bool { return n%2 == 0 })
+
+	tests := []struct {
+		name string
+		p    gofp.Predicate[int]
+		in   int
+		want bool
+	}{
+		{"and satisfied", positive.And(even), 2, true},
+		{"and unsatisfied", positive.And(even), 3, false},
+		{"or satisfied", positive.Or(even), -2, true},
+		{"or unsatisfied", positive.Or(even), -3, false},
+		{"not", positive.Not(), -1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Test(tt.in); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("contramap", func(t *testing.T) {
+		long := gofp.PredicateContramap(positive, func(s string) int { return len(s) - 3 })
+		if !long("abcd") || long("abc") {
+			t.Error("expected only strings longer than 3 to satisfy the predicate")
+		}
+	})
+
+	t.Run("usable as a filter", func(t *testing.T) {
+		if got := gofp.Some(3).Filter(even); got.IsSome() {
+			t.Errorf("expected None, got %v", got)
+		}
+	})
+}
+
+func TestComparator(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+
+	byAge := gofp.ComparatorContramap(gofp.Natural[int](), func(p person) int { return p.age })
+	byName := gofp.ComparatorContramap(gofp.Natural[string](), func(p person) string { return p.name })
+
+	people := []person{{"carol", 30}, {"alice", 25}, {"bob", 30}}
+
+	t.Run("then by", func(t *testing.T) {
+		got := slices.Clone(people)
+		slices.SortFunc(got, byAge.ThenBy(byName))
+		want := []person{{"alice", 25}, {"bob", 30}, {"carol", 30}}
+		if !slices.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("reversed", func(t *testing.T) {
+		got := slices.Clone(people)
+		slices.SortFunc(got, byAge.Reversed().ThenBy(byName))
+		want := []person{{"bob", 30}, {"carol", 30}, {"alice", 25}}
+		if !slices.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("compare", func(t *testing.T) {
+		if got := byName.Compare(people[0], people[1]); got <= 0 {
+			t.Errorf("expected positive result, got %d", got)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+// Package progress reports the progress of long-running pipelines.
+//
+// Progress updates are values that may be accumulated by a writer computation
+// using [Monoid], so that a pipeline remains a pure description of its work,
+// and replayed to a [Reporter] such as a [Terminal] once it has run. Steps
+// created with [StepTo] are also sent to a [Reporter] as the pipeline runs, for
+// pipelines that take long enough for live progress to matter.
+package progress
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/tomasbasham/gofp/writer"
+)
+
+// Update records that a named step of a pipeline has reached a percentage of
+// completion.
+type Update struct {
+	Name    string
+	Percent float64
+}
+
+// Reporter receives progress updates.
+type Reporter interface {
+	// Step reports that the named step has reached the given percentage of
+	// completion, between 0 and 100.
+	Step(name string, pct float64)
+}
+
+// ReporterFunc is an adapter that allows the use of an ordinary function as a
+// [Reporter].
+type ReporterFunc func(name string, pct float64)
+
+// Step calls f(name, pct).
+func (f ReporterFunc) Step(name string, pct float64) {
+	f(name, pct)
+}
+
+// Monoid is a [writer.Monoid] for slices of [Update] values.
+type Monoid struct{}
+
+// Empty returns an empty slice of updates.
+func (Monoid) Empty() []Update {
+	return []Update{}
+}
+
+// Append concatenates two slices of updates.
+func (Monoid) Append(a, b []Update) []Update {
+	updates := make([]Update, 0, len(a)+len(b))
+	updates = append(updates, a...)
+	return append(updates, b...)
+}
+
+// Step creates a [writer.Writer] computation that records a single [Update].
+// The result will be the zero value for type A.
+func Step[A any](name string, pct float64) writer.Writer[[]Update, A] {
+	return writer.Tell[[]Update, A]([]Update{{Name: name, Percent: pct}}, Monoid{})
+}
+
+// StepTo is like [Step] but also sends the update to r each time the
+// computation is run.
+func StepTo[A any](r Reporter, name string, pct float64) writer.Writer[[]Update, A] {
+	return writer.New(func() (A, []Update) {
+		var zero A
+		r.Step(name, pct)
+		return zero, []Update{{Name: name, Percent: pct}}
+	}, Monoid{})
+}
+
+// Replay sends every update to r, in order.
+func Replay(updates []Update, r Reporter) {
+	for _, u := range updates {
+		r.Step(u.Name, u.Percent)
+	}
+}
+
+// Terminal is a [Reporter] that renders a progress bar, redrawing it in place
+// on each update. A newline is written once a step reaches 100 percent, so each
+// completed step is left on its own line. It is safe for concurrent use.
+type Terminal struct {
+	mu    sync.Mutex
+	w     io.Writer
+	width int
+	last  int
+}
+
+// NewTerminal returns a [Terminal] that writes to w, drawing bars with the
+// given width in characters.
+func NewTerminal(w io.Writer, width int) *Terminal {
+	return &Terminal{w: w, width: width}
+}
+
+// Step redraws the progress bar for the named step.
+func (t *Terminal) Step(name string, pct float64) {
+	pct = min(max(pct, 0), 100)
+	filled := int(pct / 100 * float64(t.width))
+	line := fmt.Sprintf("[%s%s] %3.0f%% %s",
+		strings.Repeat("#", filled),
+		strings.Repeat(" ", t.width-filled),
+		pct,
+		name,
+	)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Pad the line to overwrite any longer line drawn before it.
+	padding := max(t.last-len(line), 0)
+	fmt.Fprintf(t.w, "\r%s%s", line, strings.Repeat(" ", padding))
+	t.last = len(line)
+	if pct == 100 {
+		fmt.Fprintln(t.w)
+		t.last = 0
+	}
+}
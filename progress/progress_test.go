@@ -0,0 +1,79 @@
+package progress_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/gofp/progress"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+type recorder struct {
+	updates []progress.Update
+}
+
+func (r *recorder) Step(name string, pct float64) {
+	r.updates = append(r.updates, progress.Update{Name: name, Percent: pct})
+}
+
+func TestStep(t *testing.T) {
+	w := writer.FlatMap(progress.Step[int]("compile", 50), func(int) writer.Writer[[]progress.Update, string] {
+		return writer.Map(progress.Step[int]("compile", 100), func(int) string { return "main.o" })
+	})
+
+	value, updates := w.Run()
+	if value != "main.o" {
+		t.Errorf("expected main.o, got %v", value)
+	}
+	want := []progress.Update{{"compile", 50}, {"compile", 100}}
+	if !slices.Equal(updates, want) {
+		t.Errorf("expected %v, got %v", want, updates)
+	}
+
+	r := &recorder{}
+	progress.Replay(updates, r)
+	if !slices.Equal(r.updates, want) {
+		t.Errorf("expected replayed %v, got %v", want, r.updates)
+	}
+}
+
+func TestStepTo(t *testing.T) {
+	r := &recorder{}
+	w := progress.StepTo[int](r, "test", 25)
+	if len(r.updates) != 0 {
+		t.Error("expected nothing to be reported before the computation runs")
+	}
+
+	_, updates := w.Run()
+	if len(r.updates) != 1 || r.updates[0] != (progress.Update{Name: "test", Percent: 25}) {
+		t.Errorf("expected a single live update, got %v", r.updates)
+	}
+	if !slices.Equal(updates, r.updates) {
+		t.Errorf("expected recorded updates to match, got %v", updates)
+	}
+}
+
+func TestTerminal(t *testing.T) {
+	var b strings.Builder
+	term := progress.NewTerminal(&b, 10)
+
+	term.Step("compiling main.go", 50)
+	term.Step("compiling", 100)
+	term.Step("test", 150)
+
+	want := "\r[#####     ]  50% compiling main.go" +
+		"\r[##########] 100% compiling        \n" +
+		"\r[##########] 100% test\n"
+	if got := b.String(); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestReporterFunc(t *testing.T) {
+	var got string
+	progress.ReporterFunc(func(name string, _ float64) { got = name }).Step("docs", 0)
+	if got != "docs" {
+		t.Errorf("expected docs, got %v", got)
+	}
+}
@@ -0,0 +1,18 @@
+package gofp
+
+// PtrOf returns a pointer to a copy of v. It allows optional pointer fields of
+// struct literals, such as those of API payloads, to be set from constants and
+// function results, which cannot have their address taken. It is equivalent to
+// Some(v).ToPtr().
+func PtrOf[T any](v T) *T {
+	return &v
+}
+
+// FromPtrOr returns the value pointed to by p, or defaultValue if p is nil. It
+// is equivalent to OptionFromPtr(p).UnwrapOr(defaultValue).
+func FromPtrOr[T any](p *T, defaultValue T) T {
+	if p == nil {
+		return defaultValue
+	}
+	return *p
+}
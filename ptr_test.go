@@ -0,0 +1,42 @@
+package gofp_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestPtrOf(t *testing.T) {
+	v := 1
+	p := gofp.PtrOf(v)
+	if p == nil || *p != 1 {
+		t.Fatalf("expected pointer to 1, got %v", p)
+	}
+	if p == &v {
+		t.Error("expected pointer to a copy")
+	}
+	if gofp.PtrOf(1) == gofp.PtrOf(1) {
+		t.Error("expected distinct pointers")
+	}
+}
+
+func TestFromPtrOr(t *testing.T) {
+	if got := gofp.FromPtrOr(gofp.PtrOf(1), 2); got != 1 {
+		t.Errorf("expected 1, got %v", got)
+	}
+	if got := gofp.FromPtrOr(nil, 2); got != 2 {
+		t.Errorf("expected 2, got %v", got)
+	}
+}
+
+func TestPtr_OptionRoundTrip(t *testing.T) {
+	for _, o := range []gofp.Option[string]{gofp.Some("a"), gofp.None[string]()} {
+		got := gofp.OptionFromPtr(o.ToPtr())
+		if got != o {
+			t.Errorf("expected %v, got %v", o, got)
+		}
+		if want := o.UnwrapOr("default"); gofp.FromPtrOr(o.ToPtr(), "default") != want {
+			t.Errorf("expected FromPtrOr to agree with UnwrapOr for %v", o)
+		}
+	}
+}
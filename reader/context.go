@@ -0,0 +1,75 @@
+package reader
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// Context is implemented by environments that carry a [context.Context].
+//
+// Type parameter E represents the environment type itself, so that
+// WithContext may return a modified copy of the environment.
+type Context[E any] interface {
+	// Context returns the context carried by the environment.
+	Context() context.Context
+
+	// WithContext returns a copy of the environment carrying the given context.
+	WithContext(context.Context) E
+}
+
+// ParZipCtx combines two [TryReader] computations into one using a combining
+// function. Like [ParZip], both computations are run concurrently, but each is
+// given an environment carrying a child of its context, which is canceled with
+// the error of whichever computation first produces an Err so that the other
+// may stop early. The result is that first Err, or otherwise Ok holding the
+// combined values. A panic in either computation is raised again on the
+// goroutine running the combination.
+func ParZipCtx[E Context[E], A, B, U any](ra TryReader[E, A], rb TryReader[E, B], f func(A, B) U) TryReader[E, U] {
+	return New(func(e E) gofp.Result[U] {
+		ctx, cancel := context.WithCancelCause(e.Context())
+		defer cancel(nil)
+		e = e.WithContext(ctx)
+
+		var (
+			a        gofp.Result[A]
+			b        gofp.Result[B]
+			bFirst   bool
+			once     sync.Once
+			panicked any
+			wg       sync.WaitGroup
+		)
+		fail := func(err error, isB bool) {
+			once.Do(func() {
+				bFirst = isB
+				cancel(err)
+			})
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer capture(&panicked)
+			if b = rb.g(e); b.IsErr() {
+				fail(b.UnwrapErr(), true)
+			}
+		}()
+		if a = ra.g(e); a.IsErr() {
+			fail(a.UnwrapErr(), false)
+		}
+		wg.Wait()
+		if panicked != nil {
+			panic(panicked)
+		}
+
+		if bFirst {
+			return gofp.ResultFlatMap(b, func(b B) gofp.Result[U] {
+				return gofp.ResultMap(a, func(a A) U { return f(a, b) })
+			})
+		}
+		return gofp.ResultFlatMap(a, func(a A) gofp.Result[U] {
+			return gofp.ResultMap(b, func(b B) U { return f(a, b) })
+		})
+	})
+}
@@ -142,3 +142,15 @@ func ExampleZip() {
 	// Output:
 	// 8
 }
+
+func ExampleParZip() {
+	env := Environment{Name: "Alice", Value: 42}
+	name := reader.New(func(e Environment) string { return e.Name })
+	value := reader.New(func(e Environment) int { return e.Value })
+	combined := reader.ParZip(name, value, func(n string, v int) string {
+		return fmt.Sprintf("%s: %d", n, v)
+	})
+	fmt.Println(combined.Run(env))
+	// Output:
+	// Alice: 42
+}
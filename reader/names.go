@@ -0,0 +1,18 @@
+package reader
+
+// Return lifts a value into a [Reader] computation. It is equivalent to
+// [Pure].
+func Return[E, A any](a A) Reader[E, A] {
+	return Pure[E](a)
+}
+
+// Bind is equivalent to [FlatMap].
+func Bind[E, A, B any](r Reader[E, A], f func(A) Reader[E, B]) Reader[E, B] {
+	return FlatMap(r, f)
+}
+
+// Join flattens a [Reader] computation whose value is itself a [Reader]
+// computation, running both with the same environment.
+func Join[E, A any](r Reader[E, Reader[E, A]]) Reader[E, A] {
+	return FlatMap(r, func(inner Reader[E, A]) Reader[E, A] { return inner })
+}
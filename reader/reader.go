@@ -7,6 +7,12 @@
 // each function.
 package reader
 
+import (
+	"sync"
+
+	"github.com/tomasbasham/gofp"
+)
+
 // Reader is a monad that models computations which read values from a shared
 // environment. It is also known as the environment monad.
 //
@@ -101,3 +107,154 @@ func Zip[E, A, B, U any](ra Reader[E, A], rb Reader[E, B], f func(A, B) U) Reade
 		})
 	})
 }
+
+// ParZip combines two [Reader] computations into one using a combining
+// function. Unlike [Zip], both computations are run concurrently with the same
+// environment, which must therefore be safe for concurrent use. See
+// [ParZipCtx] to stop one computation early when the other fails. A panic in
+// either computation is raised again on the goroutine running the combination.
+func ParZip[E, A, B, U any](ra Reader[E, A], rb Reader[E, B], f func(A, B) U) Reader[E, U] {
+	return Reader[E, U]{
+		func(e E) U {
+			var (
+				b        B
+				panicked any
+				wg       sync.WaitGroup
+			)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer capture(&panicked)
+				b = rb.g(e)
+			}()
+			a := ra.g(e)
+			wg.Wait()
+			if panicked != nil {
+				panic(panicked)
+			}
+			return f(a, b)
+		},
+	}
+}
+
+// ParSequence transforms a slice of [Reader] computations into a single
+// [Reader] computation that returns a slice of values. All computations are run
+// concurrently with the same environment, which must therefore be safe for
+// concurrent use. The order of the values matches the order of the slice. A
+// panic in any computation is raised again on the goroutine running the
+// sequence, once every computation has completed. If several panic, the first
+// in slice order is raised.
+func ParSequence[E, A any](readers []Reader[E, A]) Reader[E, []A] {
+	return Reader[E, []A]{
+		func(e E) []A {
+			values := make([]A, len(readers))
+			panics := make([]any, len(readers))
+
+			var wg sync.WaitGroup
+			wg.Add(len(readers))
+			for i, r := range readers {
+				go func(i int, r Reader[E, A]) {
+					defer wg.Done()
+					defer capture(&panics[i])
+					values[i] = r.g(e)
+				}(i, r)
+			}
+			wg.Wait()
+			for _, p := range panics {
+				if p != nil {
+					panic(p)
+				}
+			}
+
+			return values
+		},
+	}
+}
+
+// capture recovers a panic on the current goroutine, storing its value in p so
+// that it may be raised again on another. It must be called directly by defer.
+func capture(p *any) {
+	*p = recover()
+}
+
+// TryReader is a [Reader] computation whose value is a [gofp.Result]. The Try
+// combinators short-circuit on Err, so only the Ok path needs to be handled
+// when composing computations that may fail.
+//
+// Type parameter E represents the environment type.
+// Type parameter A represents the value type.
+type TryReader[E, A any] = Reader[E, gofp.Result[A]]
+
+// TryFromFunc creates a [TryReader] from a function following Go's typical
+// (value, error) return pattern.
+func TryFromFunc[E, A any](f func(E) (A, error)) TryReader[E, A] {
+	return New(func(e E) gofp.Result[A] {
+		return gofp.FromReturn(f(e))
+	})
+}
+
+// TryLift lifts a [Reader] computation into a [TryReader] whose value is
+// always Ok.
+func TryLift[E, A any](r Reader[E, A]) TryReader[E, A] {
+	return Map(r, gofp.Ok[A])
+}
+
+// TryMap applies a function to transform the Ok value of a [TryReader], or
+// otherwise preserves the Err.
+func TryMap[E, A, B any](r TryReader[E, A], f func(A) B) TryReader[E, B] {
+	return Map(r, func(ra gofp.Result[A]) gofp.Result[B] {
+		return gofp.ResultMap(ra, f)
+	})
+}
+
+// TryFlatMap composes two [TryReader] computations by using the Ok value of the
+// first to create the second. If the first computation produces an Err, the
+// second is never run and the Err is preserved.
+func TryFlatMap[E, A, B any](r TryReader[E, A], f func(A) TryReader[E, B]) TryReader[E, B] {
+	return New(func(e E) gofp.Result[B] {
+		return gofp.ResultFlatMap(r.g(e), func(a A) gofp.Result[B] {
+			return f(a).g(e)
+		})
+	})
+}
+
+// OptionReader is a [Reader] computation whose value is a [gofp.Option]. The
+// Option combinators short-circuit on None.
+//
+// Type parameter E represents the environment type.
+// Type parameter A represents the value type.
+type OptionReader[E, A any] = Reader[E, gofp.Option[A]]
+
+// OptionMap applies a function to transform the Some value of an
+// [OptionReader], or otherwise preserves None.
+func OptionMap[E, A, B any](r OptionReader[E, A], f func(A) B) OptionReader[E, B] {
+	return Map(r, func(oa gofp.Option[A]) gofp.Option[B] {
+		return gofp.OptionMap(oa, f)
+	})
+}
+
+// OptionFlatMap composes two [OptionReader] computations by using the Some
+// value of the first to create the second. If the first computation produces
+// None, the second is never run.
+func OptionFlatMap[E, A, B any](r OptionReader[E, A], f func(A) OptionReader[E, B]) OptionReader[E, B] {
+	return New(func(e E) gofp.Option[B] {
+		return gofp.OptionFlatMap(r.g(e), func(a A) gofp.Option[B] {
+			return f(a).g(e)
+		})
+	})
+}
+
+// Instrument wraps a [Reader] computation so that each time it is run the given
+// hook is called with a [gofp.StageInfo] describing the wall time and heap
+// allocations of the computation.
+func Instrument[E, A any](r Reader[E, A], hook func(gofp.StageInfo)) Reader[E, A] {
+	return Reader[E, A]{
+		g: func(env E) A {
+			var a A
+			hook(gofp.Measure(func() {
+				a = r.g(env)
+			}))
+			return a
+		},
+	}
+}
@@ -1,9 +1,12 @@
 package reader_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/tomasbasham/gofp"
 	"github.com/tomasbasham/gofp/reader"
 )
 
@@ -218,6 +221,22 @@ func TestReader_FlatMap(t *testing.T) {
 	})
 }
 
+func TestInstrument(t *testing.T) {
+	env := Environment{Debug: true, Name: "test", Value: 42}
+
+	var calls int
+	r := reader.Instrument(reader.New(func(e Environment) int { return e.Value }), func(gofp.StageInfo) {
+		calls++
+	})
+
+	if got := r.Run(env); got != 42 {
+		t.Errorf("expected 42, got %v", got)
+	}
+	if calls != 1 {
+		t.Errorf("expected hook to be called once, got %d", calls)
+	}
+}
+
 func TestZip(t *testing.T) {
 	t.Run("combines two readers", func(t *testing.T) {
 		env := Environment{Debug: true, Name: "test", Value: 42}
@@ -264,6 +283,218 @@ func TestZip(t *testing.T) {
 	})
 }
 
+func TestParZip(t *testing.T) {
+	t.Run("combines with environment", func(t *testing.T) {
+		env := Environment{Debug: true, Name: "test", Value: 42}
+		r1 := reader.Pure[Environment](5)
+		r2 := reader.New(func(e Environment) int {
+			return e.Value
+		})
+
+		sum := reader.ParZip(r1, r2, func(a, b int) int {
+			return a + b
+		})
+
+		if result := sum.Run(env); result != 47 {
+			t.Errorf("expected 47, got %v", result)
+		}
+	})
+
+	t.Run("raises a panic on the caller", func(t *testing.T) {
+		boom := reader.New(func(Environment) int {
+			panic("boom")
+		})
+
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Errorf("expected the panic to propagate, got %v", p)
+			}
+		}()
+		reader.ParZip(reader.Pure[Environment](5), boom, func(a, b int) int {
+			return a + b
+		}).Run(Environment{})
+		t.Error("expected ParZip to panic")
+	})
+}
+
+type ctxEnv struct {
+	ctx context.Context
+}
+
+func (e ctxEnv) Context() context.Context {
+	return e.ctx
+}
+
+func (e ctxEnv) WithContext(ctx context.Context) ctxEnv {
+	e.ctx = ctx
+	return e
+}
+
+func TestParZipCtx(t *testing.T) {
+	env := ctxEnv{ctx: context.Background()}
+	add := func(a, b int) int { return a + b }
+
+	t.Run("combines Ok values", func(t *testing.T) {
+		sum := reader.ParZipCtx(reader.TryLift(reader.Pure[ctxEnv](2)), reader.TryLift(reader.Pure[ctxEnv](3)), add)
+		if got := sum.Run(env); got.Unwrap() != 5 {
+			t.Errorf("expected Ok(5), got %v", got)
+		}
+	})
+
+	t.Run("cancels the other computation on Err", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		fails := reader.New(func(ctxEnv) gofp.Result[int] {
+			return gofp.Err[int](errBoom)
+		})
+		waits := reader.New(func(e ctxEnv) gofp.Result[int] {
+			<-e.Context().Done()
+			if cause := context.Cause(e.Context()); cause != errBoom {
+				t.Errorf("expected the context to be canceled with %v, got %v", errBoom, cause)
+			}
+			return gofp.Err[int](gofp.ContextError(e.Context()))
+		})
+
+		for name, r := range map[string]reader.TryReader[ctxEnv, int]{
+			"first":  reader.ParZipCtx(fails, waits, add),
+			"second": reader.ParZipCtx(waits, fails, add),
+		} {
+			t.Run(name, func(t *testing.T) {
+				if err := r.Run(env).UnwrapErr(); err != errBoom {
+					t.Errorf("expected %v, got %v", errBoom, err)
+				}
+			})
+		}
+	})
+
+	t.Run("raises a panic on the caller", func(t *testing.T) {
+		boom := reader.New(func(ctxEnv) gofp.Result[int] {
+			panic("boom")
+		})
+
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Errorf("expected the panic to propagate, got %v", p)
+			}
+		}()
+		reader.ParZipCtx(reader.TryLift(reader.Pure[ctxEnv](2)), boom, add).Run(env)
+		t.Error("expected ParZipCtx to panic")
+	})
+}
+
+func TestParSequence(t *testing.T) {
+	env := Environment{Debug: true, Name: "test", Value: 42}
+	readers := make([]reader.Reader[Environment, int], 10)
+	for i := range readers {
+		i := i
+		readers[i] = reader.New(func(e Environment) int {
+			return e.Value + i
+		})
+	}
+
+	result := reader.ParSequence(readers).Run(env)
+	for i, v := range result {
+		if v != 42+i {
+			t.Errorf("expected %d at index %d, got %v", 42+i, i, v)
+		}
+	}
+	if len(result) != len(readers) {
+		t.Errorf("expected %d values, got %d", len(readers), len(result))
+	}
+
+	t.Run("raises the first panic in slice order", func(t *testing.T) {
+		readers := make([]reader.Reader[Environment, int], 10)
+		for i := range readers {
+			readers[i] = reader.New(func(Environment) int {
+				if i%3 == 2 {
+					panic(i)
+				}
+				return i
+			})
+		}
+
+		defer func() {
+			if p := recover(); p != 2 {
+				t.Errorf("expected a panic of 2, got %v", p)
+			}
+		}()
+		reader.ParSequence(readers).Run(env)
+		t.Error("expected ParSequence to panic")
+	})
+}
+
+func TestTryFromFunc(t *testing.T) {
+	env := Environment{Name: "test", Value: -1}
+	errNegative := errors.New("negative")
+	r := reader.TryFromFunc(func(e Environment) (int, error) {
+		if e.Value < 0 {
+			return 0, errNegative
+		}
+		return e.Value, nil
+	})
+
+	if result := r.Run(env); result.UnwrapErr() != errNegative {
+		t.Errorf("expected %v, got %v", errNegative, result)
+	}
+
+	env.Value = 42
+	if result := r.Run(env); result.Unwrap() != 42 {
+		t.Errorf("expected Ok(42), got %v", result)
+	}
+}
+
+func TestTryMap(t *testing.T) {
+	env := Environment{Value: 42}
+	r := reader.TryLift(reader.New(func(e Environment) int { return e.Value }))
+	result := reader.TryMap(r, func(x int) string { return fmt.Sprint(x) }).Run(env)
+	if result.Unwrap() != "42" {
+		t.Errorf("expected Ok(42), got %v", result)
+	}
+}
+
+func TestTryFlatMap(t *testing.T) {
+	env := Environment{Value: 42}
+
+	t.Run("chains Ok values", func(t *testing.T) {
+		r := reader.TryFlatMap(reader.Pure[Environment](gofp.Ok(1)), func(x int) reader.TryReader[Environment, int] {
+			return reader.New(func(e Environment) gofp.Result[int] {
+				return gofp.Ok(x + e.Value)
+			})
+		})
+		if result := r.Run(env); result.Unwrap() != 43 {
+			t.Errorf("expected Ok(43), got %v", result)
+		}
+	})
+
+	t.Run("short-circuits on Err", func(t *testing.T) {
+		errFailed := errors.New("failed")
+		r := reader.TryFlatMap(reader.Pure[Environment](gofp.Err[int](errFailed)), func(x int) reader.TryReader[Environment, int] {
+			t.Error("expected function not to be called")
+			return reader.Pure[Environment](gofp.Ok(x))
+		})
+		if result := r.Run(env); result.UnwrapErr() != errFailed {
+			t.Errorf("expected %v, got %v", errFailed, result)
+		}
+	})
+}
+
+func TestOptionFlatMap(t *testing.T) {
+	env := Environment{Name: "Alice"}
+	name := reader.New(func(e Environment) gofp.Option[string] {
+		return gofp.GuardOption(e.Name != "", e.Name)
+	})
+	greeting := reader.OptionFlatMap(name, func(n string) reader.OptionReader[Environment, string] {
+		return reader.Pure[Environment](gofp.Some("Hello, " + n))
+	})
+	if result := greeting.Run(env); result.Unwrap() != "Hello, Alice" {
+		t.Errorf("expected Some(Hello, Alice), got %v", result)
+	}
+
+	length := reader.OptionMap(name, func(n string) int { return len(n) })
+	if result := length.Run(Environment{}); !result.IsNone() {
+		t.Errorf("expected None, got %v", result)
+	}
+}
+
 func TestComposition(t *testing.T) {
 	env := Environment{Debug: true, Name: "Alice", Value: 42}
 
@@ -281,3 +512,102 @@ func TestComposition(t *testing.T) {
 		t.Errorf("expected 'Hello, Alice', got %v", result)
 	}
 }
+
+func TestBindReturnJoin(t *testing.T) {
+	env := Environment{Name: "test", Value: 2}
+
+	bound := reader.Bind(reader.Return[Environment](3), func(x int) reader.Reader[Environment, int] {
+		return reader.New(func(e Environment) int { return x * e.Value })
+	})
+	if got := bound.Run(env); got != 6 {
+		t.Errorf("expected 6, got %v", got)
+	}
+
+	nested := reader.New(func(e Environment) reader.Reader[Environment, string] {
+		return reader.New(func(e2 Environment) string { return e.Name + e2.Name })
+	})
+	if got := reader.Join(nested).Run(env); got != "testtest" {
+		t.Errorf("expected testtest, got %v", got)
+	}
+}
+
+// scopedEnv is a test environment carrying a memo cache.
+type scopedEnv struct {
+	cache *reader.Cache
+}
+
+func (e scopedEnv) Cache() *reader.Cache {
+	return e.cache
+}
+
+func (e scopedEnv) WithCache(c *reader.Cache) scopedEnv {
+	e.cache = c
+	return e
+}
+
+func TestScoped(t *testing.T) {
+	runs := 0
+	shared := reader.Memo(reader.New(func(scopedEnv) int {
+		runs++
+		return runs
+	}))
+	sum := reader.Zip(shared, shared, func(a, b int) int {
+		return a + b
+	})
+
+	t.Run("runs memoized readers once per run", func(t *testing.T) {
+		runs = 0
+		r := reader.Scoped(sum)
+		if got := r.Run(scopedEnv{}); got != 2 {
+			t.Errorf("expected 2, got %v", got)
+		}
+		if got := r.Run(scopedEnv{}); got != 4 {
+			t.Errorf("expected 4, got %v", got)
+		}
+		if runs != 2 {
+			t.Errorf("expected 2 runs, got %d", runs)
+		}
+	})
+
+	t.Run("shares values between concurrent readers", func(t *testing.T) {
+		runs = 0
+		r := reader.Scoped(reader.ParZip(shared, shared, func(a, b int) int {
+			return a + b
+		}))
+		if got := r.Run(scopedEnv{}); got != 2 {
+			t.Errorf("expected 2, got %v", got)
+		}
+	})
+
+	t.Run("raises a panic on every load", func(t *testing.T) {
+		calls := 0
+		boom := reader.Memo(reader.New(func(scopedEnv) int {
+			calls++
+			panic("boom")
+		}))
+		load := func(e scopedEnv) (p any) {
+			defer func() {
+				p = recover()
+			}()
+			boom.Run(e)
+			return nil
+		}
+
+		r := reader.Scoped(reader.New(func(e scopedEnv) [2]any {
+			return [2]any{load(e), load(e)}
+		}))
+		if got := r.Run(scopedEnv{}); got != [2]any{"boom", "boom"} {
+			t.Errorf("expected both loads to panic, got %v", got)
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("runs memoized readers each time outside a scope", func(t *testing.T) {
+		runs = 0
+		if got := sum.Run(scopedEnv{}); got != 3 {
+			t.Errorf("expected 3, got %v", got)
+		}
+	})
+}
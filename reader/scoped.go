@@ -0,0 +1,94 @@
+package reader
+
+import "sync"
+
+// Cache holds the values of the [Memo] computations run within a single
+// [Scoped] computation. A Cache is safe for concurrent use, so memoized
+// computations may be combined with [ParZip] and [ParSequence].
+type Cache struct {
+	mu      sync.Mutex
+	entries map[*memoKey]*memoEntry
+}
+
+// memoKey identifies a [Memo] computation within a [Cache]. It is not zero
+// sized so that each key has a distinct address.
+type memoKey struct {
+	_ byte
+}
+
+type memoEntry struct {
+	once     sync.Once
+	value    any
+	panicked any
+}
+
+// NewCache returns an empty [*Cache].
+func NewCache() *Cache {
+	return &Cache{entries: make(map[*memoKey]*memoEntry)}
+}
+
+// load returns the cached value for the key, calling f to compute it the first
+// time the key is loaded. Concurrent loads of the same key wait for f to
+// return rather than calling it again. If f panics, the panic is raised again
+// by every load of the key, rather than later loads returning no value.
+func (c *Cache) load(key *memoKey, f func() any) any {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &memoEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		defer func() {
+			entry.panicked = recover()
+		}()
+		entry.value = f()
+	})
+	if entry.panicked != nil {
+		panic(entry.panicked)
+	}
+	return entry.value
+}
+
+// Scope is implemented by environments that carry a [*Cache].
+//
+// Type parameter E represents the environment type itself, so that WithCache
+// may return a modified copy of the environment.
+type Scope[E any] interface {
+	// Cache returns the cache carried by the environment, or nil if it carries
+	// none.
+	Cache() *Cache
+
+	// WithCache returns a copy of the environment carrying the given cache.
+	WithCache(*Cache) E
+}
+
+// Scoped runs a [Reader] computation with a new [*Cache] installed in its
+// environment, so that each [Memo] computation it runs is evaluated at most once
+// per run. Separate runs of the computation do not share values.
+func Scoped[E Scope[E], A any](r Reader[E, A]) Reader[E, A] {
+	return Local(r, func(env E) E {
+		return env.WithCache(NewCache())
+	})
+}
+
+// Memo wraps a [Reader] computation so that, within a single run of a [Scoped]
+// computation, it is evaluated once and its value reused wherever it appears,
+// such as when it is shared by both sides of a [Zip]. Outside of a scope,
+// where the environment carries no cache, it is evaluated each time it is run.
+func Memo[E Scope[E], A any](r Reader[E, A]) Reader[E, A] {
+	key := &memoKey{}
+	return New(func(env E) A {
+		cache := env.Cache()
+		if cache == nil {
+			return r.Run(env)
+		}
+		// The value may be a nil interface, so the assertion must not panic.
+		a, _ := cache.load(key, func() any {
+			return r.Run(env)
+		}).(A)
+		return a
+	})
+}
@@ -0,0 +1,150 @@
+// Package refined provides numeric types whose values are guaranteed to satisfy
+// an invariant.
+//
+// Values of refined types can only be created through smart constructors that
+// validate their input and return a [gofp.Result]. Once created, a value is
+// known to be valid, so the validation need not be repeated wherever it is
+// used. Arithmetic on refined types checks that the invariant still holds,
+// which also catches integer overflow.
+package refined
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// ErrOutOfRange is returned when a value does not satisfy the invariant of a
+// refined type.
+var ErrOutOfRange = errors.New("value out of range")
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Positive is a number strictly greater than zero.
+//
+// Type parameter T represents the underlying number type.
+type Positive[T Number] struct {
+	value T
+}
+
+// NewPositive returns a [Positive] if the given value is greater than zero,
+// otherwise an Err wrapping [ErrOutOfRange].
+func NewPositive[T Number](v T) gofp.Result[Positive[T]] {
+	if !(v > 0) {
+		return gofp.Err[Positive[T]](fmt.Errorf("%w: %v is not positive", ErrOutOfRange, v))
+	}
+	return gofp.Ok(Positive[T]{value: v})
+}
+
+// Value returns the underlying number.
+func (p Positive[T]) Value() T {
+	return p.value
+}
+
+func (p Positive[T]) String() string {
+	return fmt.Sprint(p.value)
+}
+
+// Add returns the sum of two [Positive] numbers, or an Err if the sum
+// overflows.
+func (p Positive[T]) Add(q Positive[T]) gofp.Result[Positive[T]] {
+	return NewPositive(p.value + q.value)
+}
+
+// Mul returns the product of two [Positive] numbers, or an Err if the product
+// overflows.
+func (p Positive[T]) Mul(q Positive[T]) gofp.Result[Positive[T]] {
+	if !isFloat[T]() && (p.value*q.value)/p.value != q.value {
+		return gofp.Err[Positive[T]](fmt.Errorf("%w: %v * %v overflows", ErrOutOfRange, p.value, q.value))
+	}
+	return NewPositive(p.value * q.value)
+}
+
+// isFloat reports whether T is a floating-point type, for which overflow
+// produces an infinity rather than wrapping around.
+func isFloat[T Number]() bool {
+	return T(1)/T(2) != 0
+}
+
+// NonNegative is a number greater than or equal to zero.
+//
+// Type parameter T represents the underlying number type.
+type NonNegative[T Number] struct {
+	value T
+}
+
+// NewNonNegative returns a [NonNegative] if the given value is greater than or
+// equal to zero, otherwise an Err wrapping [ErrOutOfRange].
+func NewNonNegative[T Number](v T) gofp.Result[NonNegative[T]] {
+	if !(v >= 0) {
+		return gofp.Err[NonNegative[T]](fmt.Errorf("%w: %v is negative", ErrOutOfRange, v))
+	}
+	return gofp.Ok(NonNegative[T]{value: v})
+}
+
+// Value returns the underlying number.
+func (n NonNegative[T]) Value() T {
+	return n.value
+}
+
+func (n NonNegative[T]) String() string {
+	return fmt.Sprint(n.value)
+}
+
+// Add returns the sum of two [NonNegative] numbers, or an Err if the sum
+// overflows.
+func (n NonNegative[T]) Add(m NonNegative[T]) gofp.Result[NonNegative[T]] {
+	sum := n.value + m.value
+	if sum < n.value {
+		return gofp.Err[NonNegative[T]](fmt.Errorf("%w: %v + %v overflows", ErrOutOfRange, n.value, m.value))
+	}
+	return NewNonNegative(sum)
+}
+
+// Sub returns the difference of two [NonNegative] numbers, or an Err if the
+// difference would be negative.
+func (n NonNegative[T]) Sub(m NonNegative[T]) gofp.Result[NonNegative[T]] {
+	if m.value > n.value {
+		return gofp.Err[NonNegative[T]](fmt.Errorf("%w: %v - %v is negative", ErrOutOfRange, n.value, m.value))
+	}
+	return NewNonNegative(n.value - m.value)
+}
+
+// Percent is a percentage between 0 and 100 inclusive.
+type Percent struct {
+	value float64
+}
+
+// NewPercent returns a [Percent] if the given value is between 0 and 100
+// inclusive, otherwise an Err wrapping [ErrOutOfRange].
+func NewPercent(v float64) gofp.Result[Percent] {
+	if !(v >= 0 && v <= 100) {
+		return gofp.Err[Percent](fmt.Errorf("%w: %v is not a percentage", ErrOutOfRange, v))
+	}
+	return gofp.Ok(Percent{value: v})
+}
+
+// Value returns the percentage as a number between 0 and 100.
+func (p Percent) Value() float64 {
+	return p.value
+}
+
+// Ratio returns the percentage as a number between 0 and 1.
+func (p Percent) Ratio() float64 {
+	return p.value / 100
+}
+
+// Of returns the given percentage of a value.
+func (p Percent) Of(v float64) float64 {
+	return v * p.Ratio()
+}
+
+func (p Percent) String() string {
+	return fmt.Sprintf("%v%%", p.value)
+}
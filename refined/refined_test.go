@@ -0,0 +1,91 @@
+package refined_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/tomasbasham/gofp/refined"
+)
+
+func TestNewPositive(t *testing.T) {
+	if got := refined.NewPositive(5); got.Unwrap().Value() != 5 {
+		t.Errorf("expected Ok(5), got %v", got)
+	}
+	for _, v := range []int{0, -1} {
+		if got := refined.NewPositive(v); !errors.Is(got.UnwrapErr(), refined.ErrOutOfRange) {
+			t.Errorf("expected ErrOutOfRange for %d, got %v", v, got)
+		}
+	}
+	if got := refined.NewPositive(math.NaN()); got.IsOk() {
+		t.Errorf("expected NaN to be rejected, got %v", got)
+	}
+}
+
+func TestPositive_Add(t *testing.T) {
+	a := refined.NewPositive(2).Unwrap()
+	b := refined.NewPositive(3).Unwrap()
+	if got := a.Add(b); got.Unwrap().Value() != 5 {
+		t.Errorf("expected Ok(5), got %v", got)
+	}
+
+	max := refined.NewPositive(int8(math.MaxInt8)).Unwrap()
+	if got := max.Add(max); !errors.Is(got.UnwrapErr(), refined.ErrOutOfRange) {
+		t.Errorf("expected overflow to be detected, got %v", got)
+	}
+}
+
+func TestPositive_Mul(t *testing.T) {
+	a := refined.NewPositive(int8(64)).Unwrap()
+	b := refined.NewPositive(int8(4)).Unwrap()
+	if got := a.Mul(b); !errors.Is(got.UnwrapErr(), refined.ErrOutOfRange) {
+		t.Errorf("expected overflow to be detected, got %v", got)
+	}
+
+	c := refined.NewPositive(int8(2)).Unwrap()
+	if got := b.Mul(c); got.Unwrap().Value() != 8 {
+		t.Errorf("expected Ok(8), got %v", got)
+	}
+}
+
+func TestPositive_MulFloat(t *testing.T) {
+	a := refined.NewPositive(0.1).Unwrap()
+	b := refined.NewPositive(3.0).Unwrap()
+	if got := a.Mul(b); got.IsErr() {
+		t.Errorf("expected Ok, got %v", got)
+	}
+}
+
+func TestNonNegative_Sub(t *testing.T) {
+	a := refined.NewNonNegative(uint(5)).Unwrap()
+	b := refined.NewNonNegative(uint(3)).Unwrap()
+	if got := a.Sub(b); got.Unwrap().Value() != 2 {
+		t.Errorf("expected Ok(2), got %v", got)
+	}
+	if got := b.Sub(a); !errors.Is(got.UnwrapErr(), refined.ErrOutOfRange) {
+		t.Errorf("expected ErrOutOfRange, got %v", got)
+	}
+}
+
+func TestNonNegative_Add(t *testing.T) {
+	max := refined.NewNonNegative(uint8(math.MaxUint8)).Unwrap()
+	one := refined.NewNonNegative(uint8(1)).Unwrap()
+	if got := max.Add(one); !errors.Is(got.UnwrapErr(), refined.ErrOutOfRange) {
+		t.Errorf("expected overflow to be detected, got %v", got)
+	}
+}
+
+func TestNewPercent(t *testing.T) {
+	p := refined.NewPercent(25).Unwrap()
+	if p.Of(200) != 50 {
+		t.Errorf("expected 50, got %v", p.Of(200))
+	}
+	if p.String() != "25%" {
+		t.Errorf("expected 25%%, got %s", p)
+	}
+	for _, v := range []float64{-1, 101} {
+		if got := refined.NewPercent(v); got.IsOk() {
+			t.Errorf("expected %v to be rejected, got %v", v, got)
+		}
+	}
+}
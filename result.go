@@ -1,9 +1,11 @@
 package gofp
 
 import (
+	"errors"
 	"fmt"
 	"runtime"
-	"strings"
+	"strconv"
+	"sync"
 )
 
 const (
@@ -17,6 +19,10 @@ const (
 	pcSkip = 3
 )
 
+// ErrNoResults is returned by functions that select from a list of [Result]
+// values when the list is empty.
+var ErrNoResults = errors.New("no results")
+
 // Result represents a computation that may fail. Unlike [Either], it
 // specifically deals with error cases and provides utility methods for working
 // with Go's error handling patterns.
@@ -26,7 +32,8 @@ type Result[T any] struct {
 	value T
 	err   error
 	isErr bool
-	stack string
+	stack *stack
+	meta  *meta
 }
 
 // Map applies a function to transform the value of a [Result].
@@ -53,7 +60,7 @@ func Err[T any](err error) Result[T] {
 // FromReturn returns a [Result] from a value and an error (Go's typical return
 // pattern).
 func FromReturn[T any](v T, err error) Result[T] {
-	stack := ""
+	var stack *stack
 	if err != nil {
 		stack = callers()
 	}
@@ -66,22 +73,93 @@ func FromReturn[T any](v T, err error) Result[T] {
 	}
 }
 
-func callers() string {
-	pc := make([]uintptr, pcCount)
-	n := runtime.Callers(pcSkip, pc)
-	if n == 0 {
-		// Return now to avoid processing the zero Frame that would otherwise be
-		// returned by frames.Next below.
+// FromReturn2 returns a [Result] from two values and an error, as returned by
+// many Go functions. The values are held together in a [Pair].
+func FromReturn2[A, B any](a A, b B, err error) Result[Pair[A, B]] {
+	var stack *stack
+	if err != nil {
+		stack = callers()
+	}
+
+	return Result[Pair[A, B]]{
+		value: NewPair(a, b),
+		err:   err,
+		isErr: err != nil,
+		stack: stack,
+	}
+}
+
+// FromReturn3 returns a [Result] from three values and an error. The values are
+// held together in a [Triple].
+func FromReturn3[A, B, C any](a A, b B, c C, err error) Result[Triple[A, B, C]] {
+	var stack *stack
+	if err != nil {
+		stack = callers()
+	}
+
+	return Result[Triple[A, B, C]]{
+		value: NewTriple(a, b, c),
+		err:   err,
+		isErr: err != nil,
+		stack: stack,
+	}
+}
+
+// stack is the stack trace of an Err. The program counters are captured when
+// the Err is created, but resolving them into frames is comparatively
+// expensive, so it is deferred until the trace is first requested. Errors that
+// are recovered from and never printed therefore never pay for it.
+type stack struct {
+	pc [pcCount]uintptr
+	n  int
+
+	once  sync.Once
+	trace string
+}
+
+// resolvedStack returns a [stack] whose trace is already known, such as one
+// decoded from its binary encoding.
+func resolvedStack(trace string) *stack {
+	s := &stack{trace: trace}
+	s.once.Do(func() {})
+	return s
+}
+
+// String returns the stack trace, with one line per frame. It is safe to call
+// on a nil stack, which has an empty trace.
+func (s *stack) String() string {
+	if s == nil {
 		return ""
 	}
+	s.once.Do(func() {
+		s.trace = frames(s.pc[:s.n])
+	})
+	return s.trace
+}
 
-	pc = pc[:n] // pass only valid pcs to runtime.CallersFrames
-	frames := runtime.CallersFrames(pc)
+func callers() *stack {
+	s := new(stack)
+	s.n = runtime.Callers(pcSkip, s.pc[:])
+	return s
+}
 
-	var sb strings.Builder
+func frames(pc []uintptr) string {
+	if len(pc) == 0 {
+		// Return now to avoid processing the zero Frame that would otherwise be
+		// returned by it.Next below.
+		return ""
+	}
+
+	var buf []byte
+	it := runtime.CallersFrames(pc)
 	for {
-		frame, more := frames.Next()
-		fmt.Fprintf(&sb, "%s:%d %s\n", frame.File, frame.Line, frame.Function)
+		frame, more := it.Next()
+		buf = append(buf, frame.File...)
+		buf = append(buf, ':')
+		buf = strconv.AppendInt(buf, int64(frame.Line), 10)
+		buf = append(buf, ' ')
+		buf = append(buf, frame.Function...)
+		buf = append(buf, '\n')
 		if frame.Function == "main.main" {
 			break
 		}
@@ -90,8 +168,7 @@ func callers() string {
 			break
 		}
 	}
-
-	return sb.String()
+	return string(buf)
 }
 
 // ResultMap applies a function to transform the value type of a
@@ -99,9 +176,9 @@ func callers() string {
 // type.
 func ResultMap[T, U any](r Result[T], fn func(T) U) Result[U] {
 	if r.isErr {
-		return Result[U]{err: r.err, isErr: true, stack: r.stack}
+		return Result[U]{err: r.err, isErr: true, stack: r.stack, meta: r.meta}
 	}
-	return Ok(fn(r.value))
+	return Result[U]{value: fn(r.value), meta: r.meta}
 }
 
 // ResultApply applies a [Result] computation containing a function to a
@@ -110,12 +187,12 @@ func ResultMap[T, U any](r Result[T], fn func(T) U) Result[U] {
 // the result of a [Result] computation.
 func ResultApply[T, U any](r Result[T], fn Result[func(T) U]) Result[U] {
 	if r.isErr {
-		return Result[U]{err: r.err, isErr: true, stack: r.stack}
+		return Result[U]{err: r.err, isErr: true, stack: r.stack, meta: r.meta}
 	}
 	if fn.isErr {
-		return Result[U]{err: fn.err, isErr: true, stack: fn.stack}
+		return Result[U]{err: fn.err, isErr: true, stack: fn.stack, meta: fn.meta.over(r.meta)}
 	}
-	return Ok(fn.value(r.value))
+	return Result[U]{value: fn.value(r.value), meta: fn.meta.over(r.meta)}
 }
 
 // ResultFlatMap composes two [Result] computations by using the value of the
@@ -123,9 +200,9 @@ func ResultApply[T, U any](r Result[T], fn Result[func(T) U]) Result[U] {
 // changing the value type.
 func ResultFlatMap[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
 	if r.isErr {
-		return Result[U]{err: r.err, isErr: true, stack: r.stack}
+		return Result[U]{err: r.err, isErr: true, stack: r.stack, meta: r.meta}
 	}
-	return fn(r.value)
+	return fn(r.value).underMeta(r.meta)
 }
 
 // ResultSequence transforms a slice of [Result] values into a single [Result]
@@ -143,6 +220,177 @@ func ResultSequence[T any](results []Result[T]) Result[[]T] {
 	return values
 }
 
+// ResultSequenceWithProgress is [ResultSequence], calling onProgress after each
+// value is examined with the number examined so far and the total, so that a
+// long batch operation may drive a progress bar. It stops at the first Err
+// without reporting further progress.
+func ResultSequenceWithProgress[T any](results []Result[T], onProgress func(done, total int)) Result[[]T] {
+	return ResultTraverseWithProgress(results, func(r Result[T]) Result[T] { return r }, onProgress)
+}
+
+// ResultTraverseWithProgress applies a function to each element of a slice,
+// collecting the values into a single [Result] of a slice, and calls
+// onProgress after each element with the number processed so far and the
+// total. It stops at the first Err without reporting further progress.
+func ResultTraverseWithProgress[T, U any](xs []T, fn func(T) Result[U], onProgress func(done, total int)) Result[[]U] {
+	values := make([]U, 0, len(xs))
+	for i, x := range xs {
+		r := fn(x)
+		if r.isErr {
+			return Result[[]U]{err: r.err, isErr: true, stack: r.stack, meta: r.meta}
+		}
+		values = append(values, r.value)
+		onProgress(i+1, len(xs))
+	}
+	return Ok(values)
+}
+
+// ResultSequenceAll transforms a slice of [Result] values into a single
+// [Result] of a slice. Unlike [ResultSequence], it does not stop at the first
+// Err. If any value is Err, it returns an Err holding an [*AggregateError] that
+// collects an [*IndexError] for every failed value, in order.
+func ResultSequenceAll[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(results))
+	var errs []error
+	for i, r := range results {
+		if r.isErr {
+			errs = append(errs, &IndexError{Index: i, Err: r.err})
+			continue
+		}
+		values = append(values, r.value)
+	}
+	if len(errs) > 0 {
+		return Err[[]T](&AggregateError{Errors: errs})
+	}
+	return Ok(values)
+}
+
+// ResultSequenceAtLeast summarises a slice of [Result] values, as
+// [ResultStats] does, for quorum-style operations that succeed if enough of
+// their parts do, such as writing to three replicas and needing two. If at
+// least k values are Ok, it returns Ok with the summary, whose Errors field
+// reports any failures. Otherwise it returns an Err holding a [*QuorumError].
+func ResultSequenceAtLeast[T any](results []Result[T], k int) Result[ResultStatistics[T]] {
+	stats := ResultStats(results)
+	if stats.Ok < k {
+		return Err[ResultStatistics[T]](&QuorumError{
+			Need:  k,
+			Ok:    stats.Ok,
+			Total: len(results),
+			Err:   stats.Errors,
+		})
+	}
+	return Ok(stats)
+}
+
+// ResultSequenceMapKeyed partitions a map of [Result] values by key, returning
+// the values of every Ok and the errors of every Err, each under its original
+// key. Unlike [ResultSequence] it never fails, so batch operations keyed by an
+// identifier report which inputs failed and why. Both maps are non-nil.
+func ResultSequenceMapKeyed[K comparable, V any](results map[K]Result[V]) (map[K]V, map[K]error) {
+	values := make(map[K]V, len(results))
+	errs := make(map[K]error)
+	for k, r := range results {
+		if r.isErr {
+			errs[k] = r.err
+			continue
+		}
+		values[k] = r.value
+	}
+	return values, errs
+}
+
+// ResultTraverseIndexed applies a function to each element of a slice along
+// with its index, collecting the values into a single [Result] of a slice. It
+// stops at the first Err, wrapping its error in an [*IndexError] recording the
+// index of the element that caused the failure.
+func ResultTraverseIndexed[T, U any](xs []T, fn func(int, T) Result[U]) Result[[]U] {
+	values := make([]U, 0, len(xs))
+	for i, x := range xs {
+		r := fn(i, x)
+		if r.isErr {
+			return Result[[]U]{err: &IndexError{Index: i, Err: r.err}, isErr: true, stack: r.stack, meta: r.meta}
+		}
+		values = append(values, r.value)
+	}
+	return Ok(values)
+}
+
+// ChunkReport describes the outcome of [ResultTraverseChunked].
+//
+// Type parameter U represents the type of the successful values.
+type ChunkReport[U any] struct {
+	// Values contains the values of every chunk that succeeded, in order.
+	Values []U
+
+	// Succeeded is the number of chunks that succeeded.
+	Succeeded int
+
+	// Failed contains an error for every chunk that failed, in order.
+	Failed []*ChunkError
+
+	// Skipped is the number of chunks that were not processed because an
+	// earlier chunk failed.
+	Skipped int
+}
+
+// Err returns an [*AggregateError] collecting the error of every chunk that
+// failed, or nil if none did.
+func (r ChunkReport[U]) Err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+	errs := make([]error, len(r.Failed))
+	for i, err := range r.Failed {
+		errs[i] = err
+	}
+	return &AggregateError{Errors: errs}
+}
+
+// ResultTraverseChunked applies a function to each element of a slice in
+// chunks of chunkSize elements. A chunk succeeds if every element in it
+// returns Ok, and fails at its first Err, discarding the values of the rest of
+// the chunk, so that a chunk may be treated as a unit, such as a batch written
+// in a single transaction. If keepGoing is true a failed chunk does not
+// prevent later chunks from being processed; otherwise they are skipped. It
+// panics if chunkSize is not positive.
+func ResultTraverseChunked[T, U any](xs []T, chunkSize int, fn func(T) Result[U], keepGoing bool) ChunkReport[U] {
+	if chunkSize <= 0 {
+		panic("gofp: chunk size must be positive")
+	}
+
+	report := ChunkReport[U]{Values: make([]U, 0, len(xs))}
+	for chunk, start := 0, 0; start < len(xs); chunk, start = chunk+1, start+chunkSize {
+		if len(report.Failed) > 0 && !keepGoing {
+			report.Skipped++
+			continue
+		}
+
+		if err := traverseChunk(xs, start, min(start+chunkSize, len(xs)), fn, &report.Values); err != nil {
+			err.Chunk = chunk
+			report.Failed = append(report.Failed, err)
+			continue
+		}
+		report.Succeeded++
+	}
+	return report
+}
+
+// traverseChunk applies fn to xs[start:end], appending the values to values
+// only if every element returns Ok.
+func traverseChunk[T, U any](xs []T, start, end int, fn func(T) Result[U], values *[]U) *ChunkError {
+	n := len(*values)
+	for i := start; i < end; i++ {
+		r := fn(xs[i])
+		if r.isErr {
+			*values = (*values)[:n]
+			return &ChunkError{Index: i, Err: r.err}
+		}
+		*values = append(*values, r.value)
+	}
+	return nil
+}
+
 // ResultFold applies one of two functions to the value of the [Result]
 // depending on whether it is an Ok or an Err.
 func ResultFold[T, R any](r Result[T], errFn func(error) R, okFn func(T) R) R {
@@ -152,11 +400,150 @@ func ResultFold[T, R any](r Result[T], errFn func(error) R, okFn func(T) R) R {
 	return okFn(r.value)
 }
 
+// ResultMapOr applies a function to the value of a [Result] if it is Ok, or
+// otherwise returns the given default value. Similar to the [Result.MapOr]
+// method but allows changing the value type.
+func ResultMapOr[T, U any](r Result[T], defaultValue U, fn func(T) U) U {
+	if r.isErr {
+		return defaultValue
+	}
+	return fn(r.value)
+}
+
+// ResultMapOrElse applies okFn to the value of a [Result] if it is Ok, or
+// otherwise applies errFn to the error. It is equivalent to [ResultFold], and
+// is similar to the [Result.MapOrElse] method but allows changing the value
+// type.
+func ResultMapOrElse[T, U any](r Result[T], errFn func(error) U, okFn func(T) U) U {
+	return ResultFold(r, errFn, okFn)
+}
+
+// ResultCompare compares two [Result] values using cmp to compare their values.
+// Err is ordered before any Ok value, and two Err values are equal regardless
+// of their errors. The result follows the convention of [cmp.Compare].
+func ResultCompare[T any](a, b Result[T], cmp func(T, T) int) int {
+	switch {
+	case a.isErr && b.isErr:
+		return 0
+	case a.isErr:
+		return -1
+	case b.isErr:
+		return 1
+	}
+	return cmp(a.value, b.value)
+}
+
+// ResultComparator lifts a [Comparator] on T into a [Comparator] on [Result]
+// values, as described by [ResultCompare]. The result may be passed directly
+// to [slices.SortFunc].
+func ResultComparator[T any](c Comparator[T]) Comparator[Result[T]] {
+	return func(a, b Result[T]) int {
+		return ResultCompare(a, b, c)
+	}
+}
+
+// FirstOk returns the first of the given [Result] values that is Ok. If none of
+// them are, it returns an Err holding an [*AggregateError] that collects every
+// error in order. If no values are given, it returns an Err holding
+// [ErrNoResults].
+func FirstOk[T any](results ...Result[T]) Result[T] {
+	if len(results) == 0 {
+		return Err[T](ErrNoResults)
+	}
+
+	errs := make([]error, 0, len(results))
+	for _, r := range results {
+		if !r.isErr {
+			return r
+		}
+		errs = append(errs, r.err)
+	}
+	return Err[T](&AggregateError{Errors: errs})
+}
+
+// Served is a value produced by [Fallback], along with which of its sources
+// served it.
+//
+// Type parameter T represents the type of the value.
+type Served[T any] struct {
+	// Value is the value served.
+	Value T
+
+	// Source is the index of the source that served the value, where 0 is the
+	// primary source and 1 is the first secondary source.
+	Source int
+
+	// Failures holds the errors of the sources tried before the one that
+	// served the value, in order.
+	Failures []error
+}
+
+// Degraded reports whether the value was served by a secondary source because
+// the primary source failed.
+func (s Served[T]) Degraded() bool {
+	return s.Source > 0
+}
+
+// Fallback calls primary and then each of the secondaries in turn until one of
+// them returns Ok, and returns its value as a [Served] recording which source
+// served it. Later sources are not called. If every source fails, it returns
+// an Err holding an [*AggregateError] that collects every error in order.
+func Fallback[T any](primary func() Result[T], secondaries ...func() Result[T]) Result[Served[T]] {
+	var errs []error
+	for i, source := range append([]func() Result[T]{primary}, secondaries...) {
+		r := source()
+		if !r.isErr {
+			return Ok(Served[T]{Value: r.value, Source: i, Failures: errs})
+		}
+		errs = append(errs, r.err)
+	}
+	return Err[Served[T]](&AggregateError{Errors: errs})
+}
+
+// ResultStatistics summarises a batch of [Result] values.
+//
+// Type parameter T represents the type of the successful values.
+type ResultStatistics[T any] struct {
+	// Ok is the number of values that were Ok.
+	Ok int
+
+	// Err is the number of values that were Err.
+	Err int
+
+	// Values contains the successful values, in order.
+	Values []T
+
+	// Errors is an [*AggregateError] collecting every error, in order, or is
+	// nil if there were none.
+	Errors error
+}
+
+// ResultStats summarises a slice of [Result] values without failing fast. It is
+// useful for batch jobs that need to report on every item rather than stopping
+// at the first error.
+func ResultStats[T any](results []Result[T]) ResultStatistics[T] {
+	stats := ResultStatistics[T]{Values: make([]T, 0, len(results))}
+	var errs []error
+	for _, r := range results {
+		if r.isErr {
+			stats.Err++
+			errs = append(errs, r.err)
+			continue
+		}
+		stats.Ok++
+		stats.Values = append(stats.Values, r.value)
+	}
+	if len(errs) > 0 {
+		stats.Errors = &AggregateError{Errors: errs}
+	}
+	return stats
+}
+
 func (r Result[T]) String() string {
 	if r.isErr {
 		return fmt.Sprintf("Err(%v)", r.err)
 	}
-	return fmt.Sprintf("Ok(%v)", r.value)
+	return fmt.Sprintf("Ok(%s)", DebugString(r.value))
 }
 
 // IsOk returns true if the [Result] is Ok.
@@ -169,6 +556,30 @@ func (r Result[T]) IsErr() bool {
 	return r.isErr
 }
 
+// IsOkAnd returns true if the [Result] is Ok and its value satisfies the given
+// predicate.
+func (r Result[T]) IsOkAnd(fn func(T) bool) bool {
+	return !r.isErr && fn(r.value)
+}
+
+// IsErrAnd returns true if the [Result] is an Err and its error satisfies the
+// given predicate.
+func (r Result[T]) IsErrAnd(fn func(error) bool) bool {
+	return r.isErr && fn(r.err)
+}
+
+// MapOr applies a function to the value of the [Result] if it is Ok, or
+// otherwise returns the given default value.
+func (r Result[T]) MapOr(defaultValue T, fn func(T) T) T {
+	return ResultMapOr(r, defaultValue, fn)
+}
+
+// MapOrElse applies okFn to the value of the [Result] if it is Ok, or
+// otherwise applies errFn to the error.
+func (r Result[T]) MapOrElse(errFn func(error) T, okFn func(T) T) T {
+	return ResultMapOrElse(r, errFn, okFn)
+}
+
 // TryUnwrap returns the value of the [Result] and a boolean indicating whether
 // the [Result] is an Ok.
 func (r Result[T]) TryUnwrap() (T, bool) {
@@ -205,6 +616,15 @@ func (r Result[T]) UnwrapOrElse(fn func() T) T {
 	return r.value
 }
 
+// UnwrapOrDefault returns the value of the [Result] or the default value of its
+// type, as given by [DefaultOf], if the [Result] is an Err.
+func (r Result[T]) UnwrapOrDefault() T {
+	if r.isErr {
+		return DefaultOf[T]()
+	}
+	return r.value
+}
+
 // UnwrapErr returns the error of the [Result] or panics if the [Result] is an
 // Ok.
 func (r Result[T]) UnwrapErr() error {
@@ -229,7 +649,7 @@ func (r Result[T]) AndThen(fn func(T) Result[T]) Result[T] {
 	if r.isErr {
 		return r
 	}
-	return fn(r.value)
+	return fn(r.value).underMeta(r.meta)
 }
 
 // Or returns the receiver [Result] if it is an Ok, otherwise it returns the
@@ -247,7 +667,7 @@ func (r Result[T]) OrElse(fn func(error) Result[T]) Result[T] {
 	if !r.isErr {
 		return r
 	}
-	return fn(r.err)
+	return fn(r.err).underMeta(r.meta)
 }
 
 // Ensure converts a value to an Err if it doesn't satisfy the given predicate.
@@ -256,7 +676,7 @@ func (r Result[T]) Ensure(err error, pred func(T) bool) Result[T] {
 		return r
 	}
 	if !pred(r.value) {
-		return Err[T](err)
+		return Err[T](err).underMeta(r.meta)
 	}
 	return r
 }
@@ -268,7 +688,37 @@ func (r Result[T]) EnsureWith(pred func(T) bool, errFn func(T) error) Result[T]
 		return r
 	}
 	if !pred(r.value) {
-		return Err[T](errFn(r.value))
+		return Err[T](errFn(r.value)).underMeta(r.meta)
+	}
+	return r
+}
+
+// Check pairs a [Predicate] with the error reported when a value does not
+// satisfy it. It is used with [Result.EnsureAll].
+//
+// Type parameter T represents the value type.
+type Check[T any] struct {
+	Pred Predicate[T]
+	Err  error
+}
+
+// EnsureAll converts a value to an Err if it doesn't satisfy every one of the
+// given checks. Unlike chaining calls to [Result.Ensure], every check is
+// evaluated and the Err holds an [*AggregateError] collecting the errors of all
+// failed checks, in order.
+func (r Result[T]) EnsureAll(checks ...Check[T]) Result[T] {
+	if r.isErr {
+		return r
+	}
+
+	var errs []error
+	for _, c := range checks {
+		if !c.Pred(r.value) {
+			errs = append(errs, c.Err)
+		}
+	}
+	if len(errs) > 0 {
+		return Err[T](&AggregateError{Errors: errs}).underMeta(r.meta)
 	}
 	return r
 }
@@ -285,6 +735,7 @@ func (r Result[T]) Wrap(msg string) Result[T] {
 		err:   fmt.Errorf("%s: %w", msg, r.err),
 		isErr: true,
 		stack: r.stack,
+		meta:  r.meta,
 	}
 }
 
@@ -297,7 +748,7 @@ func (r Result[T]) ToReturn() (T, error) {
 // [Result] is an Err.
 func (r Result[T]) Recover(fn func(error) T) Result[T] {
 	if r.isErr {
-		return Ok(fn(r.err))
+		return Result[T]{value: fn(r.err), meta: r.meta}
 	}
 	return r
 }
@@ -306,7 +757,7 @@ func (r Result[T]) Recover(fn func(error) T) Result[T] {
 // [Result] is an Err.
 func (r Result[T]) RecoverWith(fn func(error) Result[T]) Result[T] {
 	if r.isErr {
-		return fn(r.err)
+		return fn(r.err).underMeta(r.meta)
 	}
 	return r
 }
@@ -314,7 +765,7 @@ func (r Result[T]) RecoverWith(fn func(error) Result[T]) Result[T] {
 // StackTrace returns the stack trace of the [Result] if it is an Err.
 func (r Result[T]) StackTrace() string {
 	if r.isErr {
-		return r.stack
+		return r.stack.String()
 	}
 	return ""
 }
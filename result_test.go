@@ -1,7 +1,13 @@
 package gofp_test
 
 import (
+	"cmp"
 	"errors"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/tomasbasham/gofp"
@@ -51,6 +57,50 @@ func TestFromReturn(t *testing.T) {
 	})
 }
 
+func TestFromReturn2(t *testing.T) {
+	split := func(s string) (string, string, error) {
+		before, after, ok := strings.Cut(s, "=")
+		if !ok {
+			return "", "", errors.New("missing separator")
+		}
+		return before, after, nil
+	}
+
+	t.Run("returns Ok for non-error", func(t *testing.T) {
+		r := gofp.FromReturn2(split("key=value"))
+		if !r.IsOk() {
+			t.Fatal("expected Ok")
+		}
+		if got := r.Unwrap(); got != gofp.NewPair("key", "value") {
+			t.Errorf("expected (key, value), got %v", got)
+		}
+	})
+
+	t.Run("returns Err for error", func(t *testing.T) {
+		r := gofp.FromReturn2(split("key"))
+		if !r.IsErr() || r.UnwrapErr().Error() != "missing separator" {
+			t.Errorf("expected missing separator error, got %v", r)
+		}
+	})
+}
+
+func TestFromReturn3(t *testing.T) {
+	t.Run("returns Ok for non-error", func(t *testing.T) {
+		r := gofp.FromReturn3("a", 1, true, nil)
+		if got := r.Unwrap(); got != gofp.NewTriple("a", 1, true) {
+			t.Errorf("expected (a, 1, true), got %v", got)
+		}
+	})
+
+	t.Run("returns Err for error", func(t *testing.T) {
+		expectedErr := errors.New("test error")
+		r := gofp.FromReturn3("", 0, false, expectedErr)
+		if !r.IsErr() || r.UnwrapErr() != expectedErr {
+			t.Error("expected test error")
+		}
+	})
+}
+
 func TestResultMap(t *testing.T) {
 	t.Run("maps Ok value", func(t *testing.T) {
 		r := gofp.Ok("test")
@@ -249,6 +299,383 @@ func TestResult_FlatMap(t *testing.T) {
 	})
 }
 
+func TestResultSequenceWithProgress(t *testing.T) {
+	t.Run("reports progress after each value", func(t *testing.T) {
+		var calls [][2]int
+		got := gofp.ResultSequenceWithProgress([]gofp.Result[int]{gofp.Ok(1), gofp.Ok(2), gofp.Ok(3)}, func(done, total int) {
+			calls = append(calls, [2]int{done, total})
+		})
+
+		if want := []int{1, 2, 3}; !reflect.DeepEqual(got.Unwrap(), want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if want := [][2]int{{1, 3}, {2, 3}, {3, 3}}; !reflect.DeepEqual(calls, want) {
+			t.Errorf("expected progress %v, got %v", want, calls)
+		}
+	})
+
+	t.Run("stops reporting at the first Err", func(t *testing.T) {
+		err := errors.New("error")
+		var calls [][2]int
+		got := gofp.ResultSequenceWithProgress([]gofp.Result[int]{gofp.Ok(1), gofp.Err[int](err), gofp.Ok(3)}, func(done, total int) {
+			calls = append(calls, [2]int{done, total})
+		})
+
+		if got.UnwrapErr() != err {
+			t.Errorf("expected %v, got %v", err, got)
+		}
+		if want := [][2]int{{1, 3}}; !reflect.DeepEqual(calls, want) {
+			t.Errorf("expected progress %v, got %v", want, calls)
+		}
+	})
+}
+
+func TestResultTraverseWithProgress(t *testing.T) {
+	var last int
+	got := gofp.ResultTraverseWithProgress([]string{"1", "2"}, func(s string) gofp.Result[int] {
+		return gofp.FromReturn(strconv.Atoi(s))
+	}, func(done, total int) {
+		last = done * 100 / total
+	})
+
+	if want := []int{1, 2}; !reflect.DeepEqual(got.Unwrap(), want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if last != 100 {
+		t.Errorf("expected progress to reach 100%%, got %d%%", last)
+	}
+}
+
+func TestResultSequenceAll(t *testing.T) {
+	t.Run("collects all Ok values", func(t *testing.T) {
+		got := gofp.ResultSequenceAll([]gofp.Result[int]{gofp.Ok(1), gofp.Ok(2)})
+		if !reflect.DeepEqual(got.Unwrap(), []int{1, 2}) {
+			t.Errorf("expected [1 2], got %v", got)
+		}
+	})
+
+	t.Run("collects all errors with indices", func(t *testing.T) {
+		err1 := errors.New("error 1")
+		err2 := errors.New("error 2")
+		got := gofp.ResultSequenceAll([]gofp.Result[int]{
+			gofp.Err[int](err1),
+			gofp.Ok(1),
+			gofp.Err[int](err2),
+		})
+
+		var aggErr *gofp.AggregateError
+		if !errors.As(got.UnwrapErr(), &aggErr) {
+			t.Fatalf("expected *AggregateError, got %T", got.UnwrapErr())
+		}
+		if len(aggErr.Errors) != 2 {
+			t.Fatalf("expected 2 errors, got %d", len(aggErr.Errors))
+		}
+		for i, want := range []int{0, 2} {
+			var idxErr *gofp.IndexError
+			if !errors.As(aggErr.Errors[i], &idxErr) || idxErr.Index != want {
+				t.Errorf("expected error at index %d, got %v", want, aggErr.Errors[i])
+			}
+		}
+		if !errors.Is(got.UnwrapErr(), err1) || !errors.Is(got.UnwrapErr(), err2) {
+			t.Errorf("expected both errors to be wrapped, got %v", got.UnwrapErr())
+		}
+		if got.UnwrapErr().Error() != "index 0: error 1\nindex 2: error 2" {
+			t.Errorf("unexpected error message %q", got.UnwrapErr().Error())
+		}
+	})
+}
+
+func TestResultSequenceAtLeast(t *testing.T) {
+	errReplica := errors.New("replica unavailable")
+
+	t.Run("returns Ok when enough values are Ok", func(t *testing.T) {
+		got := gofp.ResultSequenceAtLeast([]gofp.Result[int]{
+			gofp.Ok(1),
+			gofp.Err[int](errReplica),
+			gofp.Ok(3),
+		}, 2)
+
+		stats := got.Unwrap()
+		if stats.Ok != 2 || stats.Err != 1 || !reflect.DeepEqual(stats.Values, []int{1, 3}) {
+			t.Errorf("unexpected statistics %+v", stats)
+		}
+		if !errors.Is(stats.Errors, errReplica) {
+			t.Errorf("expected failures to be reported, got %v", stats.Errors)
+		}
+	})
+
+	t.Run("returns Err when too few values are Ok", func(t *testing.T) {
+		got := gofp.ResultSequenceAtLeast([]gofp.Result[int]{
+			gofp.Ok(1),
+			gofp.Err[int](errReplica),
+			gofp.Err[int](errReplica),
+		}, 2)
+
+		var quorum *gofp.QuorumError
+		if !errors.As(got.UnwrapErr(), &quorum) {
+			t.Fatalf("expected *QuorumError, got %v", got.UnwrapErr())
+		}
+		if quorum.Need != 2 || quorum.Ok != 1 || quorum.Total != 3 {
+			t.Errorf("unexpected error %+v", quorum)
+		}
+		if !errors.Is(got.UnwrapErr(), errReplica) {
+			t.Errorf("expected error to wrap failures, got %v", got.UnwrapErr())
+		}
+	})
+
+	t.Run("returns Err when k exceeds the number of values", func(t *testing.T) {
+		got := gofp.ResultSequenceAtLeast([]gofp.Result[int]{gofp.Ok(1), gofp.Ok(2)}, 3)
+
+		var quorum *gofp.QuorumError
+		if !errors.As(got.UnwrapErr(), &quorum) {
+			t.Fatalf("expected *QuorumError, got %v", got.UnwrapErr())
+		}
+		if quorum.Err != nil {
+			t.Errorf("expected no failures, got %v", quorum.Err)
+		}
+		if want := "quorum not reached: 2 of 2 succeeded, need 3"; quorum.Error() != want {
+			t.Errorf("expected %q, got %q", want, quorum.Error())
+		}
+	})
+
+	t.Run("returns Ok when k is zero", func(t *testing.T) {
+		got := gofp.ResultSequenceAtLeast[int](nil, 0)
+		if got.IsErr() {
+			t.Errorf("expected Ok, got %v", got)
+		}
+	})
+}
+
+func TestResultSequenceMapKeyed(t *testing.T) {
+	errMissing := errors.New("missing")
+	values, errs := gofp.ResultSequenceMapKeyed(map[string]gofp.Result[int]{
+		"a": gofp.Ok(1),
+		"b": gofp.Err[int](errMissing),
+		"c": gofp.Ok(3),
+	})
+
+	if want := map[string]int{"a": 1, "c": 3}; !maps.Equal(values, want) {
+		t.Errorf("expected values %v, got %v", want, values)
+	}
+	if want := map[string]error{"b": errMissing}; !maps.Equal(errs, want) {
+		t.Errorf("expected errors %v, got %v", want, errs)
+	}
+
+	values, errs = gofp.ResultSequenceMapKeyed[string, int](nil)
+	if values == nil || errs == nil || len(values) != 0 || len(errs) != 0 {
+		t.Errorf("expected empty non-nil maps, got %v and %v", values, errs)
+	}
+}
+
+func TestResultTraverseIndexed(t *testing.T) {
+	t.Run("collects all Ok values", func(t *testing.T) {
+		got := gofp.ResultTraverseIndexed([]int{1, 2}, func(i, x int) gofp.Result[int] {
+			return gofp.Ok(i + x)
+		})
+		if !reflect.DeepEqual(got.Unwrap(), []int{1, 3}) {
+			t.Errorf("expected [1 3], got %v", got)
+		}
+	})
+
+	t.Run("reports index of first Err", func(t *testing.T) {
+		errNegative := errors.New("negative")
+		got := gofp.ResultTraverseIndexed([]int{1, -1, -2}, func(_, x int) gofp.Result[int] {
+			if x < 0 {
+				return gofp.Err[int](errNegative)
+			}
+			return gofp.Ok(x)
+		})
+
+		var idxErr *gofp.IndexError
+		if !errors.As(got.UnwrapErr(), &idxErr) || idxErr.Index != 1 {
+			t.Fatalf("expected error at index 1, got %v", got.UnwrapErr())
+		}
+		if !errors.Is(got.UnwrapErr(), errNegative) {
+			t.Errorf("expected error to wrap %v", errNegative)
+		}
+		if got.UnwrapErr().Error() != "index 1: negative" {
+			t.Errorf("unexpected error message %q", got.UnwrapErr().Error())
+		}
+	})
+}
+
+func TestResultTraverseChunked(t *testing.T) {
+	errOdd := errors.New("odd")
+	evenOnly := func(n int) gofp.Result[int] {
+		if n%2 != 0 {
+			return gofp.Err[int](errOdd)
+		}
+		return gofp.Ok(n * 10)
+	}
+
+	t.Run("succeeds when every chunk succeeds", func(t *testing.T) {
+		report := gofp.ResultTraverseChunked([]int{2, 4, 6, 8, 10}, 2, evenOnly, false)
+		if want := []int{20, 40, 60, 80, 100}; !reflect.DeepEqual(report.Values, want) {
+			t.Errorf("expected values %v, got %v", want, report.Values)
+		}
+		if report.Succeeded != 3 || len(report.Failed) != 0 || report.Skipped != 0 {
+			t.Errorf("unexpected report %+v", report)
+		}
+		if err := report.Err(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("isolates failures to their chunk", func(t *testing.T) {
+		report := gofp.ResultTraverseChunked([]int{2, 4, 6, 7, 8, 10, 11}, 2, evenOnly, true)
+		if want := []int{20, 40, 80, 100}; !reflect.DeepEqual(report.Values, want) {
+			t.Errorf("expected values %v, got %v", want, report.Values)
+		}
+		if report.Succeeded != 2 || report.Skipped != 0 {
+			t.Errorf("unexpected report %+v", report)
+		}
+		want := []*gofp.ChunkError{
+			{Chunk: 1, Index: 3, Err: errOdd},
+			{Chunk: 3, Index: 6, Err: errOdd},
+		}
+		if !reflect.DeepEqual(report.Failed, want) {
+			t.Errorf("expected failures %v, got %v", want, report.Failed)
+		}
+		if !errors.Is(report.Err(), errOdd) {
+			t.Errorf("expected error to wrap failures, got %v", report.Err())
+		}
+	})
+
+	t.Run("skips later chunks after a failure", func(t *testing.T) {
+		report := gofp.ResultTraverseChunked([]int{2, 3, 4, 6, 8}, 2, evenOnly, false)
+		if len(report.Values) != 0 || report.Succeeded != 0 || len(report.Failed) != 1 || report.Skipped != 2 {
+			t.Errorf("unexpected report %+v", report)
+		}
+		if got := report.Err().Error(); got != "chunk 0: index 1: odd" {
+			t.Errorf("unexpected error %q", got)
+		}
+	})
+
+	t.Run("panics when the chunk size is not positive", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic")
+			}
+		}()
+		gofp.ResultTraverseChunked([]int{1}, 0, evenOnly, true)
+	})
+}
+
+func TestFirstOk(t *testing.T) {
+	t.Run("returns first Ok value", func(t *testing.T) {
+		got := gofp.FirstOk(gofp.Err[int](errors.New("error")), gofp.Ok(1), gofp.Ok(2))
+		if got.Unwrap() != 1 {
+			t.Errorf("expected 1, got %v", got)
+		}
+	})
+
+	t.Run("aggregates errors when all values are Err", func(t *testing.T) {
+		err1 := errors.New("error 1")
+		err2 := errors.New("error 2")
+		got := gofp.FirstOk(gofp.Err[int](err1), gofp.Err[int](err2))
+
+		var agg *gofp.AggregateError
+		if !errors.As(got.UnwrapErr(), &agg) || !reflect.DeepEqual(agg.Errors, []error{err1, err2}) {
+			t.Errorf("expected an AggregateError of both errors, got %v", got.UnwrapErr())
+		}
+	})
+
+	t.Run("returns ErrNoResults when no values are given", func(t *testing.T) {
+		got := gofp.FirstOk[int]()
+		if got.UnwrapErr() != gofp.ErrNoResults {
+			t.Errorf("expected ErrNoResults, got %v", got.UnwrapErr())
+		}
+	})
+}
+
+func TestFallback(t *testing.T) {
+	t.Run("serves the primary source when Ok", func(t *testing.T) {
+		var calls int
+		secondary := func() gofp.Result[int] {
+			calls++
+			return gofp.Ok(2)
+		}
+		got := gofp.Fallback(func() gofp.Result[int] { return gofp.Ok(1) }, secondary).Unwrap()
+		if got.Value != 1 || got.Source != 0 || got.Degraded() || len(got.Failures) != 0 {
+			t.Errorf("expected value from primary source, got %+v", got)
+		}
+		if calls != 0 {
+			t.Errorf("expected secondary source not to be called, called %d times", calls)
+		}
+	})
+
+	t.Run("serves the first secondary source that is Ok", func(t *testing.T) {
+		err1 := errors.New("error 1")
+		err2 := errors.New("error 2")
+		got := gofp.Fallback(
+			func() gofp.Result[int] { return gofp.Err[int](err1) },
+			func() gofp.Result[int] { return gofp.Err[int](err2) },
+			func() gofp.Result[int] { return gofp.Ok(3) },
+		).Unwrap()
+		if got.Value != 3 || got.Source != 2 || !got.Degraded() {
+			t.Errorf("expected value from source 2, got %+v", got)
+		}
+		if len(got.Failures) != 2 || got.Failures[0] != err1 || got.Failures[1] != err2 {
+			t.Errorf("expected failures of earlier sources, got %v", got.Failures)
+		}
+	})
+
+	t.Run("joins errors when every source fails", func(t *testing.T) {
+		err1 := errors.New("error 1")
+		err2 := errors.New("error 2")
+		got := gofp.Fallback(
+			func() gofp.Result[int] { return gofp.Err[int](err1) },
+			func() gofp.Result[int] { return gofp.Err[int](err2) },
+		)
+		if !errors.Is(got.UnwrapErr(), err1) || !errors.Is(got.UnwrapErr(), err2) {
+			t.Errorf("expected joined errors, got %v", got.UnwrapErr())
+		}
+	})
+}
+
+func TestResultStats(t *testing.T) {
+	t.Run("summarises a mixed batch", func(t *testing.T) {
+		err1 := errors.New("first")
+		err2 := errors.New("second")
+		stats := gofp.ResultStats([]gofp.Result[int]{
+			gofp.Ok(1),
+			gofp.Err[int](err1),
+			gofp.Ok(2),
+			gofp.Err[int](err2),
+		})
+
+		if stats.Ok != 2 || stats.Err != 2 {
+			t.Errorf("expected 2 Ok and 2 Err, got %d and %d", stats.Ok, stats.Err)
+		}
+		if !reflect.DeepEqual(stats.Values, []int{1, 2}) {
+			t.Errorf("expected values [1 2], got %v", stats.Values)
+		}
+		if !errors.Is(stats.Errors, err1) || !errors.Is(stats.Errors, err2) {
+			t.Errorf("expected both errors to be joined, got %v", stats.Errors)
+		}
+	})
+
+	t.Run("has no errors when every value is Ok", func(t *testing.T) {
+		stats := gofp.ResultStats([]gofp.Result[int]{gofp.Ok(1)})
+		if stats.Errors != nil {
+			t.Errorf("expected no errors, got %v", stats.Errors)
+		}
+	})
+}
+
+func TestResultComparator(t *testing.T) {
+	err := errors.New("failed")
+	xs := []gofp.Result[int]{gofp.Ok(3), gofp.Err[int](err), gofp.Ok(1)}
+	slices.SortFunc(xs, gofp.ResultComparator(gofp.Natural[int]()))
+
+	if xs[0].IsOk() || xs[1].Unwrap() != 1 || xs[2].Unwrap() != 3 {
+		t.Errorf("expected [Err, Ok(1), Ok(3)], got %v", xs)
+	}
+	if got := gofp.ResultCompare(gofp.Err[int](err), gofp.Err[int](errors.New("other")), cmp.Compare[int]); got != 0 {
+		t.Errorf("expected Err values to be equal, got %d", got)
+	}
+}
+
 func TestResult_String(t *testing.T) {
 	t.Run("formats Ok value", func(t *testing.T) {
 		r := gofp.Ok("test")
@@ -507,6 +934,46 @@ func TestResult_Ensure(t *testing.T) {
 	})
 }
 
+func TestResult_EnsureAll(t *testing.T) {
+	errNegative := errors.New("negative")
+	errOdd := errors.New("odd")
+	errLarge := errors.New("too large")
+	checks := []gofp.Check[int]{
+		{Pred: func(i int) bool { return i >= 0 }, Err: errNegative},
+		{Pred: func(i int) bool { return i%2 == 0 }, Err: errOdd},
+		{Pred: func(i int) bool { return i < 100 }, Err: errLarge},
+	}
+
+	t.Run("keeps value when every check passes", func(t *testing.T) {
+		got := gofp.Ok(4).EnsureAll(checks...)
+		if !got.IsOk() || got.Unwrap() != 4 {
+			t.Errorf("expected Ok(4), got %v", got)
+		}
+	})
+
+	t.Run("joins the errors of every failed check", func(t *testing.T) {
+		got := gofp.Ok(-3).EnsureAll(checks...)
+		if !got.IsErr() {
+			t.Fatal("expected Err")
+		}
+		err := got.UnwrapErr()
+		if !errors.Is(err, errNegative) || !errors.Is(err, errOdd) {
+			t.Errorf("expected negative and odd errors, got %v", err)
+		}
+		if errors.Is(err, errLarge) {
+			t.Errorf("expected passing check to be excluded, got %v", err)
+		}
+	})
+
+	t.Run("propagates error", func(t *testing.T) {
+		expectedErr := errors.New("test error")
+		got := gofp.Err[int](expectedErr).EnsureAll(checks...)
+		if got.UnwrapErr() != expectedErr {
+			t.Error("expected error to propagate")
+		}
+	})
+}
+
 func TestResult_ToReturn(t *testing.T) {
 	t.Run("returns value for Ok", func(t *testing.T) {
 		r := gofp.Ok("test")
@@ -593,3 +1060,82 @@ func TestResult_RecoverWith(t *testing.T) {
 		}
 	})
 }
+
+func TestResult_IsOkAnd(t *testing.T) {
+	errBoom := errors.New("boom")
+	positive := func(x int) bool { return x > 0 }
+
+	if !gofp.Ok(1).IsOkAnd(positive) {
+		t.Error("expected true for Ok satisfying predicate")
+	}
+	if gofp.Ok(-1).IsOkAnd(positive) {
+		t.Error("expected false for Ok not satisfying predicate")
+	}
+	if gofp.Err[int](errBoom).IsOkAnd(positive) {
+		t.Error("expected false for Err")
+	}
+
+	isBoom := func(err error) bool { return errors.Is(err, errBoom) }
+	if !gofp.Err[int](errBoom).IsErrAnd(isBoom) {
+		t.Error("expected true for Err satisfying predicate")
+	}
+	if gofp.Ok(1).IsErrAnd(isBoom) {
+		t.Error("expected false for Ok")
+	}
+}
+
+func TestResult_MapOr(t *testing.T) {
+	errBoom := errors.New("boom")
+	double := func(x int) int { return x * 2 }
+
+	if got := gofp.Ok(2).MapOr(0, double); got != 4 {
+		t.Errorf("expected 4, got %v", got)
+	}
+	if got := gofp.Err[int](errBoom).MapOr(-1, double); got != -1 {
+		t.Errorf("expected -1, got %v", got)
+	}
+	errLen := func(err error) int { return len(err.Error()) }
+	if got := gofp.Err[int](errBoom).MapOrElse(errLen, double); got != 4 {
+		t.Errorf("expected 4, got %v", got)
+	}
+}
+
+func TestResultMapOr(t *testing.T) {
+	errBoom := errors.New("boom")
+	length := func(s string) int { return len(s) }
+
+	if got := gofp.ResultMapOr(gofp.Ok("abc"), 0, length); got != 3 {
+		t.Errorf("expected 3, got %v", got)
+	}
+	if got := gofp.ResultMapOr(gofp.Err[string](errBoom), -1, length); got != -1 {
+		t.Errorf("expected -1, got %v", got)
+	}
+	got := gofp.ResultMapOrElse(gofp.Err[string](errBoom), func(err error) string { return err.Error() }, strings.ToUpper)
+	if got != "boom" {
+		t.Errorf("expected boom, got %v", got)
+	}
+}
+
+func TestResult_StackTrace(t *testing.T) {
+	t.Run("records the caller of Err", func(t *testing.T) {
+		r := gofp.Err[int](errors.New("boom"))
+		first, _, _ := strings.Cut(r.StackTrace(), "\n")
+		if !strings.Contains(first, "result_test.go") || !strings.HasSuffix(first, "TestResult_StackTrace.func1") {
+			t.Errorf("expected the first frame to be the test, got %q", first)
+		}
+	})
+
+	t.Run("is shared by derived results", func(t *testing.T) {
+		r := gofp.Err[int](errors.New("boom"))
+		mapped := gofp.ResultMap(r, strconv.Itoa).Wrap("context")
+		if mapped.StackTrace() != r.StackTrace() {
+			t.Errorf("expected %q, got %q", r.StackTrace(), mapped.StackTrace())
+		}
+	})
+
+	t.Run("is empty for Ok", func(t *testing.T) {
+		if got := gofp.Ok(1).StackTrace(); got != "" {
+			t.Errorf("expected empty stack trace, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package rws_test
+
+import (
+	"fmt"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/rws"
+)
+
+func ExampleRWS_Run() {
+	m := SliceMonoid[string]{}
+	step := rws.FlatMap(rws.Ask[Config, []string, int](m), func(cfg Config) Counter {
+		return rws.FlatMap(rws.Modify[Config](func(s int) int { return s + cfg.Step }, m), func(gofp.Unit) Counter {
+			return rws.Tell[Config, []string, int]([]string{fmt.Sprintf("added %d", cfg.Step)}, m)
+		})
+	})
+
+	_, state, output := step.Run(Config{Step: 5}, 10)
+	fmt.Println(state, output)
+	// Output:
+	// 15 [added 5]
+}
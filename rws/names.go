@@ -0,0 +1,20 @@
+package rws
+
+import "github.com/tomasbasham/gofp/writer"
+
+// Return lifts a value into an [RWS] computation. It is equivalent to [Pure].
+func Return[E, W, S, A any](a A, m writer.Monoid[W]) RWS[E, W, S, A] {
+	return Pure[E, W, S](a, m)
+}
+
+// Bind is equivalent to [FlatMap].
+func Bind[E, W, S, A, B any](r RWS[E, W, S, A], f func(A) RWS[E, W, S, B]) RWS[E, W, S, B] {
+	return FlatMap(r, f)
+}
+
+// Join flattens an [RWS] computation whose value is itself an [RWS]
+// computation, running the inner computation with the same environment and
+// the state left by the outer one.
+func Join[E, W, S, A any](r RWS[E, W, S, RWS[E, W, S, A]]) RWS[E, W, S, A] {
+	return FlatMap(r, func(inner RWS[E, W, S, A]) RWS[E, W, S, A] { return inner })
+}
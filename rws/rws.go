@@ -0,0 +1,140 @@
+// Package rws implements the Reader-Writer-State monad for computations that
+// combine all three effects.
+//
+// The [RWS] monad models computations that read from a shared environment,
+// accumulate output alongside their values, and thread state through a series
+// of steps. It avoids the deeply nested types that result from stacking the
+// reader, writer and state packages by hand.
+package rws
+
+import (
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+// RWS is a monad that models computations which read from an environment,
+// produce output and depend on some state.
+//
+// Type parameter E represents the environment type.
+// Type parameter W represents the output/log type, which must satisfy the
+// Monoid interface.
+// Type parameter S represents the state type.
+// Type parameter A represents the value type.
+type RWS[E, W, S, A any] struct {
+	g func(E, S) (A, S, W)
+
+	// Monoid is a type that can be combined with other values of the same type.
+	monoid writer.Monoid[W]
+}
+
+// Map applies a function to transform the value of an [RWS], while preserving
+// the output and state transitions.
+func (r RWS[E, W, S, A]) Map(f func(A) A) RWS[E, W, S, A] {
+	return Map(r, f)
+}
+
+// FlatMap composes two [RWS] computations by using the result of the first to
+// create the second. Both computations share the same environment, the state is
+// threaded through them sequentially, and their outputs are combined according
+// to the [writer.Monoid].
+func (r RWS[E, W, S, A]) FlatMap(f func(A) RWS[E, W, S, A]) RWS[E, W, S, A] {
+	return FlatMap(r, f)
+}
+
+// Run executes the [RWS] computation with the given environment and initial
+// state, and returns the value, the final state and the accumulated output.
+func (r RWS[E, W, S, A]) Run(env E, state S) (A, S, W) {
+	return r.g(env, state)
+}
+
+// New creates an [RWS] from a function.
+func New[E, W, S, A any](f func(E, S) (A, S, W), m writer.Monoid[W]) RWS[E, W, S, A] {
+	return RWS[E, W, S, A]{g: f, monoid: m}
+}
+
+// Pure lifts a value into an [RWS] computation with an empty output that
+// leaves the state unchanged.
+func Pure[E, W, S, A any](a A, m writer.Monoid[W]) RWS[E, W, S, A] {
+	return New(func(_ E, s S) (A, S, W) {
+		return a, s, m.Empty()
+	}, m)
+}
+
+// Ask returns an [RWS] computation that provides the environment.
+func Ask[E, W, S any](m writer.Monoid[W]) RWS[E, W, S, E] {
+	return New(func(e E, s S) (E, S, W) {
+		return e, s, m.Empty()
+	}, m)
+}
+
+// Asks returns an [RWS] computation that applies a function to the environment
+// to extract a value.
+func Asks[E, W, S, A any](f func(E) A, m writer.Monoid[W]) RWS[E, W, S, A] {
+	return New(func(e E, s S) (A, S, W) {
+		return f(e), s, m.Empty()
+	}, m)
+}
+
+// Tell returns an [RWS] computation that only produces output and returns
+// [gofp.Unit].
+func Tell[E, W, S any](w W, m writer.Monoid[W]) RWS[E, W, S, gofp.Unit] {
+	return New(func(_ E, s S) (gofp.Unit, S, W) {
+		return gofp.UnitValue, s, w
+	}, m)
+}
+
+// Get returns an [RWS] computation that provides the current state as its
+// value without modifying the state.
+func Get[E, W, S any](m writer.Monoid[W]) RWS[E, W, S, S] {
+	return New(func(_ E, s S) (S, S, W) {
+		return s, s, m.Empty()
+	}, m)
+}
+
+// Put returns an [RWS] computation that replaces the current state with the
+// given state and returns [gofp.Unit].
+func Put[E, W, S any](state S, m writer.Monoid[W]) RWS[E, W, S, gofp.Unit] {
+	return New(func(_ E, _ S) (gofp.Unit, S, W) {
+		return gofp.UnitValue, state, m.Empty()
+	}, m)
+}
+
+// Modify returns an [RWS] computation that transforms the current state using
+// the provided function and returns [gofp.Unit].
+func Modify[E, W, S any](f func(S) S, m writer.Monoid[W]) RWS[E, W, S, gofp.Unit] {
+	return New(func(_ E, s S) (gofp.Unit, S, W) {
+		return gofp.UnitValue, f(s), m.Empty()
+	}, m)
+}
+
+// Map applies a function to transform the value type of an [RWS], while
+// preserving the output and state transitions. Similar to the [RWS.Map] method
+// but allows changing the value type.
+func Map[E, W, S, A, B any](r RWS[E, W, S, A], f func(A) B) RWS[E, W, S, B] {
+	return New(func(e E, s S) (B, S, W) {
+		a, s1, w := r.g(e, s)
+		return f(a), s1, w
+	}, r.monoid)
+}
+
+// FlatMap composes two [RWS] computations by using the result of the first to
+// create the second. Similar to the [RWS.FlatMap] method but allows changing
+// the value type.
+func FlatMap[E, W, S, A, B any](r RWS[E, W, S, A], f func(A) RWS[E, W, S, B]) RWS[E, W, S, B] {
+	return New(func(e E, s S) (B, S, W) {
+		a, s1, w1 := r.g(e, s)
+		b, s2, w2 := f(a).g(e, s1)
+		return b, s2, r.monoid.Append(w1, w2)
+	}, r.monoid)
+}
+
+// Zip combines two [RWS] computations into one using a combining function.
+// Both computations are run sequentially with the same environment, the state
+// threaded through them and their outputs combined.
+func Zip[E, W, S, A, B, U any](ra RWS[E, W, S, A], rb RWS[E, W, S, B], f func(A, B) U) RWS[E, W, S, U] {
+	return FlatMap(ra, func(a A) RWS[E, W, S, U] {
+		return Map(rb, func(b B) U {
+			return f(a, b)
+		})
+	})
+}
@@ -0,0 +1,119 @@
+package rws_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/rws"
+)
+
+// Config is a test environment type.
+type Config struct {
+	Step int
+}
+
+// SliceMonoid implements the Monoid interface for slices.
+type SliceMonoid[T any] struct{}
+
+func (m SliceMonoid[T]) Empty() []T {
+	return []T{}
+}
+
+func (m SliceMonoid[T]) Append(a, b []T) []T {
+	return append(a, b...)
+}
+
+type Counter = rws.RWS[Config, []string, int, gofp.Unit]
+
+func TestPure(t *testing.T) {
+	r := rws.Pure[Config, []string, int](5, SliceMonoid[string]{})
+	value, state, output := r.Run(Config{}, 1)
+	if value != 5 || state != 1 || len(output) != 0 {
+		t.Errorf("expected (5, 1, []), got (%v, %v, %v)", value, state, output)
+	}
+}
+
+func TestAsk(t *testing.T) {
+	r := rws.Ask[Config, []string, int](SliceMonoid[string]{})
+	value, _, _ := r.Run(Config{Step: 2}, 0)
+	if value.Step != 2 {
+		t.Errorf("expected environment, got %v", value)
+	}
+}
+
+func TestTell(t *testing.T) {
+	r := rws.Tell[Config, []string, int]([]string{"log"}, SliceMonoid[string]{})
+	_, state, output := r.Run(Config{}, 1)
+	if state != 1 || !reflect.DeepEqual(output, []string{"log"}) {
+		t.Errorf("expected (1, [log]), got (%v, %v)", state, output)
+	}
+}
+
+func TestGetPut(t *testing.T) {
+	m := SliceMonoid[string]{}
+	r := rws.FlatMap(rws.Get[Config, []string, int](m), func(s int) rws.RWS[Config, []string, int, gofp.Unit] {
+		return rws.Put[Config](s*10, m)
+	})
+	_, state, _ := r.Run(Config{}, 2)
+	if state != 20 {
+		t.Errorf("expected state 20, got %v", state)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	m := SliceMonoid[string]{}
+	increment := rws.FlatMap(rws.Ask[Config, []string, int](m), func(cfg Config) Counter {
+		return rws.FlatMap(rws.Modify[Config](func(s int) int { return s + cfg.Step }, m), func(gofp.Unit) Counter {
+			return rws.Tell[Config, []string, int]([]string{"incremented"}, m)
+		})
+	})
+
+	twice := increment.FlatMap(func(gofp.Unit) Counter {
+		return increment
+	})
+
+	_, state, output := twice.Run(Config{Step: 3}, 1)
+	if state != 7 {
+		t.Errorf("expected state 7, got %v", state)
+	}
+
+	expectedOutput := []string{"incremented", "incremented"}
+	if !reflect.DeepEqual(output, expectedOutput) {
+		t.Errorf("expected %v, got %v", expectedOutput, output)
+	}
+}
+
+func TestZip(t *testing.T) {
+	m := SliceMonoid[string]{}
+	r := rws.Zip(
+		rws.Asks[Config, []string, int](func(c Config) int { return c.Step }, m),
+		rws.Get[Config, []string, int](m),
+		func(a, b int) int { return a + b },
+	)
+	value, _, _ := r.Run(Config{Step: 2}, 3)
+	if value != 5 {
+		t.Errorf("expected 5, got %v", value)
+	}
+}
+
+func TestBindReturnJoin(t *testing.T) {
+	m := SliceMonoid[string]{}
+	bound := rws.Bind(rws.Return[Config, []string, int](3, m), func(x int) rws.RWS[Config, []string, int, int] {
+		return rws.Asks[Config, []string, int](func(c Config) int { return x * c.Step }, m)
+	})
+	value, _, _ := bound.Run(Config{Step: 2}, 0)
+	if value != 6 {
+		t.Errorf("expected 6, got %v", value)
+	}
+
+	nested := rws.Map(rws.Tell[Config, []string, int]([]string{"outer"}, m), func(gofp.Unit) rws.RWS[Config, []string, int, int] {
+		return rws.FlatMap(rws.Tell[Config, []string, int]([]string{"inner"}, m), func(gofp.Unit) rws.RWS[Config, []string, int, int] {
+			return rws.Get[Config, []string, int](m)
+		})
+	})
+	value, state, output := rws.Join(nested).Run(Config{}, 5)
+	if value != 5 || state != 5 || !reflect.DeepEqual(output, []string{"outer", "inner"}) {
+		t.Errorf("expected (5, 5, [outer inner]), got (%v, %v, %v)", value, state, output)
+	}
+}
@@ -0,0 +1,36 @@
+package saga_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/saga"
+)
+
+func ExampleSaga_Run() {
+	reserve := saga.NewStep(
+		func(_ context.Context, item string) gofp.Result[string] {
+			fmt.Println("reserved", item)
+			return gofp.Ok(item)
+		},
+		func(_ context.Context, item string) error {
+			fmt.Println("released", item)
+			return nil
+		},
+	)
+	charge := saga.NewStep(
+		func(_ context.Context, _ string) gofp.Result[int] {
+			return gofp.Err[int](errors.New("card declined"))
+		},
+		nil,
+	)
+
+	r := saga.Then(saga.New(reserve), charge).Run(context.Background(), "book")
+	fmt.Println(r.UnwrapErr())
+	// Output:
+	// reserved book
+	// released book
+	// card declined
+}
@@ -0,0 +1,121 @@
+// Package saga implements the saga pattern for orchestrating multi-step
+// workflows with compensation on failure.
+//
+// A [Saga] is a sequence of steps, each of which performs some action and is
+// paired with a compensating action that undoes it. Should any step fail, the
+// compensating actions of all previously completed steps are run in reverse
+// order, leaving the system in a consistent state.
+package saga
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// Error is returned when a [Saga] fails. It carries the error that caused the
+// failure alongside any errors returned by the compensating actions.
+type Error struct {
+	// Err is the error returned by the step that failed.
+	Err error
+
+	// Compensations contains the errors returned by compensating actions, in the
+	// order they were run.
+	Compensations []error
+}
+
+func (e *Error) Error() string {
+	if len(e.Compensations) == 0 {
+		return e.Err.Error()
+	}
+
+	msgs := make([]string, len(e.Compensations))
+	for i, err := range e.Compensations {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%v (compensation errors: %s)", e.Err, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the error that caused the [Saga] to fail.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Step is a single unit of work within a [Saga]. It pairs an action with a
+// compensating action that undoes the effects of the action should a later
+// step fail.
+//
+// Type parameter A represents the input type.
+// Type parameter B represents the output type.
+type Step[A, B any] struct {
+	action     func(context.Context, A) gofp.Result[B]
+	compensate func(context.Context, B) error
+}
+
+// NewStep creates a [Step] from an action and a compensating action. The
+// compensating action receives the value produced by the action. A nil
+// compensating action indicates that the step requires no compensation.
+func NewStep[A, B any](action func(context.Context, A) gofp.Result[B], compensate func(context.Context, B) error) Step[A, B] {
+	return Step[A, B]{action: action, compensate: compensate}
+}
+
+type compensation func(context.Context) error
+
+// Saga is a sequence of [Step] values that are run in order, threading the
+// output of each step into the next.
+//
+// Type parameter A represents the input type.
+// Type parameter B represents the output type.
+type Saga[A, B any] struct {
+	g func(context.Context, A) (gofp.Result[B], []compensation)
+}
+
+// New creates a [Saga] from a single [Step].
+func New[A, B any](step Step[A, B]) Saga[A, B] {
+	return Then(Saga[A, A]{
+		g: func(_ context.Context, a A) (gofp.Result[A], []compensation) {
+			return gofp.Ok(a), nil
+		},
+	}, step)
+}
+
+// Then appends a [Step] to a [Saga]. The step is run with the output of the
+// saga only if every previous step succeeded.
+func Then[A, B, C any](s Saga[A, B], step Step[B, C]) Saga[A, C] {
+	return Saga[A, C]{
+		g: func(ctx context.Context, a A) (gofp.Result[C], []compensation) {
+			rb, comps := s.g(ctx, a)
+			rc := gofp.ResultFlatMap(rb, func(b B) gofp.Result[C] {
+				rc := step.action(ctx, b)
+				if c, ok := rc.TryUnwrap(); ok && step.compensate != nil {
+					comps = append(comps, func(ctx context.Context) error {
+						return step.compensate(ctx, c)
+					})
+				}
+				return rc
+			})
+			return rc, comps
+		},
+	}
+}
+
+// Run executes the [Saga] with the given input. If any step fails, the
+// compensating actions of all previously completed steps are run in reverse
+// order and the returned [gofp.Result] holds an [*Error] describing both the
+// original failure and any compensation failures.
+func (s Saga[A, B]) Run(ctx context.Context, a A) gofp.Result[B] {
+	r, comps := s.g(ctx, a)
+	if r.IsOk() {
+		return r
+	}
+
+	sagaErr := &Error{Err: r.UnwrapErr()}
+	for i := len(comps) - 1; i >= 0; i-- {
+		if err := comps[i](ctx); err != nil {
+			sagaErr.Compensations = append(sagaErr.Compensations, err)
+		}
+	}
+	return gofp.Err[B](sagaErr)
+}
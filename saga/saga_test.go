@@ -0,0 +1,111 @@
+package saga_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/saga"
+)
+
+func TestSaga_Run(t *testing.T) {
+	t.Run("runs all steps in order", func(t *testing.T) {
+		var calls []string
+		s := saga.Then(
+			saga.New(saga.NewStep(
+				func(_ context.Context, x int) gofp.Result[int] {
+					calls = append(calls, "double")
+					return gofp.Ok(x * 2)
+				},
+				nil,
+			)),
+			saga.NewStep(
+				func(_ context.Context, x int) gofp.Result[string] {
+					calls = append(calls, "format")
+					return gofp.Ok("value")
+				},
+				nil,
+			),
+		)
+
+		got := s.Run(context.Background(), 5)
+		if got.Unwrap() != "value" {
+			t.Errorf("expected value, got %v", got)
+		}
+		if !reflect.DeepEqual(calls, []string{"double", "format"}) {
+			t.Errorf("expected steps to run in order, got %v", calls)
+		}
+	})
+
+	t.Run("compensates completed steps in reverse order", func(t *testing.T) {
+		var compensated []int
+		step := func(n int) saga.Step[int, int] {
+			return saga.NewStep(
+				func(_ context.Context, x int) gofp.Result[int] {
+					return gofp.Ok(x + n)
+				},
+				func(_ context.Context, x int) error {
+					compensated = append(compensated, x)
+					return nil
+				},
+			)
+		}
+
+		errFailed := errors.New("failed")
+		s := saga.Then(saga.Then(saga.New(step(1)), step(10)), saga.NewStep(
+			func(_ context.Context, _ int) gofp.Result[int] {
+				return gofp.Err[int](errFailed)
+			},
+			func(_ context.Context, _ int) error {
+				t.Error("expected failing step not to be compensated")
+				return nil
+			},
+		))
+
+		got := s.Run(context.Background(), 0)
+		if !got.IsErr() {
+			t.Fatal("expected Err")
+		}
+		if !errors.Is(got.UnwrapErr(), errFailed) {
+			t.Errorf("expected error to wrap %v, got %v", errFailed, got.UnwrapErr())
+		}
+		if !reflect.DeepEqual(compensated, []int{11, 1}) {
+			t.Errorf("expected compensations [11 1], got %v", compensated)
+		}
+	})
+
+	t.Run("collects compensation errors", func(t *testing.T) {
+		errCompensate := errors.New("compensate failed")
+		errFailed := errors.New("failed")
+		s := saga.Then(
+			saga.New(saga.NewStep(
+				func(_ context.Context, x int) gofp.Result[int] {
+					return gofp.Ok(x)
+				},
+				func(_ context.Context, _ int) error {
+					return errCompensate
+				},
+			)),
+			saga.NewStep(
+				func(_ context.Context, _ int) gofp.Result[int] {
+					return gofp.Err[int](errFailed)
+				},
+				nil,
+			),
+		)
+
+		got := s.Run(context.Background(), 0)
+		var sagaErr *saga.Error
+		if !errors.As(got.UnwrapErr(), &sagaErr) {
+			t.Fatalf("expected *saga.Error, got %T", got.UnwrapErr())
+		}
+		if sagaErr.Err != errFailed {
+			t.Errorf("expected original error %v, got %v", errFailed, sagaErr.Err)
+		}
+		if len(sagaErr.Compensations) != 1 || sagaErr.Compensations[0] != errCompensate {
+			t.Errorf("expected compensation error %v, got %v", errCompensate, sagaErr.Compensations)
+		}
+	})
+}
@@ -0,0 +1,181 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// ErrInvalidCron is wrapped by the error returned when parsing a malformed
+// cron expression.
+var ErrInvalidCron = errors.New("invalid cron expression")
+
+// descriptors are the named schedules accepted in place of five fields.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField describes the range of one of the five fields of a cron
+// expression.
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// cron is a [Schedule] parsed from a cron expression. Each field is a bit set
+// of the values it matches.
+type cron struct {
+	minute, hour, dom, month, dow uint64
+
+	// domAny and dowAny record whether the day fields began with *, since a
+	// day matches either field only when both are restricted.
+	domAny, dowAny bool
+}
+
+// maxSearch bounds the search for the next activation of a cron expression
+// that may never match, such as one for the 30th of February.
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+// Cron parses a standard five field cron expression, giving the minute, hour,
+// day of month, month and day of week on which to run. Each field may be *, a
+// value, a range such as 1-5, or a list of these separated by commas, and
+// values and ranges may be followed by a step such as */15. Day of week 0 and
+// 7 are both Sunday. The descriptors @yearly, @monthly, @weekly, @daily and
+// @hourly are also accepted.
+//
+// As with cron, when both the day of month and day of week are restricted, a
+// day matches if either does. Times are matched in the location of the time
+// passed to Next.
+func Cron(expr string) gofp.Result[Schedule] {
+	if d, ok := descriptors[strings.TrimSpace(expr)]; ok {
+		expr = d
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return gofp.Err[Schedule](fmt.Errorf("%w %q: expected 5 fields, got %d", ErrInvalidCron, expr, len(fields)))
+	}
+
+	var sets [5]uint64
+	for i, f := range fields {
+		set, err := parseCronField(f, cronFields[i])
+		if err != nil {
+			return gofp.Err[Schedule](fmt.Errorf("%w %q: %s: %v", ErrInvalidCron, expr, cronFields[i].name, err))
+		}
+		sets[i] = set
+	}
+
+	// Sunday may be written as either 0 or 7.
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+
+	return gofp.Ok[Schedule](cron{
+		minute: sets[0],
+		hour:   sets[1],
+		dom:    sets[2],
+		month:  sets[3],
+		dow:    sets[4],
+		domAny: strings.HasPrefix(fields[2], "*"),
+		dowAny: strings.HasPrefix(fields[4], "*"),
+	})
+}
+
+func parseCronField(field string, f cronField) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepStr)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepStr)
+			}
+			step = n
+		}
+
+		lo, hi := f.min, f.max
+		if rng != "*" {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = parseCronValue(loStr, f); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = parseCronValue(hiStr, f); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				hi = f.max
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q", rng)
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+func parseCronValue(s string, f cronField) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %q out of range %d-%d", s, f.min, f.max)
+	}
+	return n, nil
+}
+
+// Next returns the first time after t matching the expression, or the zero
+// time if there is none within five years.
+func (c cron) Next(t time.Time) time.Time {
+	limit := t.Add(maxSearch)
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (c cron) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
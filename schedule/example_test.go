@@ -0,0 +1,35 @@
+package schedule_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/schedule"
+	"github.com/tomasbasham/gofp/sim"
+)
+
+func ExampleRun() {
+	// Report every weekday at half past nine.
+	weekdays := schedule.Cron("30 9 * * 1-5").Unwrap()
+
+	report := func(context.Context) gofp.Result[gofp.Unit] {
+		return gofp.Ok(gofp.UnitValue)
+	}
+
+	clock := sim.NewVirtualClock(time.Date(2024, 1, 5, 12, 0, 0, 0, time.UTC))
+	_, runs := schedule.Run(context.Background(), report, weekdays,
+		schedule.WithClock(clock),
+		schedule.WithOverlap(schedule.Wait),
+		schedule.WithRuns(3),
+	).Run()
+
+	for _, run := range runs {
+		fmt.Printf("run %d at %s: ok=%t\n", run.N, run.Scheduled.Format("Mon 2006-01-02 15:04"), run.Result.IsOk())
+	}
+	// Output:
+	// run 1 at Mon 2024-01-08 09:30: ok=true
+	// run 2 at Tue 2024-01-09 09:30: ok=true
+	// run 3 at Wed 2024-01-10 09:30: ok=true
+}
@@ -0,0 +1,206 @@
+// Package schedule runs jobs periodically according to a [Schedule].
+//
+// A [Job] is a function returning a [gofp.Result], so periodic work is written
+// in the same terms as the rest of a pipeline. [Run] returns a
+// [writer.Writer] computation that, when run, runs the job at each time given
+// by its schedule until its context is done, recording every run, and every
+// run that was skipped, in its output. Schedules are either fixed intervals,
+// created with [Every], or cron expressions, parsed with [Cron].
+//
+// Ticks are scheduled by a [sim.Clock], so that a schedule may be tested with
+// a [sim.VirtualClock] without waiting for it in real time.
+package schedule
+
+import (
+	"cmp"
+	"context"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/monoid"
+	"github.com/tomasbasham/gofp/sim"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+// Job is a unit of periodic work.
+type Job func(context.Context) gofp.Result[gofp.Unit]
+
+// Schedule determines when a job runs.
+type Schedule interface {
+	// Next returns the first time after t at which the job should run, or the
+	// zero time if it should not run again.
+	Next(t time.Time) time.Time
+}
+
+type every time.Duration
+
+// Every returns a [Schedule] that runs a job at a fixed interval, starting one
+// interval after the schedule starts.
+func Every(d time.Duration) Schedule {
+	return every(d)
+}
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e))
+}
+
+// Overlap determines what happens when a job is due to run whilst its previous
+// run has not finished.
+type Overlap int
+
+const (
+	// Skip does not run the job, and records the run as skipped.
+	Skip Overlap = iota
+
+	// Wait runs the job once its previous run has finished. Since runs are
+	// never concurrent, the next run is scheduled from when it finishes.
+	Wait
+
+	// Allow runs the job concurrently with its previous run.
+	Allow
+)
+
+// Record describes a single scheduled run of a job.
+type Record struct {
+	// N is the number of the run, starting at one.
+	N int
+
+	// Scheduled is the time at which the run was due, including any jitter.
+	Scheduled time.Time
+
+	// Duration is the time the job took to run.
+	Duration time.Duration
+
+	// Skipped reports whether the run was skipped because the previous run had
+	// not finished.
+	Skipped bool
+
+	// Result is the result of the job, which is Ok if the run was skipped.
+	Result gofp.Result[gofp.Unit]
+}
+
+// Option configures how a job is run.
+type Option func(*config)
+
+type config struct {
+	clock   sim.Clock
+	jitter  time.Duration
+	overlap Overlap
+	runs    int
+}
+
+// WithClock sets the [sim.Clock] used to schedule runs. It defaults to
+// [sim.RealClock].
+func WithClock(c sim.Clock) Option {
+	return func(cfg *config) {
+		cfg.clock = c
+	}
+}
+
+// WithJitter delays each run by a random duration of up to d, so that jobs
+// scheduled for the same time on many instances do not all run at once.
+func WithJitter(d time.Duration) Option {
+	return func(cfg *config) {
+		cfg.jitter = d
+	}
+}
+
+// WithOverlap sets what happens when a job is due whilst its previous run has
+// not finished. It defaults to [Skip].
+func WithOverlap(o Overlap) Option {
+	return func(cfg *config) {
+		cfg.overlap = o
+	}
+}
+
+// WithRuns limits the job to n scheduled runs, including skipped runs. A job
+// without a limit runs until its context is done or its schedule ends.
+func WithRuns(n int) Option {
+	return func(cfg *config) {
+		cfg.runs = n
+	}
+}
+
+// Run returns a [writer.Writer] computation that, when run, runs the job at
+// each time given by the schedule, and whose output holds a [Record] of every
+// run in order. The computation finishes once the number of runs given by
+// [WithRuns] have been scheduled, the schedule ends, or ctx is done, and waits
+// for any runs still in progress. An Err returned by the job is recorded and
+// does not stop the schedule.
+func Run(ctx context.Context, job Job, s Schedule, opts ...Option) writer.Writer[[]Record, gofp.Unit] {
+	cfg := config{clock: sim.RealClock{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return writer.New(func() (gofp.Unit, []Record) {
+		var (
+			mu      sync.Mutex
+			records []Record
+			wg      sync.WaitGroup
+			busy    = make(chan struct{}, 1)
+		)
+		record := func(r Record) {
+			mu.Lock()
+			defer mu.Unlock()
+			records = append(records, r)
+		}
+		run := func(n int, scheduled time.Time) {
+			start := cfg.clock.Now()
+			r := job(ctx)
+			record(Record{N: n, Scheduled: scheduled, Duration: cfg.clock.Now().Sub(start), Result: r})
+		}
+
+		for n := 1; cfg.runs <= 0 || n <= cfg.runs; n++ {
+			if ctx.Err() != nil {
+				break
+			}
+
+			next := s.Next(cfg.clock.Now())
+			if next.IsZero() {
+				break
+			}
+			if cfg.jitter > 0 {
+				next = next.Add(rand.N(cfg.jitter))
+			}
+
+			select {
+			case <-ctx.Done():
+			case <-cfg.clock.After(next.Sub(cfg.clock.Now())):
+			}
+			if ctx.Err() != nil {
+				break
+			}
+
+			switch cfg.overlap {
+			case Wait:
+				run(n, next)
+			case Allow:
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					run(n, next)
+				}()
+			default:
+				select {
+				case busy <- struct{}{}:
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						defer func() { <-busy }()
+						run(n, next)
+					}()
+				default:
+					record(Record{N: n, Scheduled: next, Skipped: true, Result: gofp.Ok(gofp.UnitValue)})
+				}
+			}
+		}
+
+		wg.Wait()
+		slices.SortFunc(records, func(a, b Record) int { return cmp.Compare(a.N, b.N) })
+		return gofp.UnitValue, records
+	}, monoid.Slice[Record]{})
+}
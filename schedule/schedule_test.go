@@ -0,0 +1,269 @@
+package schedule_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+	"github.com/tomasbasham/gofp/schedule"
+	"github.com/tomasbasham/gofp/sim"
+)
+
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) // A Monday.
+
+func ok(context.Context) gofp.Result[gofp.Unit] {
+	return gofp.Ok(gofp.UnitValue)
+}
+
+func TestCron(t *testing.T) {
+	tests := map[string]struct {
+		expr  string
+		after time.Time
+		want  []time.Time
+	}{
+		"every minute": {
+			expr:  "* * * * *",
+			after: start.Add(30 * time.Second),
+			want:  []time.Time{start.Add(time.Minute), start.Add(2 * time.Minute)},
+		},
+		"step": {
+			expr:  "*/15 * * * *",
+			after: start,
+			want:  []time.Time{start.Add(15 * time.Minute), start.Add(30 * time.Minute)},
+		},
+		"list and range": {
+			expr:  "0 9-10,17 * * *",
+			after: start,
+			want: []time.Time{
+				start.Add(9 * time.Hour),
+				start.Add(10 * time.Hour),
+				start.Add(17 * time.Hour),
+				start.Add(33 * time.Hour),
+			},
+		},
+		"day of week with sunday as 7": {
+			expr:  "30 8 * * 6-7",
+			after: start,
+			want: []time.Time{
+				time.Date(2024, 1, 6, 8, 30, 0, 0, time.UTC),
+				time.Date(2024, 1, 7, 8, 30, 0, 0, time.UTC),
+				time.Date(2024, 1, 13, 8, 30, 0, 0, time.UTC),
+			},
+		},
+		"day of month or day of week": {
+			expr:  "0 0 15 * 5",
+			after: start,
+			want: []time.Time{
+				time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"descriptor": {
+			expr:  "@monthly",
+			after: start,
+			want: []time.Time{
+				time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"leap day": {
+			expr:  "0 0 29 2 *",
+			after: start,
+			want: []time.Time{
+				time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+				time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"never": {
+			expr:  "0 0 30 2 *",
+			after: start,
+			want:  []time.Time{{}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := assert.Ok(t, schedule.Cron(tt.expr))
+			next := tt.after
+			for _, want := range tt.want {
+				next = s.Next(next)
+				if !next.Equal(want) {
+					t.Fatalf("expected %v, got %v", want, next)
+				}
+			}
+		})
+	}
+}
+
+func TestCron_invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@often",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			assert.ErrIs(t, schedule.Cron(expr), schedule.ErrInvalidCron)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("runs the job on schedule", func(t *testing.T) {
+		clock := sim.NewVirtualClock(start)
+		var calls atomic.Int32
+		job := func(context.Context) gofp.Result[gofp.Unit] {
+			if calls.Add(1) == 2 {
+				return gofp.Err[gofp.Unit](errors.New("boom"))
+			}
+			return gofp.Ok(gofp.UnitValue)
+		}
+
+		_, runs := schedule.Run(context.Background(), job, schedule.Every(time.Hour),
+			schedule.WithClock(clock),
+			schedule.WithOverlap(schedule.Wait),
+			schedule.WithRuns(3),
+		).Run()
+
+		if len(runs) != 3 {
+			t.Fatalf("expected 3 runs, got %d", len(runs))
+		}
+		for i, run := range runs {
+			if run.N != i+1 || run.Skipped {
+				t.Errorf("unexpected run %+v", run)
+			}
+			if want := start.Add(time.Duration(i+1) * time.Hour); !run.Scheduled.Equal(want) {
+				t.Errorf("expected run at %v, got %v", want, run.Scheduled)
+			}
+		}
+		assert.Ok(t, runs[0].Result)
+		assert.Err(t, runs[1].Result)
+		assert.Ok(t, runs[2].Result)
+	})
+
+	t.Run("stops when the schedule ends", func(t *testing.T) {
+		s := assert.Ok(t, schedule.Cron("0 0 30 2 *"))
+		_, runs := schedule.Run(context.Background(), ok, s, schedule.WithClock(sim.NewVirtualClock(start))).Run()
+		if len(runs) != 0 {
+			t.Errorf("expected no runs, got %d", len(runs))
+		}
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var calls atomic.Int32
+		job := func(context.Context) gofp.Result[gofp.Unit] {
+			if calls.Add(1) == 4 {
+				cancel()
+			}
+			return gofp.Ok(gofp.UnitValue)
+		}
+
+		_, runs := schedule.Run(ctx, job, schedule.Every(time.Minute),
+			schedule.WithClock(sim.NewVirtualClock(start)),
+			schedule.WithOverlap(schedule.Wait),
+		).Run()
+		if len(runs) != 4 {
+			t.Errorf("expected 4 runs, got %d", len(runs))
+		}
+	})
+
+	t.Run("skips runs that overlap", func(t *testing.T) {
+		release := make(chan struct{})
+		job := func(context.Context) gofp.Result[gofp.Unit] {
+			<-release
+			return gofp.Ok(gofp.UnitValue)
+		}
+
+		var calls atomic.Int32
+		s := scheduleFunc(func(t time.Time) time.Time {
+			if calls.Add(1) == 3 {
+				close(release)
+			}
+			return t.Add(time.Millisecond)
+		})
+
+		_, runs := schedule.Run(context.Background(), job, s, schedule.WithRuns(3)).Run()
+		if len(runs) != 3 {
+			t.Fatalf("expected 3 runs, got %d", len(runs))
+		}
+		if runs[0].Skipped || !runs[1].Skipped {
+			t.Errorf("expected only the second run to be skipped, got %+v", runs)
+		}
+	})
+
+	t.Run("allows runs that overlap", func(t *testing.T) {
+		var running, peak atomic.Int32
+		release := make(chan struct{})
+		job := func(context.Context) gofp.Result[gofp.Unit] {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			<-release
+			running.Add(-1)
+			return gofp.Ok(gofp.UnitValue)
+		}
+
+		var calls atomic.Int32
+		s := scheduleFunc(func(t time.Time) time.Time {
+			if calls.Add(1) == 3 {
+				// Give the first two runs time to start before releasing them.
+				time.Sleep(10 * time.Millisecond)
+				close(release)
+			}
+			return t.Add(time.Millisecond)
+		})
+
+		_, runs := schedule.Run(context.Background(), job, s,
+			schedule.WithOverlap(schedule.Allow),
+			schedule.WithRuns(3),
+		).Run()
+		if len(runs) != 3 {
+			t.Fatalf("expected 3 runs, got %d", len(runs))
+		}
+		if peak.Load() < 2 {
+			t.Errorf("expected runs to overlap, got a peak of %d", peak.Load())
+		}
+	})
+
+	t.Run("delays runs by jitter", func(t *testing.T) {
+		_, runs := schedule.Run(context.Background(), ok, schedule.Every(time.Hour),
+			schedule.WithClock(sim.NewVirtualClock(start)),
+			schedule.WithOverlap(schedule.Wait),
+			schedule.WithJitter(time.Minute),
+			schedule.WithRuns(20),
+		).Run()
+
+		prev := start
+		for _, run := range runs {
+			delay := run.Scheduled.Sub(prev.Add(time.Hour))
+			if delay < 0 || delay >= time.Minute {
+				t.Errorf("expected jitter within a minute, got %v", delay)
+			}
+			prev = run.Scheduled
+		}
+	})
+}
+
+type scheduleFunc func(time.Time) time.Time
+
+func (f scheduleFunc) Next(t time.Time) time.Time {
+	return f(t)
+}
@@ -0,0 +1,51 @@
+package gofp
+
+import "encoding/json"
+
+const redacted = "[REDACTED]"
+
+// Sensitive wraps a value that must not appear in logs or other output. It is
+// formatted as "[REDACTED]" by the fmt package, [DebugString] and the String
+// methods of [Option], [Result] and [Either], and is marshalled to JSON as the
+// string "[REDACTED]". The raw value is only available through
+// [Sensitive.Unwrap].
+//
+// Type parameter T represents the value type.
+type Sensitive[T any] struct {
+	value T
+}
+
+// NewSensitive returns a [Sensitive] wrapping the given value.
+func NewSensitive[T any](value T) Sensitive[T] {
+	return Sensitive[T]{value: value}
+}
+
+// Unwrap returns the raw value of the [Sensitive].
+func (s Sensitive[T]) Unwrap() T {
+	return s.value
+}
+
+func (s Sensitive[T]) String() string {
+	return redacted
+}
+
+// GoString returns "[REDACTED]" so that the value is hidden from the %#v verb.
+func (s Sensitive[T]) GoString() string {
+	return redacted
+}
+
+func (s Sensitive[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redacted)
+}
+
+func (s *Sensitive[T]) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &s.value)
+}
+
+func (s Sensitive[T]) sensitive() {}
+
+// sensitiveValue is implemented by all instantiations of [Sensitive], allowing
+// them to be detected by reflection even when held in unexported fields.
+type sensitiveValue interface {
+	sensitive()
+}
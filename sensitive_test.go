@@ -0,0 +1,67 @@
+package gofp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+type databaseConfig struct {
+	Host     string
+	password gofp.Sensitive[string]
+}
+
+func TestSensitive_Unwrap(t *testing.T) {
+	s := gofp.NewSensitive("hunter2")
+	if s.Unwrap() != "hunter2" {
+		t.Errorf("expected hunter2, got %q", s.Unwrap())
+	}
+}
+
+func TestSensitive_String(t *testing.T) {
+	s := gofp.NewSensitive("hunter2")
+	for _, verb := range []string{"%v", "%s", "%+v", "%#v"} {
+		if got := fmt.Sprintf(verb, s); got != "[REDACTED]" {
+			t.Errorf("%s: expected [REDACTED], got %q", verb, got)
+		}
+	}
+}
+
+func TestSensitive_MarshalJSON(t *testing.T) {
+	got, err := json.Marshal(struct {
+		Password gofp.Sensitive[string]
+	}{gofp.NewSensitive("hunter2")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != `{"Password":"[REDACTED]"}` {
+		t.Errorf("unexpected JSON %s", got)
+	}
+}
+
+func TestSensitive_UnmarshalJSON(t *testing.T) {
+	var s gofp.Sensitive[string]
+	if err := json.Unmarshal([]byte(`"hunter2"`), &s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.Unwrap() != "hunter2" {
+		t.Errorf("expected hunter2, got %q", s.Unwrap())
+	}
+}
+
+func TestSensitive_InContainers(t *testing.T) {
+	cfg := databaseConfig{Host: "localhost", password: gofp.NewSensitive("hunter2")}
+	want := "{localhost [REDACTED]}"
+
+	if got := gofp.Ok(cfg).String(); got != "Ok("+want+")" {
+		t.Errorf("unexpected Result string %q", got)
+	}
+	if got := gofp.Some(cfg).String(); got != "Some("+want+")" {
+		t.Errorf("unexpected Option string %q", got)
+	}
+	if got := gofp.Left[databaseConfig, int](cfg).String(); got != "Left("+want+")" {
+		t.Errorf("unexpected Either string %q", got)
+	}
+}
@@ -0,0 +1,202 @@
+package gofp
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Show is a type class for types whose values can be formatted as a
+// human-readable string. Registering a Show instance with [RegisterShow]
+// changes how values of the type are formatted by [DebugString] and the String
+// methods of [Option], [Result] and [Either], which is useful for hiding
+// sensitive data from logs.
+//
+// Type parameter T represents the value type.
+type Show[T any] interface {
+	Show(T) string
+}
+
+// ShowFunc is an adapter that allows the use of an ordinary function as a
+// [Show] instance.
+type ShowFunc[T any] func(T) string
+
+// Show returns f(v).
+func (f ShowFunc[T]) Show(v T) string {
+	return f(v)
+}
+
+// ShowBy derives a [Show] instance for type T by converting values to type U
+// and formatting them with [DebugString].
+func ShowBy[T, U any](fn func(T) U) Show[T] {
+	return ShowFunc[T](func(v T) string {
+		return DebugString(fn(v))
+	})
+}
+
+var (
+	shows         sync.Map // map[reflect.Type]func(reflect.Value) string
+	sensitiveType = reflect.TypeOf((*sensitiveValue)(nil)).Elem()
+)
+
+// RegisterShow registers a [Show] instance for type T, replacing any
+// previously registered instance.
+func RegisterShow[T any](s Show[T]) {
+	shows.Store(typeOf[T](), func(v reflect.Value) string {
+		return s.Show(v.Interface().(T))
+	})
+}
+
+// DebugString formats a value using the [Show] instance registered for its
+// type. Values of types without a registered instance are formatted as with
+// the %v verb, except that any nested struct fields, slice elements, map
+// entries and pointers are themselves formatted using registered instances.
+// [Sensitive] values are always formatted as "[REDACTED]", even when held in
+// unexported struct fields. A value held in an unexported struct field cannot
+// be passed to the instance registered for its type, so it is also formatted
+// as "[REDACTED]" rather than having its contents shown.
+func DebugString[T any](v T) string {
+	return showValue(reflect.ValueOf(&v).Elem(), 0)
+}
+
+func showValue(v reflect.Value, depth int) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+	if v.Type().Implements(sensitiveType) {
+		return redacted
+	}
+	if fn, ok := shows.Load(v.Type()); ok {
+		if !v.CanInterface() {
+			return redacted
+		}
+		return fn.(func(reflect.Value) string)(v)
+	}
+	if v.CanInterface() {
+		switch v.Interface().(type) {
+		case error, fmt.Stringer:
+			return fmt.Sprintf("%v", v)
+		}
+	}
+
+	var sb strings.Builder
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return "<nil>"
+		}
+		return showValue(v.Elem(), depth)
+	case reflect.Pointer:
+		if depth > 0 || v.IsNil() {
+			return fmt.Sprintf("%v", v)
+		}
+		switch v.Elem().Kind() {
+		case reflect.Array, reflect.Slice, reflect.Struct, reflect.Map:
+			return "&" + showValue(v.Elem(), depth+1)
+		}
+		return fmt.Sprintf("%v", v)
+	case reflect.Struct:
+		sb.WriteByte('{')
+		for i := 0; i < v.NumField(); i++ {
+			if i > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(showValue(v.Field(i), depth+1))
+		}
+		sb.WriteByte('}')
+		return sb.String()
+	case reflect.Array, reflect.Slice:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("%v", v)
+		}
+		sb.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(showValue(v.Index(i), depth+1))
+		}
+		sb.WriteByte(']')
+		return sb.String()
+	case reflect.Map:
+		keys := v.MapKeys()
+		slices.SortStableFunc(keys, compareKeys)
+		sb.WriteString("map[")
+		for i, k := range keys {
+			if i > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(showValue(k, depth+1))
+			sb.WriteByte(':')
+			sb.WriteString(showValue(v.MapIndex(k), depth+1))
+		}
+		sb.WriteByte(']')
+		return sb.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// compareKeys orders map keys in the same way as the fmt package, so that maps
+// are formatted as they would be with the %v verb. Numbers, strings and
+// booleans are ordered by value, pointers and channels by address, and
+// structs and arrays element by element. Interface values are ordered first by
+// the name of their dynamic type, with nil first, and then by value.
+func compareKeys(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	case reflect.Complex64, reflect.Complex128:
+		if c := cmp.Compare(real(a.Complex()), real(b.Complex())); c != 0 {
+			return c
+		}
+		return cmp.Compare(imag(a.Complex()), imag(b.Complex()))
+	case reflect.Bool:
+		switch {
+		case a.Bool() == b.Bool():
+			return 0
+		case a.Bool():
+			return 1
+		}
+		return -1
+	case reflect.Pointer, reflect.UnsafePointer, reflect.Chan:
+		return cmp.Compare(a.Pointer(), b.Pointer())
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if c := compareKeys(a.Field(i), b.Field(i)); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if c := compareKeys(a.Index(i), b.Index(i)); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case reflect.Interface:
+		switch {
+		case a.IsNil() && b.IsNil():
+			return 0
+		case a.IsNil():
+			return -1
+		case b.IsNil():
+			return 1
+		}
+		if c := cmp.Compare(a.Elem().Type().String(), b.Elem().Type().String()); c != 0 {
+			return c
+		}
+		return compareKeys(a.Elem(), b.Elem())
+	}
+	return 0
+}
@@ -0,0 +1,78 @@
+package gofp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+type secret string
+
+type credentials struct {
+	User     string
+	Password secret
+}
+
+type token string
+
+type session struct {
+	User  string
+	token token
+}
+
+func init() {
+	gofp.RegisterShow(gofp.ShowFunc[secret](func(secret) string {
+		return "[REDACTED]"
+	}))
+	gofp.RegisterShow(gofp.ShowFunc[token](func(t token) string {
+		return string(t[:4]) + "..."
+	}))
+}
+
+func TestDebugString(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"registered type", secret("hunter2"), "[REDACTED]"},
+		{"unregistered type", 42, "42"},
+		{"nested in struct", credentials{"alice", "hunter2"}, "{alice [REDACTED]}"},
+		{"pointer to struct", &credentials{"alice", "hunter2"}, "&{alice [REDACTED]}"},
+		{"nested in slice", []secret{"a", "b"}, "[[REDACTED] [REDACTED]]"},
+		{"nested in map", map[string]secret{"b": "x", "a": "y"}, "map[a:[REDACTED] b:[REDACTED]]"},
+		{"numeric map keys", map[int]string{2: "a", 10: "b", 1: "c"}, "map[1:c 2:a 10:b]"},
+		{"redacted map keys", map[secret]int{"b": 1}, "map[[REDACTED]:1]"},
+		{"exported registered field", struct{ T token }{"abcd1234"}, "{abcd...}"},
+		{"unexported registered field", session{"alice", "abcd1234"}, "{alice [REDACTED]}"},
+		{"error", errors.New("failed"), "failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gofp.DebugString(tt.value); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestShowBy(t *testing.T) {
+	s := gofp.ShowBy(func(c credentials) string { return c.User })
+	if got := s.Show(credentials{"alice", "hunter2"}); got != "alice" {
+		t.Errorf("expected alice, got %q", got)
+	}
+}
+
+func TestString_UsesRegisteredShow(t *testing.T) {
+	c := credentials{"alice", "hunter2"}
+	if got := gofp.Some(c).String(); got != "Some({alice [REDACTED]})" {
+		t.Errorf("unexpected Option string %q", got)
+	}
+	if got := gofp.Ok(c).String(); got != "Ok({alice [REDACTED]})" {
+		t.Errorf("unexpected Result string %q", got)
+	}
+	if got := gofp.Right[error](c).String(); got != "Right({alice [REDACTED]})" {
+		t.Errorf("unexpected Either string %q", got)
+	}
+}
@@ -0,0 +1,34 @@
+package sim_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tomasbasham/gofp/sim"
+	"github.com/tomasbasham/gofp/state"
+)
+
+func ExampleRun() {
+	// Each tick a population grows by a tenth.
+	grow := state.Update(func(population int) (int, int) {
+		births := population / 10
+		return births, population + births
+	})
+
+	clock := sim.NewVirtualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	final, ticks := sim.Run(context.Background(), grow, 1000, 24*time.Hour,
+		sim.WithClock(clock),
+		sim.WithTicks(3),
+	).Run()
+
+	for _, tick := range ticks {
+		fmt.Printf("%s: %d births, population %d\n", tick.Time.Format(time.DateOnly), tick.Value, tick.State)
+	}
+	fmt.Println("final:", final)
+	// Output:
+	// 2024-01-02: 100 births, population 1100
+	// 2024-01-03: 110 births, population 1210
+	// 2024-01-04: 121 births, population 1331
+	// final: 1331
+}
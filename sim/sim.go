@@ -0,0 +1,153 @@
+// Package sim runs [state.State] step functions on a tick schedule.
+//
+// A simulation repeatedly runs a single step computation, threading the state
+// from one tick to the next, and records the value and state produced at every
+// tick. Ticks are scheduled by a [Clock], which is either the real wall clock,
+// for long-lived processes, or a [VirtualClock] that advances instantly, for
+// tests and simulations that should run as fast as possible.
+package sim
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tomasbasham/gofp/monoid"
+	"github.com/tomasbasham/gofp/state"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+// Clock schedules the ticks of a simulation.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current time on
+	// the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// RealClock is a [Clock] backed by the system wall clock.
+type RealClock struct{}
+
+// Now returns the current local time.
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// After waits for the duration to elapse using [time.After].
+func (RealClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// VirtualClock is a [Clock] whose time only moves when a tick is scheduled.
+// Each call to After advances the clock by the given duration and fires
+// immediately, so a simulation runs without waiting.
+type VirtualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewVirtualClock returns a [VirtualClock] starting at the given time.
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{now: start}
+}
+
+// Now returns the current virtual time.
+func (c *VirtualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After advances the virtual time by d and returns a channel holding the new
+// time.
+func (c *VirtualClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	now := c.now
+	c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	ch <- now
+	return ch
+}
+
+// Tick records the outcome of a single step of a simulation.
+//
+// Type parameter S represents the state type.
+// Type parameter A represents the value type.
+type Tick[S, A any] struct {
+	// N is the number of the tick, starting at one.
+	N int
+
+	// Time is the time at which the tick ran.
+	Time time.Time
+
+	// Value is the value produced by the step.
+	Value A
+
+	// State is the state after the step.
+	State S
+}
+
+// Option configures a simulation.
+type Option func(*config)
+
+type config struct {
+	clock Clock
+	ticks int
+}
+
+// WithClock sets the [Clock] used to schedule ticks. It defaults to
+// [RealClock].
+func WithClock(c Clock) Option {
+	return func(cfg *config) {
+		cfg.clock = c
+	}
+}
+
+// WithTicks limits the simulation to n ticks. A simulation without a limit
+// runs until its context is done.
+func WithTicks(n int) Option {
+	return func(cfg *config) {
+		cfg.ticks = n
+	}
+}
+
+// Run returns a [writer.Writer] computation that, when run, executes step once
+// per interval starting from the initial state. The output of the computation
+// records a [Tick] for every step, and its value is the final state.
+//
+// The simulation stops once the number of ticks given by [WithTicks] have run
+// or the context is done, whichever happens first. Because every tick is
+// recorded, a simulation without a tick limit should be given a context that
+// will eventually be done.
+func Run[S, A any](ctx context.Context, step state.State[S, A], initial S, interval time.Duration, opts ...Option) writer.Writer[[]Tick[S, A], S] {
+	cfg := config{clock: RealClock{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return writer.New(func() (S, []Tick[S, A]) {
+		var (
+			s   = initial
+			log []Tick[S, A]
+		)
+		for n := 1; cfg.ticks <= 0 || n <= cfg.ticks; n++ {
+			if ctx.Err() != nil {
+				return s, log
+			}
+
+			select {
+			case <-ctx.Done():
+				return s, log
+			case now := <-cfg.clock.After(interval):
+				var a A
+				a, s = step.Run(s)
+				log = append(log, Tick[S, A]{N: n, Time: now, Value: a, State: s})
+			}
+		}
+		return s, log
+	}, monoid.Slice[Tick[S, A]]{})
+}
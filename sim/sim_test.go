@@ -0,0 +1,72 @@
+package sim_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp/sim"
+	"github.com/tomasbasham/gofp/state"
+)
+
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func counter() state.State[int, int] {
+	return state.Update(func(s int) (int, int) {
+		return s * 10, s + 1
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("records every tick on a virtual clock", func(t *testing.T) {
+		clock := sim.NewVirtualClock(start)
+		final, ticks := sim.Run(context.Background(), counter(), 0, time.Minute,
+			sim.WithClock(clock),
+			sim.WithTicks(3),
+		).Run()
+
+		if final != 3 {
+			t.Errorf("expected final state 3, got %v", final)
+		}
+		if len(ticks) != 3 {
+			t.Fatalf("expected 3 ticks, got %d", len(ticks))
+		}
+		for i, tick := range ticks {
+			if tick.N != i+1 || tick.Value != i*10 || tick.State != i+1 {
+				t.Errorf("unexpected tick %+v", tick)
+			}
+			if want := start.Add(time.Duration(i+1) * time.Minute); !tick.Time.Equal(want) {
+				t.Errorf("expected tick at %v, got %v", want, tick.Time)
+			}
+		}
+		if want := start.Add(3 * time.Minute); !clock.Now().Equal(want) {
+			t.Errorf("expected clock at %v, got %v", want, clock.Now())
+		}
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		step := state.Update(func(s int) (int, int) {
+			if s == 4 {
+				cancel()
+			}
+			return s, s + 1
+		})
+
+		final, ticks := sim.Run(ctx, step, 0, time.Second, sim.WithClock(sim.NewVirtualClock(start))).Run()
+		if final != 5 || len(ticks) != 5 {
+			t.Errorf("expected 5 ticks, got %d with final state %v", len(ticks), final)
+		}
+	})
+
+	t.Run("waits between ticks on the real clock", func(t *testing.T) {
+		begin := time.Now()
+		_, ticks := sim.Run(context.Background(), counter(), 0, 5*time.Millisecond, sim.WithTicks(2)).Run()
+		if len(ticks) != 2 {
+			t.Fatalf("expected 2 ticks, got %d", len(ticks))
+		}
+		if elapsed := time.Since(begin); elapsed < 10*time.Millisecond {
+			t.Errorf("expected at least 10ms to elapse, got %v", elapsed)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package gofp
+
+// FilterMap applies a function to each element of a slice, keeping only the
+// values of those that return Some. The order of the kept values is preserved.
+func FilterMap[T, U any](xs []T, fn func(T) Option[U]) []U {
+	values := make([]U, 0, len(xs))
+	for _, x := range xs {
+		if o := fn(x); o.valid {
+			values = append(values, o.value)
+		}
+	}
+	return values
+}
+
+// MapWhileOk applies a function to each element of a slice, stopping at the
+// first that returns an Err. If every element returns Ok, it returns Ok with a
+// slice of all values, preserving order. Otherwise it returns the first Err
+// without applying the function to any remaining elements.
+func MapWhileOk[T, U any](xs []T, fn func(T) Result[U]) Result[[]U] {
+	values := make([]U, 0, len(xs))
+	for _, x := range xs {
+		r := fn(x)
+		if r.isErr {
+			return Result[[]U]{err: r.err, isErr: true, stack: r.stack, meta: r.meta}
+		}
+		values = append(values, r.value)
+	}
+	return Ok(values)
+}
@@ -0,0 +1,53 @@
+package gofp_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func TestFilterMap(t *testing.T) {
+	xs := []string{"1", "two", "3"}
+	got := gofp.FilterMap(xs, func(s string) gofp.Option[int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return gofp.None[int]()
+		}
+		return gofp.Some(n)
+	})
+	if !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("expected [1 3], got %v", got)
+	}
+}
+
+func TestMapWhileOk(t *testing.T) {
+	t.Run("collects all Ok values", func(t *testing.T) {
+		got := gofp.MapWhileOk([]string{"1", "2"}, func(s string) gofp.Result[int] {
+			return gofp.FromReturn(strconv.Atoi(s))
+		})
+		if !reflect.DeepEqual(got.Unwrap(), []int{1, 2}) {
+			t.Errorf("expected [1 2], got %v", got)
+		}
+	})
+
+	t.Run("stops at first Err", func(t *testing.T) {
+		errInvalid := errors.New("invalid")
+		calls := 0
+		got := gofp.MapWhileOk([]int{1, -1, 2}, func(x int) gofp.Result[int] {
+			calls++
+			if x < 0 {
+				return gofp.Err[int](errInvalid)
+			}
+			return gofp.Ok(x)
+		})
+		if got.UnwrapErr() != errInvalid {
+			t.Errorf("expected %v, got %v", errInvalid, got)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+}
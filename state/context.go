@@ -0,0 +1,61 @@
+package state
+
+import (
+	"context"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// CtxState is a [TryState] computation that is given a context when it is run.
+// Steps composed with [FlatMapCtx] check the context before they run, so that a
+// long chain of stateful steps stops promptly once the context is done.
+//
+// Type parameter S represents the state type.
+// Type parameter A represents the value type.
+type CtxState[S, A any] func(context.Context) TryState[S, A]
+
+// WithCtx lifts a [TryState] computation, which does not use the context, into
+// a [CtxState].
+func WithCtx[S, A any](s TryState[S, A]) CtxState[S, A] {
+	return func(context.Context) TryState[S, A] {
+		return s
+	}
+}
+
+// FromCtxFunc creates a [CtxState] from a function that receives the context
+// and the current state, and returns a value, a new state and an error. If the
+// error is non-nil, the computation produces an Err and leaves the state
+// unchanged.
+func FromCtxFunc[S, A any](f func(context.Context, S) (A, S, error)) CtxState[S, A] {
+	return func(ctx context.Context) TryState[S, A] {
+		return TryFromFunc(func(s S) (A, S, error) {
+			return f(ctx, s)
+		})
+	}
+}
+
+// FlatMapCtx composes two [CtxState] computations by using the Ok value of the
+// first to create the second. If the context is done once the first
+// computation has finished, the second is never run and the computation
+// produces an Err holding the error given by [gofp.ContextError].
+func FlatMapCtx[S, A, B any](s CtxState[S, A], f func(A) CtxState[S, B]) CtxState[S, B] {
+	return func(ctx context.Context) TryState[S, B] {
+		return TryFlatMap(s(ctx), func(a A) TryState[S, B] {
+			if err := gofp.ContextError(ctx); err != nil {
+				return Pure[S](gofp.Err[B](err))
+			}
+			return f(a)(ctx)
+		})
+	}
+}
+
+// RunCtx executes the [CtxState] computation with the given context and
+// initial state, and returns both the result and the final state. If the
+// context is already done, nothing is run and the result is an Err holding the
+// error given by [gofp.ContextError].
+func RunCtx[S, A any](ctx context.Context, s CtxState[S, A], state S) (gofp.Result[A], S) {
+	if err := gofp.ContextError(ctx); err != nil {
+		return gofp.Err[A](err), state
+	}
+	return s(ctx).Run(state)
+}
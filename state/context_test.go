@@ -0,0 +1,84 @@
+package state_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/state"
+)
+
+func TestRunCtx(t *testing.T) {
+	increment := state.WithCtx(state.TryLift(state.ModifyGet(func(s int) int { return s + 1 })))
+
+	t.Run("runs every step", func(t *testing.T) {
+		s := state.FlatMapCtx(increment, func(int) state.CtxState[int, int] {
+			return increment
+		})
+
+		result, final := state.RunCtx(context.Background(), s, 0)
+		if result.Unwrap() != 2 || final != 2 {
+			t.Errorf("expected Ok(2) and state 2, got %v and %v", result, final)
+		}
+	})
+
+	t.Run("stops between steps once canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelling := state.FromCtxFunc(func(_ context.Context, s int) (int, int, error) {
+			cancel()
+			return s, s + 10, nil
+		})
+
+		ran := false
+		s := state.FlatMapCtx(cancelling, func(int) state.CtxState[int, int] {
+			ran = true
+			return increment
+		})
+
+		result, final := state.RunCtx(ctx, s, 0)
+		if ran {
+			t.Error("expected the step after cancellation not to run")
+		}
+		if final != 10 {
+			t.Errorf("expected state from completed steps to be kept, got %v", final)
+		}
+
+		if result.UnwrapErr() != gofp.ErrCanceled {
+			t.Errorf("expected ErrCanceled, got %v", result)
+		}
+	})
+
+	t.Run("does not run when already canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		result, final := state.RunCtx(ctx, increment, 5)
+		if result.IsOk() || final != 5 {
+			t.Errorf("expected Err and unchanged state, got %v and %v", result, final)
+		}
+	})
+
+	t.Run("passes the context to steps", func(t *testing.T) {
+		type key struct{}
+		ctx := context.WithValue(context.Background(), key{}, "request-1")
+		s := state.FromCtxFunc(func(ctx context.Context, s string) (string, string, error) {
+			return ctx.Value(key{}).(string), s, nil
+		})
+
+		if result, _ := state.RunCtx(ctx, s, ""); result.Unwrap() != "request-1" {
+			t.Errorf("expected request-1, got %v", result)
+		}
+	})
+
+	t.Run("propagates step errors", func(t *testing.T) {
+		errFailed := errors.New("failed")
+		s := state.FlatMapCtx(state.WithCtx(state.Pure[int](gofp.Err[int](errFailed))), func(int) state.CtxState[int, int] {
+			return increment
+		})
+
+		if result, _ := state.RunCtx(context.Background(), s, 0); result.UnwrapErr() != errFailed {
+			t.Errorf("expected error to propagate, got %v", result)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package state
+
+// Return lifts a value into a [State] computation. It is equivalent to [Pure].
+func Return[S, A any](a A) State[S, A] {
+	return Pure[S](a)
+}
+
+// Bind is equivalent to [FlatMap].
+func Bind[S, A, B any](s State[S, A], f func(A) State[S, B]) State[S, B] {
+	return FlatMap(s, f)
+}
+
+// Join flattens a [State] computation whose value is itself a [State]
+// computation, running the inner computation with the state left by the
+// outer one.
+func Join[S, A any](s State[S, State[S, A]]) State[S, A] {
+	return FlatMap(s, func(inner State[S, A]) State[S, A] { return inner })
+}
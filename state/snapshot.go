@@ -0,0 +1,138 @@
+package state
+
+import "github.com/tomasbasham/gofp"
+
+// COWMap is an immutable map with copy-on-write semantics. Updating a COWMap
+// returns a new map and never mutates the original, so it can be used as the
+// state of a [State] computation without aliasing the caller's map across runs.
+// The zero value is an empty map ready to use.
+//
+// A COWMap is a persistent hash trie, so an update copies only the few nodes
+// on the path to the updated key and shares the rest with the original. Set
+// and Delete therefore take time proportional to the logarithm of the size of
+// the map, rather than copying every entry.
+//
+// Type parameter K represents the key type.
+// Type parameter V represents the value type.
+type COWMap[K comparable, V any] struct {
+	root *trie[K, V]
+	len  int
+}
+
+// NewCOWMap creates a [COWMap] holding a copy of the given map.
+func NewCOWMap[K comparable, V any](m map[K]V) COWMap[K, V] {
+	var c COWMap[K, V]
+	for k, v := range m {
+		c = c.Set(k, v)
+	}
+	return c
+}
+
+// Get returns the value associated with the given key, or None if the key is
+// not present.
+func (c COWMap[K, V]) Get(key K) gofp.Option[V] {
+	v, ok := c.root.get(hashKey(key), 0, key)
+	if !ok {
+		return gofp.None[V]()
+	}
+	return gofp.Some(v)
+}
+
+// Set returns a new [COWMap] with the given key associated with the given
+// value.
+func (c COWMap[K, V]) Set(key K, value V) COWMap[K, V] {
+	root, added := c.root.set(hashKey(key), 0, key, value)
+	if added {
+		return COWMap[K, V]{root: root, len: c.len + 1}
+	}
+	return COWMap[K, V]{root: root, len: c.len}
+}
+
+// Delete returns a new [COWMap] without the given key.
+func (c COWMap[K, V]) Delete(key K) COWMap[K, V] {
+	root, removed := c.root.delete(hashKey(key), 0, key)
+	if !removed {
+		return c
+	}
+	return COWMap[K, V]{root: root, len: c.len - 1}
+}
+
+// Len returns the number of entries in the [COWMap].
+func (c COWMap[K, V]) Len() int {
+	return c.len
+}
+
+// ToMap returns a copy of the entries of the [COWMap] as a Go map.
+func (c COWMap[K, V]) ToMap() map[K]V {
+	m := make(map[K]V, c.len)
+	c.root.all(func(k K, v V) bool {
+		m[k] = v
+		return true
+	})
+	return m
+}
+
+// Snapshot is a state that records checkpoints of an underlying state, so
+// that a computation can roll back to an earlier point. Checkpoints share
+// values with the current state, so the underlying state should be immutable,
+// such as a [COWMap].
+//
+// Type parameter S represents the underlying state type.
+type Snapshot[S any] struct {
+	Current     S
+	checkpoints []S
+}
+
+// NewSnapshot creates a [Snapshot] with the given current state and no
+// checkpoints.
+func NewSnapshot[S any](s S) Snapshot[S] {
+	return Snapshot[S]{Current: s}
+}
+
+// Checkpoints returns the number of checkpoints recorded by the [Snapshot].
+func (s Snapshot[S]) Checkpoints() int {
+	return len(s.checkpoints)
+}
+
+// Checkpoint returns a [State] computation that records the current state so
+// that it can later be restored by [RestoreLast].
+func Checkpoint[S any]() State[Snapshot[S], gofp.Unit] {
+	return Modify(func(s Snapshot[S]) Snapshot[S] {
+		checkpoints := make([]S, len(s.checkpoints), len(s.checkpoints)+1)
+		copy(checkpoints, s.checkpoints)
+		return Snapshot[S]{
+			Current:     s.Current,
+			checkpoints: append(checkpoints, s.Current),
+		}
+	})
+}
+
+// RestoreLast returns a [State] computation that replaces the current state
+// with the most recent checkpoint, removing the checkpoint. Its value reports
+// whether a checkpoint was restored; if there are no checkpoints the state is
+// left unchanged.
+func RestoreLast[S any]() State[Snapshot[S], bool] {
+	return State[Snapshot[S], bool]{
+		func(s Snapshot[S]) (bool, Snapshot[S]) {
+			n := len(s.checkpoints)
+			if n == 0 {
+				return false, s
+			}
+			return true, Snapshot[S]{
+				Current:     s.checkpoints[n-1],
+				checkpoints: s.checkpoints[: n-1 : n-1],
+			}
+		},
+	}
+}
+
+// InSnapshot lifts a [State] computation over the underlying state into one
+// over a [Snapshot], leaving the checkpoints unchanged.
+func InSnapshot[S, A any](st State[S, A]) State[Snapshot[S], A] {
+	return State[Snapshot[S], A]{
+		func(s Snapshot[S]) (A, Snapshot[S]) {
+			a, current := st.g(s.Current)
+			return a, Snapshot[S]{Current: current, checkpoints: s.checkpoints}
+		},
+	}
+}
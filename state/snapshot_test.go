@@ -0,0 +1,132 @@
+package state_test
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/state"
+	"github.com/tomasbasham/gofp/state/statetest"
+)
+
+func TestCOWMap(t *testing.T) {
+	original := map[string]int{"a": 1}
+	m1 := state.NewCOWMap(original)
+	m2 := m1.Set("b", 2)
+	m3 := m2.Delete("a")
+
+	original["c"] = 3
+	if m1.Len() != 1 || m2.Len() != 2 || m3.Len() != 1 {
+		t.Errorf("expected lengths 1, 2, 1, got %d, %d, %d", m1.Len(), m2.Len(), m3.Len())
+	}
+	if !m1.Get("b").IsNone() {
+		t.Error("expected Set not to mutate the original map")
+	}
+	if m2.Get("a").Unwrap() != 1 {
+		t.Error("expected Delete not to mutate the original map")
+	}
+
+	var zero state.COWMap[string, int]
+	if zero.Set("a", 1).Get("a").Unwrap() != 1 {
+		t.Error("expected zero value to be usable")
+	}
+}
+
+func TestCOWMap_Persistent(t *testing.T) {
+	var (
+		m        state.COWMap[int, int]
+		versions []state.COWMap[int, int]
+		want     []map[int]int
+	)
+	current := map[int]int{}
+	for i := range 2000 {
+		if i%3 == 2 {
+			m = m.Delete(i / 2)
+			delete(current, i/2)
+		} else {
+			m = m.Set(i%700, i)
+			current[i%700] = i
+		}
+		if i%100 == 0 {
+			versions = append(versions, m)
+			want = append(want, maps.Clone(current))
+		}
+	}
+	versions = append(versions, m)
+	want = append(want, current)
+
+	for i, v := range versions {
+		if v.Len() != len(want[i]) {
+			t.Fatalf("version %d: expected %d entries, got %d", i, len(want[i]), v.Len())
+		}
+		if got := v.ToMap(); !maps.Equal(got, want[i]) {
+			t.Fatalf("version %d: expected %v, got %v", i, want[i], got)
+		}
+		for k, x := range want[i] {
+			if got := v.Get(k); got.Unwrap() != x {
+				t.Fatalf("version %d: expected Some(%d) for %d, got %v", i, x, k, got)
+			}
+		}
+	}
+}
+
+func TestCOWMap_DeepEqual(t *testing.T) {
+	var added state.COWMap[int, int]
+	for i := range 100 {
+		added = added.Set(i, i)
+	}
+	for i := 50; i < 100; i++ {
+		added = added.Delete(i)
+	}
+
+	var fresh state.COWMap[int, int]
+	for i := range 50 {
+		fresh = fresh.Set(i, i)
+	}
+
+	if diff := statetest.Diff(fresh, added); diff != "" {
+		t.Errorf("expected maps with the same entries to be equal, got:\n%s", diff)
+	}
+}
+
+func TestCOWMap_Run(t *testing.T) {
+	put := state.Modify(func(m state.COWMap[string, int]) state.COWMap[string, int] {
+		return m.Set("a", 1)
+	})
+
+	initial := state.COWMap[string, int]{}
+	_, first := put.Run(initial)
+	if initial.Len() != 0 || first.Len() != 1 {
+		t.Errorf("expected running the computation not to mutate the initial state")
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	set := func(v int) state.State[state.Snapshot[int], gofp.Unit] {
+		return state.InSnapshot(state.Put(v))
+	}
+
+	s := state.FlatMap(set(1), func(gofp.Unit) state.State[state.Snapshot[int], bool] {
+		return state.FlatMap(state.Checkpoint[int](), func(gofp.Unit) state.State[state.Snapshot[int], bool] {
+			return state.FlatMap(set(2), func(gofp.Unit) state.State[state.Snapshot[int], bool] {
+				return state.RestoreLast[int]()
+			})
+		})
+	})
+
+	restored, final := s.Run(state.NewSnapshot(0))
+	if !restored {
+		t.Error("expected checkpoint to be restored")
+	}
+	if final.Current != 1 {
+		t.Errorf("expected current state 1, got %d", final.Current)
+	}
+	if final.Checkpoints() != 0 {
+		t.Errorf("expected no checkpoints, got %d", final.Checkpoints())
+	}
+
+	restored, final = state.RestoreLast[int]().Run(final)
+	if restored || final.Current != 1 {
+		t.Errorf("expected restore without checkpoints to be a no-op, got %v, %v", restored, final.Current)
+	}
+}
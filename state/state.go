@@ -34,6 +34,20 @@ func (s State[S, A]) Run(state S) (A, S) {
 	return s.g(state)
 }
 
+// New creates a [State] from a function that receives the current state and
+// returns a value along with the new state.
+func New[S, A any](f func(S) (A, S)) State[S, A] {
+	return State[S, A]{f}
+}
+
+// Update creates a [State] computation from a function that receives the
+// current state and returns a value along with the new state. It is equivalent
+// to [New], and reads more naturally where an existing function is used to
+// read and replace the state in a single step.
+func Update[S, A any](f func(S) (A, S)) State[S, A] {
+	return New(f)
+}
+
 // Pure lifts a value into a [State] computation. The resulting [State] will
 // always return the given value and leave the state unchanged.
 func Pure[S, A any](a A) State[S, A] {
@@ -87,6 +101,42 @@ func Modify[S any](f func(S) S) State[S, gofp.Unit] {
 	}
 }
 
+// ModifyGet returns a [State] computation that transforms the current state
+// using the provided function and returns the new state as its value.
+func ModifyGet[S any](f func(S) S) State[S, S] {
+	return State[S, S]{
+		func(s S) (S, S) {
+			next := f(s)
+			return next, next
+		},
+	}
+}
+
+// GetAndModify returns a [State] computation that transforms the current state
+// using the provided function and returns the previous state as its value.
+func GetAndModify[S any](f func(S) S) State[S, S] {
+	return State[S, S]{
+		func(s S) (S, S) {
+			return s, f(s)
+		},
+	}
+}
+
+// When returns the given [State] computation if the condition holds, otherwise
+// a computation that leaves the state unchanged.
+func When[S any](cond bool, s State[S, gofp.Unit]) State[S, gofp.Unit] {
+	if !cond {
+		return Pure[S](gofp.UnitValue)
+	}
+	return s
+}
+
+// Unless returns the given [State] computation if the condition does not hold,
+// otherwise a computation that leaves the state unchanged.
+func Unless[S any](cond bool, s State[S, gofp.Unit]) State[S, gofp.Unit] {
+	return When(!cond, s)
+}
+
 // Map applies a function to transform the value type of a [State], while
 // preserving the state transitions. Similar to the [State.Map] method but
 // allows changing the value type.
@@ -137,6 +187,20 @@ func Zip[S, A, B, U any](sa State[S, A], sb State[S, B], f func(A, B) U) State[S
 	})
 }
 
+// ZipIndependent combines two [State] computations into one. Unlike [Zip], both
+// computations start from the same state rather than threading the state from
+// one to the other. The resulting states are merged using mergeStates and the
+// values are combined using mergeValues.
+func ZipIndependent[S, A, B, U any](sa State[S, A], sb State[S, B], mergeStates func(S, S) S, mergeValues func(A, B) U) State[S, U] {
+	return State[S, U]{
+		func(state S) (U, S) {
+			a, s1 := sa.g(state)
+			b, s2 := sb.g(state)
+			return mergeValues(a, b), mergeStates(s1, s2)
+		},
+	}
+}
+
 // Sequence transforms a slice of [State] computations into a single [State]
 // computation that returns a slice of values. The state is threaded through
 // all computations in order.
@@ -149,3 +213,84 @@ func Sequence[S, A any](states []State[S, A]) State[S, []A] {
 	}
 	return values
 }
+
+// TryState is a [State] computation whose value is a [gofp.Result]. The Try
+// combinators short-circuit on Err, so only the Ok path needs to be handled
+// when composing stateful computations that may fail.
+//
+// Type parameter S represents the state type.
+// Type parameter A represents the value type.
+type TryState[S, A any] = State[S, gofp.Result[A]]
+
+// TryFromFunc creates a [TryState] from a function that returns a value, a new
+// state and an error. If the error is non-nil, the computation produces an Err
+// and leaves the state unchanged.
+func TryFromFunc[S, A any](f func(S) (A, S, error)) TryState[S, A] {
+	return TryState[S, A]{
+		func(s S) (gofp.Result[A], S) {
+			a, newState, err := f(s)
+			if err != nil {
+				return gofp.Err[A](err), s
+			}
+			return gofp.Ok(a), newState
+		},
+	}
+}
+
+// TryLift lifts a [State] computation into a [TryState] whose value is always
+// Ok.
+func TryLift[S, A any](s State[S, A]) TryState[S, A] {
+	return Map(s, gofp.Ok[A])
+}
+
+// TryMap applies a function to transform the Ok value of a [TryState], or
+// otherwise preserves the Err.
+func TryMap[S, A, B any](s TryState[S, A], f func(A) B) TryState[S, B] {
+	return Map(s, func(ra gofp.Result[A]) gofp.Result[B] {
+		return gofp.ResultMap(ra, f)
+	})
+}
+
+// TryMapErr applies a function to transform the error of a [TryState] that
+// produces an Err, or otherwise preserves the Ok value.
+func TryMapErr[S, A any](s TryState[S, A], f func(error) error) TryState[S, A] {
+	return Map(s, func(ra gofp.Result[A]) gofp.Result[A] {
+		return ra.OrElse(func(err error) gofp.Result[A] {
+			return gofp.Err[A](f(err))
+		})
+	})
+}
+
+// TryFlatMap composes two [TryState] computations by using the Ok value of the
+// first to create the second. If the first computation produces an Err, the
+// second is never run and the state is left as the first computation left it.
+func TryFlatMap[S, A, B any](s TryState[S, A], f func(A) TryState[S, B]) TryState[S, B] {
+	return TryState[S, B]{
+		func(state S) (gofp.Result[B], S) {
+			ra, newState := s.g(state)
+			rb := gofp.ResultFlatMap(ra, func(a A) gofp.Result[B] {
+				var rb gofp.Result[B]
+				rb, newState = f(a).g(newState)
+				return rb
+			})
+			return rb, newState
+		},
+	}
+}
+
+// Instrument wraps a [State] computation so that each time it is run the given
+// hook is called with a [gofp.StageInfo] describing the wall time and heap
+// allocations of the computation. Wrapping the individual stages of a long
+// FlatMap chain makes it possible to identify slow stages without an external
+// profiler.
+func Instrument[S, A any](s State[S, A], hook func(gofp.StageInfo)) State[S, A] {
+	return State[S, A]{
+		func(state S) (A, S) {
+			var a A
+			hook(gofp.Measure(func() {
+				a, state = s.g(state)
+			}))
+			return a, state
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package state_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -99,6 +100,80 @@ func TestModify(t *testing.T) {
 	}
 }
 
+func TestModifyGet(t *testing.T) {
+	value, finalState := state.ModifyGet(func(s int) int { return s * 2 }).Run(21)
+	if value != 42 || finalState != 42 {
+		t.Errorf("expected value and state 42, got %v and %v", value, finalState)
+	}
+}
+
+func TestGetAndModify(t *testing.T) {
+	value, finalState := state.GetAndModify(func(s int) int { return s * 2 }).Run(21)
+	if value != 21 {
+		t.Errorf("expected previous state 21, got %v", value)
+	}
+	if finalState != 42 {
+		t.Errorf("expected state 42, got %v", finalState)
+	}
+}
+
+func TestNew(t *testing.T) {
+	s := state.New(func(s int) (int, int) {
+		return s * 2, s + 1
+	})
+
+	value, finalState := s.Run(21)
+	if value != 42 || finalState != 22 {
+		t.Errorf("expected value 42 and state 22, got %v and %v", value, finalState)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := state.Update(func(s int) (string, int) {
+		return fmt.Sprintf("was %d", s), s + 1
+	})
+
+	value, finalState := s.Run(41)
+	if value != "was 41" {
+		t.Errorf("expected 'was 41', got %v", value)
+	}
+	if finalState != 42 {
+		t.Errorf("expected state 42, got %v", finalState)
+	}
+}
+
+func TestWhen(t *testing.T) {
+	increment := state.Modify(func(s int) int { return s + 1 })
+
+	t.Run("runs computation when condition holds", func(t *testing.T) {
+		_, finalState := state.When(true, increment).Run(1)
+		if finalState != 2 {
+			t.Errorf("expected state 2, got %v", finalState)
+		}
+	})
+
+	t.Run("leaves state unchanged when condition does not hold", func(t *testing.T) {
+		_, finalState := state.When(false, increment).Run(1)
+		if finalState != 1 {
+			t.Errorf("expected state 1, got %v", finalState)
+		}
+	})
+}
+
+func TestUnless(t *testing.T) {
+	increment := state.Modify(func(s int) int { return s + 1 })
+
+	_, finalState := state.Unless(false, increment).Run(1)
+	if finalState != 2 {
+		t.Errorf("expected state 2, got %v", finalState)
+	}
+
+	_, finalState = state.Unless(true, increment).Run(1)
+	if finalState != 1 {
+		t.Errorf("expected state 1, got %v", finalState)
+	}
+}
+
 func TestMap(t *testing.T) {
 	t.Run("maps value only", func(t *testing.T) {
 		env := Environment{Debug: true, Name: "test", Value: 42}
@@ -181,22 +256,12 @@ func TestApply(t *testing.T) {
 	t.Run("threads state through computations", func(t *testing.T) {
 		initialState := Environment{Debug: true, Name: "test", Value: 42}
 
-		// Create a custom state computation that increments value
-		incrementValue := state.Pure[Environment](func(s Environment) (int, Environment) {
+		// First state computation increments value and returns it
+		s1 := state.Update(func(s Environment) (int, Environment) {
 			s.Value++
 			return s.Value, s
 		})
 
-		// First state computation increments value and returns it
-		s1 := state.FlatMap(incrementValue, func(f func(Environment) (int, Environment)) state.State[Environment, int] {
-			return state.FlatMap(state.Get[Environment](), func(e Environment) state.State[Environment, int] {
-				value, newState := f(e)
-				return state.FlatMap(state.Put(newState), func(_ gofp.Unit) state.State[Environment, int] {
-					return state.Pure[Environment](value)
-				})
-			})
-		})
-
 		// Second state computation contains a function
 		sf := state.Pure[Environment](func(n int) string {
 			return fmt.Sprintf("Number: %d", n)
@@ -328,6 +393,41 @@ func TestZip(t *testing.T) {
 	})
 }
 
+func TestZipIndependent(t *testing.T) {
+	env := Environment{Debug: true, Name: "test", Value: 42}
+
+	s1 := threadEnvironmentValue(func(e Environment) Environment {
+		e.Value += 5
+		return e
+	})
+	s2 := threadEnvironmentValue(func(e Environment) Environment {
+		e.Value += 10
+		return e
+	})
+
+	var merged []int
+	sum := state.ZipIndependent(s1, s2, func(a, b Environment) Environment {
+		merged = append(merged, a.Value, b.Value)
+		a.Value += b.Value - env.Value
+		return a
+	}, func(a, b int) int {
+		return a + b
+	})
+
+	value, finalState := sum.Run(env)
+	if value != 99 { // (42 + 5) + (42 + 10)
+		t.Errorf("expected 99, got %v", value)
+	}
+
+	if len(merged) != 2 || merged[0] != 47 || merged[1] != 52 {
+		t.Errorf("expected both computations to start from the same state, got %v", merged)
+	}
+
+	if finalState.Value != 57 {
+		t.Errorf("expected state value to be 57, got %v", finalState.Value)
+	}
+}
+
 func TestSequence(t *testing.T) {
 	t.Run("combines multiple state computations", func(t *testing.T) {
 		env := Environment{Debug: true, Name: "test", Value: 42}
@@ -375,6 +475,93 @@ func TestSequence(t *testing.T) {
 	})
 }
 
+func TestTryFromFunc(t *testing.T) {
+	errNegative := errors.New("negative")
+	s := state.TryFromFunc(func(n int) (int, int, error) {
+		if n < 0 {
+			return 0, 0, errNegative
+		}
+		return n * 2, n + 1, nil
+	})
+
+	value, finalState := s.Run(1)
+	if value.Unwrap() != 2 || finalState != 2 {
+		t.Errorf("expected (Ok(2), 2), got (%v, %v)", value, finalState)
+	}
+
+	value, finalState = s.Run(-1)
+	if value.UnwrapErr() != errNegative || finalState != -1 {
+		t.Errorf("expected (Err(%v), -1), got (%v, %v)", errNegative, value, finalState)
+	}
+}
+
+func TestTryMapErr(t *testing.T) {
+	s := state.Pure[int](gofp.Err[int](errors.New("failed")))
+	wrapped := state.TryMapErr(s, func(err error) error {
+		return fmt.Errorf("wrapped: %w", err)
+	})
+
+	value, _ := wrapped.Run(0)
+	if value.UnwrapErr().Error() != "wrapped: failed" {
+		t.Errorf("expected wrapped error, got %v", value)
+	}
+}
+
+func TestTryFlatMap(t *testing.T) {
+	increment := state.TryLift(threadInt(func(s int) int { return s + 1 }))
+
+	t.Run("threads state through Ok values", func(t *testing.T) {
+		s := state.TryFlatMap(increment, func(x int) state.TryState[int, string] {
+			return state.TryMap(increment, func(y int) string {
+				return fmt.Sprintf("%d,%d", x, y)
+			})
+		})
+
+		value, finalState := s.Run(0)
+		if value.Unwrap() != "1,2" || finalState != 2 {
+			t.Errorf("expected (Ok(1,2), 2), got (%v, %v)", value, finalState)
+		}
+	})
+
+	t.Run("short-circuits on Err", func(t *testing.T) {
+		errFailed := errors.New("failed")
+		failing := state.FlatMap(increment, func(gofp.Result[int]) state.TryState[int, int] {
+			return state.Pure[int](gofp.Err[int](errFailed))
+		})
+		s := state.TryFlatMap(failing, func(int) state.TryState[int, int] {
+			return increment
+		})
+
+		value, finalState := s.Run(0)
+		if value.UnwrapErr() != errFailed || finalState != 1 {
+			t.Errorf("expected (Err(%v), 1), got (%v, %v)", errFailed, value, finalState)
+		}
+	})
+}
+
+func TestInstrument(t *testing.T) {
+	var stages []gofp.StageInfo
+	hook := func(info gofp.StageInfo) {
+		stages = append(stages, info)
+	}
+
+	s := state.FlatMap(state.Instrument(threadInt(func(s int) int { return s + 1 }), hook), func(int) state.State[int, int] {
+		return state.Instrument(threadInt(func(s int) int { return s * 10 }), hook)
+	})
+
+	result, finalState := s.Run(1)
+	if result != 20 || finalState != 20 {
+		t.Errorf("expected result and state 20, got %v and %v", result, finalState)
+	}
+
+	if len(stages) != 2 {
+		t.Fatalf("expected 2 stages, got %d", len(stages))
+	}
+	if stages[1].Start.Before(stages[0].Start) {
+		t.Error("expected stages to be reported in order")
+	}
+}
+
 func TestComposition(t *testing.T) {
 	t.Run("complex state transformation", func(t *testing.T) {
 		env := Environment{Debug: true, Name: "Alice", Value: 42}
@@ -438,23 +625,33 @@ func TestComposition(t *testing.T) {
 }
 
 func threadEnvironmentValue(fn func(e Environment) Environment) state.State[Environment, int] {
-	return state.FlatMap(state.Get[Environment](), func(e Environment) state.State[Environment, int] {
-		e = fn(e)
-		return state.FlatMap(state.Put(e), func(_ gofp.Unit) state.State[Environment, int] {
-			return state.Pure[Environment](e.Value)
-		})
+	return state.Map(state.ModifyGet(fn), func(e Environment) int {
+		return e.Value
 	})
 }
 
 func threadInt(fn func(s int) int) state.State[int, int] {
-	return state.FlatMap(state.Get[int](), func(s int) state.State[int, int] {
-		s = fn(s)
-		return state.FlatMap(state.Put(s), func(_ gofp.Unit) state.State[int, int] {
-			return state.Pure[int](s)
-		})
-	})
+	return state.ModifyGet(fn)
 }
 
 func environmentEquals(a, b Environment) bool {
 	return a.Debug == b.Debug && a.Name == b.Name && a.Value == b.Value
 }
+
+func TestBindReturnJoin(t *testing.T) {
+	bound := state.Bind(state.Return[int](3), func(x int) state.State[int, int] {
+		return state.Map(state.ModifyGet(func(s int) int { return s + x }), func(s int) int { return s * 10 })
+	})
+	value, final := bound.Run(1)
+	if value != 40 || final != 4 {
+		t.Errorf("expected (40, 4), got (%v, %v)", value, final)
+	}
+
+	nested := state.Map(state.Modify(func(s int) int { return s + 1 }), func(gofp.Unit) state.State[int, int] {
+		return state.Get[int]()
+	})
+	value, final = state.Join(nested).Run(1)
+	if value != 2 || final != 2 {
+		t.Errorf("expected (2, 2), got (%v, %v)", value, final)
+	}
+}
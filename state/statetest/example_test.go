@@ -0,0 +1,23 @@
+package statetest_test
+
+import (
+	"fmt"
+
+	"github.com/tomasbasham/gofp/state/statetest"
+)
+
+func ExampleDiff() {
+	type Account struct {
+		Owner   string
+		Balance int
+		History []int
+	}
+
+	expected := Account{Owner: "alice", Balance: 50, History: []int{100, -50}}
+	actual := Account{Owner: "alice", Balance: 75, History: []int{100, -25}}
+
+	fmt.Println(statetest.Diff(expected, actual))
+	// Output:
+	// Balance: expected 50, got 75
+	// History[1]: expected -50, got -25
+}
@@ -0,0 +1,178 @@
+// Package statetest provides utilities for testing [state.State] computations.
+//
+// Comparing the final state of a computation usually means hand-rolling an
+// equality function for every state type, and reporting only that the states
+// differ. [Diff] compares any two values field by field using reflection and
+// describes exactly where they differ, and [AssertRun] and [RequireRun] check
+// both the value and the final state of a computation in a single call.
+package statetest
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/gofp/state"
+)
+
+// Diff returns a readable description of the differences between expected and
+// actual, with one line per differing field, element or key. It returns an
+// empty string if the values are deeply equal.
+func Diff[S any](expected, actual S) string {
+	var lines []string
+	diff(&lines, "", reflect.ValueOf(&expected).Elem(), reflect.ValueOf(&actual).Elem())
+	return strings.Join(lines, "\n")
+}
+
+func diff(lines *[]string, path string, e, a reflect.Value) {
+	if !e.IsValid() || !a.IsValid() {
+		if e.IsValid() != a.IsValid() {
+			report(lines, path, e, a)
+		}
+		return
+	}
+
+	if e.Type() != a.Type() {
+		*lines = append(*lines, fmt.Sprintf("%s: expected type %v, got %v", label(path), e.Type(), a.Type()))
+		return
+	}
+
+	switch e.Kind() {
+	case reflect.Struct:
+		for i := range e.NumField() {
+			diff(lines, join(path, e.Type().Field(i).Name), e.Field(i), a.Field(i))
+		}
+
+	case reflect.Slice, reflect.Array:
+		if e.Kind() == reflect.Slice && e.IsNil() != a.IsNil() {
+			report(lines, path, e, a)
+			return
+		}
+		if e.Len() != a.Len() {
+			*lines = append(*lines, fmt.Sprintf("%s: expected length %d, got %d", label(path), e.Len(), a.Len()))
+		}
+		for i := range min(e.Len(), a.Len()) {
+			diff(lines, fmt.Sprintf("%s[%d]", path, i), e.Index(i), a.Index(i))
+		}
+
+	case reflect.Map:
+		if e.IsNil() != a.IsNil() {
+			report(lines, path, e, a)
+			return
+		}
+		for _, k := range mapKeys(e, a) {
+			p := fmt.Sprintf("%s[%s]", path, format(k))
+			ev, av := e.MapIndex(k), a.MapIndex(k)
+			switch {
+			case !ev.IsValid():
+				*lines = append(*lines, fmt.Sprintf("%s: unexpected key, got %s", p, format(av)))
+			case !av.IsValid():
+				*lines = append(*lines, fmt.Sprintf("%s: missing key, expected %s", p, format(ev)))
+			default:
+				diff(lines, p, ev, av)
+			}
+		}
+
+	case reflect.Pointer, reflect.Interface:
+		if e.IsNil() || a.IsNil() {
+			if e.IsNil() != a.IsNil() {
+				report(lines, path, e, a)
+			}
+			return
+		}
+		diff(lines, path, e.Elem(), a.Elem())
+
+	default:
+		if format(e) != format(a) {
+			report(lines, path, e, a)
+		}
+	}
+}
+
+// mapKeys returns the union of the keys of two maps, sorted by their formatted
+// representation so that the diff is deterministic.
+func mapKeys(e, a reflect.Value) []reflect.Value {
+	seen := make(map[string]reflect.Value)
+	for _, m := range []reflect.Value{e, a} {
+		for _, k := range m.MapKeys() {
+			seen[format(k)] = k
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	keys := make([]reflect.Value, len(names))
+	for i, name := range names {
+		keys[i] = seen[name]
+	}
+	return keys
+}
+
+func report(lines *[]string, path string, e, a reflect.Value) {
+	*lines = append(*lines, fmt.Sprintf("%s: expected %s, got %s", label(path), format(e), format(a)))
+}
+
+func format(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+func join(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func label(path string) string {
+	if path == "" {
+		return "value"
+	}
+	return path
+}
+
+// AssertRun runs the [state.State] computation with the given initial state and
+// reports an error if either the value or the final state differ from those
+// expected. It returns true if both match.
+func AssertRun[S, A any](t testing.TB, s state.State[S, A], initial S, value A, final S) bool {
+	t.Helper()
+	return check(t, t.Errorf, s, initial, value, final)
+}
+
+// RequireRun is like [AssertRun] but stops the test if either the value or the
+// final state differ from those expected.
+func RequireRun[S, A any](t testing.TB, s state.State[S, A], initial S, value A, final S) {
+	t.Helper()
+	check(t, t.Fatalf, s, initial, value, final)
+}
+
+func check[S, A any](t testing.TB, fail func(string, ...any), s state.State[S, A], initial S, value A, final S) bool {
+	t.Helper()
+
+	gotValue, gotFinal := s.Run(initial)
+
+	var msgs []string
+	if d := Diff(value, gotValue); d != "" {
+		msgs = append(msgs, "value mismatch:\n"+indent(d))
+	}
+	if d := Diff(final, gotFinal); d != "" {
+		msgs = append(msgs, "final state mismatch:\n"+indent(d))
+	}
+	if len(msgs) > 0 {
+		fail("%s", strings.Join(msgs, "\n"))
+		return false
+	}
+	return true
+}
+
+func indent(s string) string {
+	return "\t" + strings.ReplaceAll(s, "\n", "\n\t")
+}
@@ -0,0 +1,159 @@
+package statetest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/state"
+	"github.com/tomasbasham/gofp/state/statetest"
+)
+
+type Inner struct {
+	Count int
+}
+
+type Environment struct {
+	Name  string
+	Tags  []string
+	Attrs map[string]int
+	Inner *Inner
+	debug bool
+}
+
+func TestDiff(t *testing.T) {
+	base := Environment{
+		Name:  "test",
+		Tags:  []string{"a", "b"},
+		Attrs: map[string]int{"x": 1},
+		Inner: &Inner{Count: 1},
+	}
+
+	tests := []struct {
+		name   string
+		modify func(e Environment) Environment
+		want   string
+	}{
+		{
+			name:   "equal values",
+			modify: func(e Environment) Environment { return e },
+			want:   "",
+		},
+		{
+			name: "differing field",
+			modify: func(e Environment) Environment {
+				e.Name = "other"
+				return e
+			},
+			want: `Name: expected "test", got "other"`,
+		},
+		{
+			name: "differing unexported field",
+			modify: func(e Environment) Environment {
+				e.debug = true
+				return e
+			},
+			want: "debug: expected false, got true",
+		},
+		{
+			name: "differing slice element and length",
+			modify: func(e Environment) Environment {
+				e.Tags = []string{"a", "c", "d"}
+				return e
+			},
+			want: "Tags: expected length 2, got 3\nTags[1]: expected \"b\", got \"c\"",
+		},
+		{
+			name: "differing map keys",
+			modify: func(e Environment) Environment {
+				e.Attrs = map[string]int{"y": 2}
+				return e
+			},
+			want: "Attrs[\"x\"]: missing key, expected 1\nAttrs[\"y\"]: unexpected key, got 2",
+		},
+		{
+			name: "differing pointer target",
+			modify: func(e Environment) Environment {
+				e.Inner = &Inner{Count: 2}
+				return e
+			},
+			want: "Inner.Count: expected 1, got 2",
+		},
+		{
+			name: "nil pointer",
+			modify: func(e Environment) Environment {
+				e.Inner = nil
+				return e
+			},
+			want: "Inner: expected &statetest_test.Inner{Count:1}, got (*statetest_test.Inner)(nil)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statetest.Diff(base, tt.modify(base)); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("top level values", func(t *testing.T) {
+		want := "value: expected 1, got 2"
+		if got := statetest.Diff(1, 2); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+}
+
+// recorder captures failures reported by the assertions under test.
+type recorder struct {
+	testing.TB
+	failures []string
+	fatal    bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	r.fatal = true
+}
+
+func TestAssertRun(t *testing.T) {
+	increment := state.FlatMap(state.Get[int](), func(n int) state.State[int, int] {
+		return state.Map(state.Put(n+1), func(gofp.Unit) int { return n })
+	})
+
+	t.Run("passes when value and state match", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if !statetest.AssertRun(r, increment, 1, 1, 2) {
+			t.Errorf("expected assertion to pass, got %v", r.failures)
+		}
+	})
+
+	t.Run("reports both mismatches", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if statetest.AssertRun(r, increment, 1, 5, 5) {
+			t.Error("expected assertion to fail")
+		}
+		want := "value mismatch:\n\tvalue: expected 5, got 1\nfinal state mismatch:\n\tvalue: expected 5, got 2"
+		if len(r.failures) != 1 || r.failures[0] != want {
+			t.Errorf("expected %q, got %q", want, r.failures)
+		}
+		if r.fatal {
+			t.Error("expected AssertRun not to stop the test")
+		}
+	})
+}
+
+func TestRequireRun(t *testing.T) {
+	r := &recorder{TB: t}
+	statetest.RequireRun(r, state.Pure[int]("value"), 1, "other", 1)
+	if !r.fatal {
+		t.Error("expected RequireRun to stop the test")
+	}
+}
@@ -0,0 +1,239 @@
+package state
+
+import (
+	"hash/maphash"
+	"math/bits"
+)
+
+// trieSeed seeds the hashes of the keys of every trie. All tries must share a
+// seed, since a trie shares nodes with those it was derived from.
+var trieSeed = maphash.MakeSeed()
+
+const (
+	trieBits = 5
+	trieMask = 1<<trieBits - 1
+)
+
+// trie is a node of a persistent hash array mapped trie, which backs a
+// [COWMap]. Each level of the trie is indexed by the next five bits of the
+// hash of a key, and only the slots that are in use are stored, as given by
+// the bitmap. Updating a trie copies only the nodes on the path to the
+// updated key, so the result shares every other node with the original, and
+// neither is ever mutated once built.
+type trie[K comparable, V any] struct {
+	bitmap uint32
+	slots  []slot[K, V]
+}
+
+// slot holds either a child node or a leaf.
+type slot[K comparable, V any] struct {
+	child *trie[K, V]
+	leaf  *leaf[K, V]
+}
+
+// leaf holds the entries whose keys have the given hash. There is more than
+// one entry only if the hashes of different keys collide.
+type leaf[K comparable, V any] struct {
+	hash    uint64
+	entries []entry[K, V]
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func hashKey[K comparable](key K) uint64 {
+	return maphash.Comparable(trieSeed, key)
+}
+
+// index returns the bit of the slot for the hash h at the given shift, and the
+// position of that slot within the slots of t.
+func (t *trie[K, V]) index(h uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((h >> shift) & trieMask)
+	return bit, bits.OnesCount32(t.bitmap & (bit - 1))
+}
+
+func (t *trie[K, V]) get(h uint64, shift uint, key K) (V, bool) {
+	for t != nil {
+		bit, pos := t.index(h, shift)
+		if t.bitmap&bit == 0 {
+			break
+		}
+		s := t.slots[pos]
+		if s.child != nil {
+			t, shift = s.child, shift+trieBits
+			continue
+		}
+		if s.leaf.hash == h {
+			for _, e := range s.leaf.entries {
+				if e.key == key {
+					return e.value, true
+				}
+			}
+		}
+		break
+	}
+	var zero V
+	return zero, false
+}
+
+// set returns a trie with the key associated with the value, and whether the
+// key was added rather than replaced. A nil trie is empty.
+func (t *trie[K, V]) set(h uint64, shift uint, key K, value V) (*trie[K, V], bool) {
+	if t == nil {
+		t = &trie[K, V]{}
+	}
+	bit, pos := t.index(h, shift)
+	if t.bitmap&bit == 0 {
+		return t.insert(bit, pos, slot[K, V]{leaf: &leaf[K, V]{hash: h, entries: []entry[K, V]{{key, value}}}}), true
+	}
+
+	s := t.slots[pos]
+	switch {
+	case s.child != nil:
+		child, added := s.child.set(h, shift+trieBits, key, value)
+		return t.replace(pos, slot[K, V]{child: child}), added
+	case s.leaf.hash == h:
+		l, added := s.leaf.set(key, value)
+		return t.replace(pos, slot[K, V]{leaf: l}), added
+	default:
+		l := &leaf[K, V]{hash: h, entries: []entry[K, V]{{key, value}}}
+		return t.replace(pos, slot[K, V]{child: split(s.leaf, l, shift+trieBits)}), true
+	}
+}
+
+// delete returns a trie without the key, and whether the key was present. The
+// result is nil if the trie is left empty.
+func (t *trie[K, V]) delete(h uint64, shift uint, key K) (*trie[K, V], bool) {
+	if t == nil {
+		return nil, false
+	}
+	bit, pos := t.index(h, shift)
+	if t.bitmap&bit == 0 {
+		return t, false
+	}
+
+	s := t.slots[pos]
+	switch {
+	case s.child != nil:
+		child, removed := s.child.delete(h, shift+trieBits, key)
+		if !removed {
+			return t, false
+		}
+		switch {
+		case child == nil:
+			return t.remove(bit, pos), true
+		case len(child.slots) == 1 && child.slots[0].leaf != nil:
+			// Lift a lone leaf into its parent, so that the shape of the trie
+			// depends only on its keys, as if they had never been deleted.
+			return t.replace(pos, child.slots[0]), true
+		}
+		return t.replace(pos, slot[K, V]{child: child}), true
+	case s.leaf.hash == h:
+		l, removed := s.leaf.delete(key)
+		if !removed {
+			return t, false
+		}
+		if l == nil {
+			return t.remove(bit, pos), true
+		}
+		return t.replace(pos, slot[K, V]{leaf: l}), true
+	default:
+		return t, false
+	}
+}
+
+// all calls f with every entry of the trie until f returns false.
+func (t *trie[K, V]) all(f func(K, V) bool) bool {
+	if t == nil {
+		return true
+	}
+	for _, s := range t.slots {
+		if s.child != nil {
+			if !s.child.all(f) {
+				return false
+			}
+			continue
+		}
+		for _, e := range s.leaf.entries {
+			if !f(e.key, e.value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (t *trie[K, V]) insert(bit uint32, pos int, s slot[K, V]) *trie[K, V] {
+	slots := make([]slot[K, V], len(t.slots)+1)
+	copy(slots, t.slots[:pos])
+	slots[pos] = s
+	copy(slots[pos+1:], t.slots[pos:])
+	return &trie[K, V]{bitmap: t.bitmap | bit, slots: slots}
+}
+
+func (t *trie[K, V]) replace(pos int, s slot[K, V]) *trie[K, V] {
+	slots := make([]slot[K, V], len(t.slots))
+	copy(slots, t.slots)
+	slots[pos] = s
+	return &trie[K, V]{bitmap: t.bitmap, slots: slots}
+}
+
+func (t *trie[K, V]) remove(bit uint32, pos int) *trie[K, V] {
+	if len(t.slots) == 1 {
+		return nil
+	}
+	slots := make([]slot[K, V], len(t.slots)-1)
+	copy(slots, t.slots[:pos])
+	copy(slots[pos:], t.slots[pos+1:])
+	return &trie[K, V]{bitmap: t.bitmap &^ bit, slots: slots}
+}
+
+// split returns a trie holding two leaves whose hashes differ, starting at the
+// given shift.
+func split[K comparable, V any](a, b *leaf[K, V], shift uint) *trie[K, V] {
+	ia, ib := (a.hash>>shift)&trieMask, (b.hash>>shift)&trieMask
+	if ia == ib {
+		return &trie[K, V]{
+			bitmap: 1 << ia,
+			slots:  []slot[K, V]{{child: split(a, b, shift+trieBits)}},
+		}
+	}
+	if ia > ib {
+		a, b = b, a
+		ia, ib = ib, ia
+	}
+	return &trie[K, V]{
+		bitmap: 1<<ia | 1<<ib,
+		slots:  []slot[K, V]{{leaf: a}, {leaf: b}},
+	}
+}
+
+func (l *leaf[K, V]) set(key K, value V) (*leaf[K, V], bool) {
+	entries := make([]entry[K, V], len(l.entries), len(l.entries)+1)
+	copy(entries, l.entries)
+	for i, e := range entries {
+		if e.key == key {
+			entries[i].value = value
+			return &leaf[K, V]{hash: l.hash, entries: entries}, false
+		}
+	}
+	return &leaf[K, V]{hash: l.hash, entries: append(entries, entry[K, V]{key, value})}, true
+}
+
+func (l *leaf[K, V]) delete(key K) (*leaf[K, V], bool) {
+	for i, e := range l.entries {
+		if e.key != key {
+			continue
+		}
+		if len(l.entries) == 1 {
+			return nil, true
+		}
+		entries := make([]entry[K, V], 0, len(l.entries)-1)
+		entries = append(entries, l.entries[:i]...)
+		entries = append(entries, l.entries[i+1:]...)
+		return &leaf[K, V]{hash: l.hash, entries: entries}, true
+	}
+	return l, false
+}
@@ -0,0 +1,23 @@
+package stdfp_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/stdfp"
+)
+
+func ExampleAtoi() {
+	sum := 0
+	for _, field := range strings.Fields("1 2 x 4") {
+		sum += stdfp.Atoi(field).UnwrapOr(0)
+	}
+	fmt.Println(sum)
+
+	doubled := gofp.ResultMap(stdfp.Atoi("21"), func(n int) int { return n * 2 })
+	fmt.Println(doubled)
+	// Output:
+	// 7
+	// Ok(42)
+}
@@ -0,0 +1,85 @@
+// Package stdfp wraps common standard library functions so that they return a
+// [gofp.Result] rather than a value and an error.
+//
+// Each function is a thin wrapper around its standard library namesake, with
+// the same arguments and semantics, so that pipelines may consume it directly
+// without converting its return values with [gofp.FromReturn] at every call
+// site.
+package stdfp
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// ReadFile reads the named file and returns its contents. See [os.ReadFile].
+func ReadFile(name string) gofp.Result[[]byte] {
+	return gofp.FromReturn(os.ReadFile(name))
+}
+
+// WriteFile writes data to the named file, creating it if necessary. See
+// [os.WriteFile].
+func WriteFile(name string, data []byte, perm fs.FileMode) gofp.Result[gofp.Unit] {
+	return gofp.FromReturn(gofp.UnitValue, os.WriteFile(name, data, perm))
+}
+
+// ReadDir reads the named directory and returns its entries sorted by
+// filename. See [os.ReadDir].
+func ReadDir(name string) gofp.Result[[]fs.DirEntry] {
+	return gofp.FromReturn(os.ReadDir(name))
+}
+
+// Stat returns a [fs.FileInfo] describing the named file. See [os.Stat].
+func Stat(name string) gofp.Result[fs.FileInfo] {
+	return gofp.FromReturn(os.Stat(name))
+}
+
+// Open opens the named file for reading. See [os.Open].
+func Open(name string) gofp.Result[*os.File] {
+	return gofp.FromReturn(os.Open(name))
+}
+
+// ReadAll reads from r until EOF and returns the data it read. See
+// [io.ReadAll].
+func ReadAll(r io.Reader) gofp.Result[[]byte] {
+	return gofp.FromReturn(io.ReadAll(r))
+}
+
+// Atoi parses s as a base 10 int. See [strconv.Atoi].
+func Atoi(s string) gofp.Result[int] {
+	return gofp.FromReturn(strconv.Atoi(s))
+}
+
+// ParseInt parses s as an integer in the given base and bit size. See
+// [strconv.ParseInt].
+func ParseInt(s string, base int, bitSize int) gofp.Result[int64] {
+	return gofp.FromReturn(strconv.ParseInt(s, base, bitSize))
+}
+
+// ParseFloat parses s as a floating-point number of the given bit size. See
+// [strconv.ParseFloat].
+func ParseFloat(s string, bitSize int) gofp.Result[float64] {
+	return gofp.FromReturn(strconv.ParseFloat(s, bitSize))
+}
+
+// ParseBool parses s as a boolean value. See [strconv.ParseBool].
+func ParseBool(s string) gofp.Result[bool] {
+	return gofp.FromReturn(strconv.ParseBool(s))
+}
+
+// ParseTime parses value as a time formatted according to layout. See
+// [time.Parse].
+func ParseTime(layout, value string) gofp.Result[time.Time] {
+	return gofp.FromReturn(time.Parse(layout, value))
+}
+
+// ParseDuration parses s as a duration, such as "300ms" or "1h30m". See
+// [time.ParseDuration].
+func ParseDuration(s string) gofp.Result[time.Duration] {
+	return gofp.FromReturn(time.ParseDuration(s))
+}
@@ -0,0 +1,117 @@
+package stdfp_test
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/stdfp"
+)
+
+func TestFiles(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "data.txt")
+
+	if r := stdfp.WriteFile(name, []byte("hello"), 0o600); r.IsErr() {
+		t.Fatalf("expected Ok, got %v", r)
+	}
+
+	t.Run("ReadFile", func(t *testing.T) {
+		got := stdfp.ReadFile(name)
+		if string(got.UnwrapOr(nil)) != "hello" {
+			t.Errorf("expected hello, got %v", got)
+		}
+	})
+
+	t.Run("ReadDir", func(t *testing.T) {
+		got := stdfp.ReadDir(dir)
+		entries := got.UnwrapOr(nil)
+		if len(entries) != 1 || entries[0].Name() != "data.txt" {
+			t.Errorf("expected [data.txt], got %v", got)
+		}
+	})
+
+	t.Run("Stat", func(t *testing.T) {
+		got := gofp.ResultMap(stdfp.Stat(name), fs.FileInfo.Size)
+		if got.UnwrapOr(0) != 5 {
+			t.Errorf("expected 5, got %v", got)
+		}
+	})
+
+	t.Run("Open and ReadAll", func(t *testing.T) {
+		f := stdfp.Open(name)
+		if f.IsErr() {
+			t.Fatalf("expected Ok, got %v", f)
+		}
+		defer f.Unwrap().Close()
+
+		got := stdfp.ReadAll(f.Unwrap())
+		if string(got.UnwrapOr(nil)) != "hello" {
+			t.Errorf("expected hello, got %v", got)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		got := stdfp.ReadFile(filepath.Join(dir, "missing"))
+		if !got.IsErr() || !errors.Is(got.UnwrapErr(), fs.ErrNotExist) {
+			t.Errorf("expected ErrNotExist, got %v", got)
+		}
+	})
+}
+
+func TestParse(t *testing.T) {
+	t.Run("Atoi", func(t *testing.T) {
+		if got := stdfp.Atoi("42"); got.UnwrapOr(0) != 42 {
+			t.Errorf("expected Ok(42), got %v", got)
+		}
+		if got := stdfp.Atoi("x"); !got.IsErr() {
+			t.Errorf("expected Err, got %v", got)
+		}
+	})
+
+	t.Run("ParseInt", func(t *testing.T) {
+		if got := stdfp.ParseInt("ff", 16, 64); got.UnwrapOr(0) != 255 {
+			t.Errorf("expected Ok(255), got %v", got)
+		}
+	})
+
+	t.Run("ParseFloat", func(t *testing.T) {
+		if got := stdfp.ParseFloat("1.5", 64); got.UnwrapOr(0) != 1.5 {
+			t.Errorf("expected Ok(1.5), got %v", got)
+		}
+	})
+
+	t.Run("ParseBool", func(t *testing.T) {
+		if got := stdfp.ParseBool("true"); !got.UnwrapOr(false) {
+			t.Errorf("expected Ok(true), got %v", got)
+		}
+	})
+
+	t.Run("ParseTime", func(t *testing.T) {
+		got := stdfp.ParseTime(time.DateOnly, "2024-02-29")
+		want := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+		if !got.UnwrapOr(time.Time{}).Equal(want) {
+			t.Errorf("expected Ok(%v), got %v", want, got)
+		}
+		if got := stdfp.ParseTime(time.DateOnly, "yesterday"); !got.IsErr() {
+			t.Errorf("expected Err, got %v", got)
+		}
+	})
+
+	t.Run("ParseDuration", func(t *testing.T) {
+		if got := stdfp.ParseDuration("1m30s"); got.UnwrapOr(0) != 90*time.Second {
+			t.Errorf("expected Ok(1m30s), got %v", got)
+		}
+	})
+}
+
+func TestReadAll_Error(t *testing.T) {
+	got := stdfp.ReadAll(iotest.ErrReader(errors.New("read failed")))
+	if !got.IsErr() {
+		t.Errorf("expected Err, got %v", got)
+	}
+}
@@ -0,0 +1,89 @@
+package gofp
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoTemplateValue is returned by the unwrap template function when the
+// container holds no value.
+var ErrNoTemplateValue = errors.New("template value is not present")
+
+// TemplateValue is implemented by containers that can be rendered in
+// text/template and html/template templates using the functions returned by
+// [TemplateFuncs]. TemplateValue returns the held value and whether it is
+// present.
+type TemplateValue interface {
+	TemplateValue() (any, bool)
+}
+
+// TemplateValue returns the value of the [Option] and whether it is Some.
+func (o Option[T]) TemplateValue() (any, bool) {
+	return o.value, o.valid
+}
+
+// TemplateValue returns the value of the [Result] and whether it is Ok.
+func (r Result[T]) TemplateValue() (any, bool) {
+	return r.value, !r.isErr
+}
+
+// TemplateValue returns the right value of the [Either] and whether it is
+// Right.
+func (e Either[T, U]) TemplateValue() (any, bool) {
+	return e.right, !e.isLeft
+}
+
+// TemplateFuncs returns functions for rendering [TemplateValue] containers in
+// templates. The result may be passed to the Funcs method of either a
+// text/template or html/template Template. The functions are:
+//
+//   - isSome returns true if the container holds a value.
+//   - isNone returns true if the container holds no value.
+//   - unwrap returns the held value, failing the template if there is none.
+//   - unwrapOr returns the held value, or the given default if there is none.
+//   - fold formats the held value with the given format string, or returns the
+//     given text if there is none.
+//
+// Because the container is the last argument of each function, they may be
+// used at the end of a pipeline:
+//
+//	{{ .Nickname | unwrapOr "anonymous" }}
+//	{{ .Age | fold "unknown" "%d years" }}
+func TemplateFuncs() map[string]any {
+	return map[string]any{
+		"isSome": func(v TemplateValue) bool {
+			_, ok := templateValue(v)
+			return ok
+		},
+		"isNone": func(v TemplateValue) bool {
+			_, ok := templateValue(v)
+			return !ok
+		},
+		"unwrap": func(v TemplateValue) (any, error) {
+			a, ok := templateValue(v)
+			if !ok {
+				return nil, ErrNoTemplateValue
+			}
+			return a, nil
+		},
+		"unwrapOr": func(def any, v TemplateValue) any {
+			if a, ok := templateValue(v); ok {
+				return a
+			}
+			return def
+		},
+		"fold": func(none, format string, v TemplateValue) string {
+			if a, ok := templateValue(v); ok {
+				return fmt.Sprintf(format, a)
+			}
+			return none
+		},
+	}
+}
+
+func templateValue(v TemplateValue) (any, bool) {
+	if v == nil {
+		return nil, false
+	}
+	return v.TemplateValue()
+}
@@ -0,0 +1,86 @@
+package gofp_test
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/tomasbasham/gofp"
+)
+
+type profile struct {
+	Name     string
+	Nickname gofp.Option[string]
+	Age      gofp.Option[int]
+	Score    gofp.Result[int]
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	tmpl := template.Must(template.New("profile").Funcs(gofp.TemplateFuncs()).Parse(
+		`{{ .Name }} ({{ .Nickname | unwrapOr "anonymous" }}), {{ .Age | fold "age unknown" "%d years" }}` +
+			`{{ if isSome .Score }}, score {{ unwrap .Score }}{{ end }}`,
+	))
+
+	tests := []struct {
+		name string
+		data profile
+		want string
+	}{
+		{
+			name: "renders present values",
+			data: profile{Name: "Ada", Nickname: gofp.Some("ada"), Age: gofp.Some(36), Score: gofp.Ok(99)},
+			want: "Ada (ada), 36 years, score 99",
+		},
+		{
+			name: "renders absent values",
+			data: profile{Name: "Bob", Score: gofp.Err[int](gofp.ErrNoResults)},
+			want: "Bob (anonymous), age unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := tmpl.Execute(&b, tt.data); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("unwrap fails on absent values", func(t *testing.T) {
+		tmpl := template.Must(template.New("unwrap").Funcs(gofp.TemplateFuncs()).Parse(`{{ unwrap .Age }}`))
+		if err := tmpl.Execute(&strings.Builder{}, profile{}); err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("works with html/template", func(t *testing.T) {
+		tmpl := htmltemplate.Must(htmltemplate.New("html").Funcs(gofp.TemplateFuncs()).Parse(
+			`<b>{{ .Nickname | unwrapOr "anonymous" }}</b>{{ if isNone .Age }}?{{ end }}`,
+		))
+
+		var b strings.Builder
+		if err := tmpl.Execute(&b, profile{Nickname: gofp.Some("<ada>")}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got, want := b.String(), "<b>&lt;ada&gt;</b>?"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("either renders its right value", func(t *testing.T) {
+		tmpl := template.Must(template.New("either").Funcs(gofp.TemplateFuncs()).Parse(`{{ . | unwrapOr "left" }}`))
+
+		var b strings.Builder
+		if err := tmpl.Execute(&b, gofp.Left[string, int]("no")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := b.String(); got != "left" {
+			t.Errorf("expected left, got %q", got)
+		}
+	})
+}
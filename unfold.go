@@ -0,0 +1,48 @@
+package gofp
+
+import "iter"
+
+// Unfold builds a slice from a seed value. The function is applied repeatedly
+// to the seed, and each time it returns Some the first value of the [Pair] is
+// appended to the slice and the second becomes the next seed. Generation stops
+// as soon as the function returns None.
+func Unfold[S, T any](seed S, fn func(S) Option[Pair[T, S]]) []T {
+	var values []T
+	for v := range UnfoldSeq(seed, fn) {
+		values = append(values, v)
+	}
+	return values
+}
+
+// UnfoldSeq is a lazy variant of [Unfold] that returns an iterator. The
+// function is only applied as values are consumed, so it is suitable for
+// generating unbounded sequences.
+func UnfoldSeq[S, T any](seed S, fn func(S) Option[Pair[T, S]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s := seed
+		for {
+			o := fn(s)
+			if !o.valid {
+				return
+			}
+			if !yield(o.value.First) {
+				return
+			}
+			s = o.value.Second
+		}
+	}
+}
+
+// Iterate returns a slice of n values formed by repeatedly applying a function
+// to an initial value, that is x, f(x), f(f(x)) and so on.
+func Iterate[T any](x T, fn func(T) T, n int) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	values := make([]T, n)
+	values[0] = x
+	for i := 1; i < n; i++ {
+		values[i] = fn(values[i-1])
+	}
+	return values
+}
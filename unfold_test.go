@@ -0,0 +1,50 @@
+package gofp_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+)
+
+func countdown(n int) gofp.Option[gofp.Pair[int, int]] {
+	if n == 0 {
+		return gofp.None[gofp.Pair[int, int]]()
+	}
+	return gofp.Some(gofp.NewPair(n, n-1))
+}
+
+func TestUnfold(t *testing.T) {
+	got := gofp.Unfold(3, countdown)
+	if !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("expected [3 2 1], got %v", got)
+	}
+}
+
+func TestUnfoldSeq(t *testing.T) {
+	naturals := gofp.UnfoldSeq(0, func(n int) gofp.Option[gofp.Pair[int, int]] {
+		return gofp.Some(gofp.NewPair(n, n+1))
+	})
+
+	var got []int
+	for n := range naturals {
+		if n == 3 {
+			break
+		}
+		got = append(got, n)
+	}
+	if !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("expected [0 1 2], got %v", got)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	got := gofp.Iterate(1, func(x int) int { return x * 2 }, 4)
+	if !reflect.DeepEqual(got, []int{1, 2, 4, 8}) {
+		t.Errorf("expected [1 2 4 8], got %v", got)
+	}
+
+	if got := gofp.Iterate(1, func(x int) int { return x }, 0); len(got) != 0 {
+		t.Errorf("expected empty slice, got %v", got)
+	}
+}
@@ -0,0 +1,25 @@
+package validate_test
+
+import (
+	"fmt"
+
+	"github.com/tomasbasham/gofp/validate"
+)
+
+func ExampleFromTags() {
+	type signup struct {
+		Username string `validate:"required,min=3"`
+		Email    string `validate:"required,email"`
+		Age      int    `validate:"min=18"`
+	}
+
+	validateSignup := validate.FromTags[signup]()
+
+	errs := validateSignup(signup{Username: "ab", Email: "ab@example.com", Age: 15}).UnwrapLeftOr(nil)
+	for _, err := range errs {
+		fmt.Println(err)
+	}
+	// Output:
+	// Username must be at least 3 characters
+	// Age must be at least 18
+}
@@ -0,0 +1,255 @@
+// Package validate builds validators for structs from their field tags.
+//
+// Validation in gofp is expressed as a [gofp.Either] whose Left holds every
+// failure rather than only the first, so that a caller may report all of the
+// problems with a value at once. [FromTags] derives such a validator from
+// struct tags in the style of github.com/go-playground/validator, so that
+// existing tagged structs may be validated without writing a function per
+// field:
+//
+//	type User struct {
+//		Name  string `validate:"required,min=3"`
+//		Email string `validate:"required,email"`
+//		Age   int    `validate:"min=18,max=130"`
+//	}
+//
+//	var validateUser = validate.FromTags[User]()
+//
+// The rules are separated by commas, and are:
+//
+//   - required: the field is not the zero value, or is a Some
+//   - min=N, max=N: the length of a string, slice, array or map, or the value
+//     of a number, is at least or at most N
+//   - len=N: the length of a string, slice, array or map, or the value of a
+//     number, is exactly N
+//   - email: the field is a valid email address
+//   - oneof=A B C: the field is one of the space-separated values
+//
+// A field that fails required is not checked against its other rules. The
+// length of a string is its number of runes. A field of type
+// [gofp.Option] that is None satisfies every rule other than required; the
+// rules of a Some are checked against the value it holds.
+package validate
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tomasbasham/gofp"
+)
+
+const tagName = "validate"
+
+var pkgPath = reflect.TypeFor[gofp.Unit]().PkgPath()
+
+// FieldError describes a field that failed one of its rules.
+type FieldError struct {
+	// Field is the name of the struct field.
+	Field string
+
+	// Rule is the name of the rule that failed, such as "min".
+	Rule string
+
+	// Message describes the failure, such as "must be at least 3".
+	Message string
+}
+
+// Error implements the error interface.
+func (e FieldError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+// Validator checks a value, returning Right holding the value if it is valid,
+// or otherwise Left holding an error for every rule it failed.
+//
+// Type parameter T represents the validated type.
+type Validator[T any] func(T) gofp.Either[[]FieldError, T]
+
+// FromTags returns a [Validator] for the struct type T built from the
+// validate tags of its fields. Fields without a validate tag, or with the tag
+// "-", are not checked.
+//
+// The tags are parsed once, when FromTags is called. It panics if T is not a
+// struct, if a tagged field is unexported, or if a tag names an unknown rule or
+// a rule that does not apply to the type of its field, since these are
+// programming errors.
+func FromTags[T any]() Validator[T] {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("validate: %s is not a struct", t))
+	}
+
+	var fields []field
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup(tagName)
+		if !ok || tag == "-" || tag == "" {
+			continue
+		}
+		fields = append(fields, parseField(sf, tag))
+	}
+
+	return func(v T) gofp.Either[[]FieldError, T] {
+		rv := reflect.ValueOf(v)
+		var errs []FieldError
+		for _, f := range fields {
+			errs = append(errs, f.check(rv.Field(f.index))...)
+		}
+		if len(errs) > 0 {
+			return gofp.Left[[]FieldError, T](errs)
+		}
+		return gofp.Right[[]FieldError](v)
+	}
+}
+
+type field struct {
+	name   string
+	index  int
+	option bool
+	rules  []rule
+}
+
+type rule struct {
+	name  string
+	check func(reflect.Value) (string, bool)
+}
+
+func (f field) check(v reflect.Value) []FieldError {
+	present := true
+	if f.option {
+		v, present = unwrapOption(v)
+	} else {
+		present = !v.IsZero()
+	}
+
+	var errs []FieldError
+	for _, r := range f.rules {
+		if r.name == "required" {
+			if !present {
+				// The other rules would only repeat that the value is missing.
+				return append(errs, FieldError{f.name, r.name, "is required"})
+			}
+			continue
+		}
+		if f.option && !present {
+			continue
+		}
+		if msg, ok := r.check(v); !ok {
+			errs = append(errs, FieldError{f.name, r.name, msg})
+		}
+	}
+	return errs
+}
+
+// unwrapOption returns the value held by a gofp.Option, and whether it is
+// Some.
+func unwrapOption(v reflect.Value) (reflect.Value, bool) {
+	out := v.MethodByName("TryUnwrap").Call(nil)
+	return out[0], out[1].Bool()
+}
+
+func isOption(t reflect.Type) bool {
+	return t.PkgPath() == pkgPath && strings.HasPrefix(t.Name(), "Option[")
+}
+
+func parseField(sf reflect.StructField, tag string) field {
+	if !sf.IsExported() {
+		panic(fmt.Sprintf("validate: field %s is not exported", sf.Name))
+	}
+	f := field{name: sf.Name, index: sf.Index[0]}
+
+	t := sf.Type
+	if isOption(t) {
+		f.option = true
+		m, _ := t.MethodByName("TryUnwrap")
+		t = m.Type.Out(0)
+	}
+
+	for _, spec := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(spec, "=")
+		r, err := parseRule(t, name, param)
+		if err != nil {
+			panic(fmt.Sprintf("validate: field %s: %v", sf.Name, err))
+		}
+		f.rules = append(f.rules, r)
+	}
+	return f
+}
+
+func parseRule(t reflect.Type, name, param string) (rule, error) {
+	switch name {
+	case "required":
+		return rule{name: name}, nil
+	case "min":
+		return compareRule(t, name, param, func(n, bound float64) bool { return n >= bound }, "at least")
+	case "max":
+		return compareRule(t, name, param, func(n, bound float64) bool { return n <= bound }, "at most")
+	case "len":
+		return compareRule(t, name, param, func(n, bound float64) bool { return n == bound }, "exactly")
+	case "email":
+		if t.Kind() != reflect.String {
+			return rule{}, fmt.Errorf("rule email does not apply to %s", t)
+		}
+		return rule{name: name, check: func(v reflect.Value) (string, bool) {
+			addr, err := mail.ParseAddress(v.String())
+			return "must be a valid email address", err == nil && addr.Address == v.String()
+		}}, nil
+	case "oneof":
+		values := strings.Fields(param)
+		if len(values) == 0 {
+			return rule{}, fmt.Errorf("rule oneof requires at least one value")
+		}
+		msg := "must be one of " + strings.Join(values, ", ")
+		return rule{name: name, check: func(v reflect.Value) (string, bool) {
+			s := fmt.Sprint(v.Interface())
+			for _, want := range values {
+				if s == want {
+					return msg, true
+				}
+			}
+			return msg, false
+		}}, nil
+	default:
+		return rule{}, fmt.Errorf("unknown rule %q", name)
+	}
+}
+
+// compareRule returns a rule comparing the size of a value, which is the
+// length of a string or collection or the value of a number, with the bound
+// given by param.
+func compareRule(t reflect.Type, name, param string, cmp func(n, bound float64) bool, relation string) (rule, error) {
+	bound, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return rule{}, fmt.Errorf("rule %s requires a number, got %q", name, param)
+	}
+
+	var (
+		size func(reflect.Value) float64
+		unit string
+	)
+	switch t.Kind() {
+	case reflect.String:
+		size = func(v reflect.Value) float64 { return float64(utf8.RuneCountInString(v.String())) }
+		unit = " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		size = func(v reflect.Value) float64 { return float64(v.Len()) }
+		unit = " elements"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		size = func(v reflect.Value) float64 { return float64(v.Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		size = func(v reflect.Value) float64 { return float64(v.Uint()) }
+	case reflect.Float32, reflect.Float64:
+		size = func(v reflect.Value) float64 { return v.Float() }
+	default:
+		return rule{}, fmt.Errorf("rule %s does not apply to %s", name, t)
+	}
+
+	msg := fmt.Sprintf("must be %s %s%s", relation, param, unit)
+	return rule{name: name, check: func(v reflect.Value) (string, bool) {
+		return msg, cmp(size(v), bound)
+	}}, nil
+}
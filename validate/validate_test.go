@@ -0,0 +1,106 @@
+package validate_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/assert"
+	"github.com/tomasbasham/gofp/validate"
+)
+
+type user struct {
+	Name     string              `validate:"required,min=3"`
+	Email    string              `validate:"required,email"`
+	Age      int                 `validate:"min=18,max=130"`
+	Role     string              `validate:"oneof=admin member"`
+	Tags     []string            `validate:"max=2"`
+	Nickname gofp.Option[string] `validate:"min=2"`
+	Country  gofp.Option[string] `validate:"required,len=2"`
+	Notes    string
+}
+
+func TestFromTags(t *testing.T) {
+	validateUser := validate.FromTags[user]()
+
+	t.Run("valid", func(t *testing.T) {
+		u := user{
+			Name:     "alice",
+			Email:    "alice@example.com",
+			Age:      30,
+			Role:     "admin",
+			Nickname: gofp.None[string](),
+			Country:  gofp.Some("GB"),
+		}
+		assert.RightEqual(t, validateUser(u), u)
+	})
+
+	t.Run("accumulates every failure", func(t *testing.T) {
+		u := user{
+			Name:     "al",
+			Email:    "not an email",
+			Age:      15,
+			Role:     "owner",
+			Tags:     []string{"a", "b", "c"},
+			Nickname: gofp.Some("x"),
+		}
+		errs := assert.Left(t, validateUser(u))
+
+		want := []validate.FieldError{
+			{Field: "Name", Rule: "min", Message: "must be at least 3 characters"},
+			{Field: "Email", Rule: "email", Message: "must be a valid email address"},
+			{Field: "Age", Rule: "min", Message: "must be at least 18"},
+			{Field: "Role", Rule: "oneof", Message: "must be one of admin, member"},
+			{Field: "Tags", Rule: "max", Message: "must be at most 2 elements"},
+			{Field: "Nickname", Rule: "min", Message: "must be at least 2 characters"},
+			{Field: "Country", Rule: "required", Message: "is required"},
+		}
+		if !reflect.DeepEqual(errs, want) {
+			t.Errorf("expected %v, got %v", want, errs)
+		}
+	})
+
+	t.Run("required", func(t *testing.T) {
+		errs := assert.Left(t, validateUser(user{Age: 18, Role: "member", Country: gofp.Some("GB")}))
+		if len(errs) != 2 || errs[0].Error() != "Name is required" || errs[1].Error() != "Email is required" {
+			t.Errorf("expected Name and Email to be required, got %v", errs)
+		}
+	})
+}
+
+func TestFromTags_Invalid(t *testing.T) {
+	tests := map[string]func(){
+		"not a struct": func() { validate.FromTags[int]() },
+		"unknown rule": func() {
+			validate.FromTags[struct {
+				Name string `validate:"unknown"`
+			}]()
+		},
+		"invalid bound": func() {
+			validate.FromTags[struct {
+				Name string `validate:"min=three"`
+			}]()
+		},
+		"inapplicable rule": func() {
+			validate.FromTags[struct {
+				Age int `validate:"email"`
+			}]()
+		},
+		"unexported field": func() {
+			validate.FromTags[struct {
+				name string `validate:"required"`
+			}]()
+		},
+	}
+
+	for name, f := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected FromTags to panic")
+				}
+			}()
+			f()
+		})
+	}
+}
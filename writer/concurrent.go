@@ -0,0 +1,73 @@
+package writer
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+)
+
+// Appender accumulates output written concurrently by many goroutines, such as
+// the branches of a fan-out stage. Each piece of output is written with a
+// sequence number, and the combined output holds them in order of sequence
+// number, so it does not depend on how the goroutines were scheduled. An
+// Appender is safe for concurrent use.
+//
+// Type parameter W represents the output type.
+type Appender[W any] struct {
+	mu      sync.Mutex
+	monoid  Monoid[W]
+	entries []appended[W]
+}
+
+type appended[W any] struct {
+	seq int
+	w   W
+}
+
+// Concurrent returns an empty [*Appender] that combines output using m.
+func Concurrent[W any](m Monoid[W]) *Appender[W] {
+	return &Appender[W]{monoid: m}
+}
+
+// Tell appends output with the given sequence number. Output written with the
+// same sequence number is combined in the order it was written, so each
+// sequence number should be written by a single goroutine, such as by using
+// the index of the item processed by each branch of a fan-out.
+func (a *Appender[W]) Tell(seq int, w W) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.entries = append(a.entries, appended[W]{seq: seq, w: w})
+}
+
+// Output returns the output written so far, combined in order of sequence
+// number.
+func (a *Appender[W]) Output() W {
+	a.mu.Lock()
+	entries := slices.Clone(a.entries)
+	a.mu.Unlock()
+
+	slices.SortStableFunc(entries, func(x, y appended[W]) int {
+		return cmp.Compare(x.seq, y.seq)
+	})
+	out := a.monoid.Empty()
+	for _, e := range entries {
+		out = a.monoid.Append(out, e.w)
+	}
+	return out
+}
+
+// Collect creates a [Writer] computation that, when run, calls f with a new
+// [*Appender] and produces its value along with the output written to the
+// appender. It lets a stage that fans work out to goroutines, each writing
+// output as it goes, take part in an otherwise pure pipeline. The goroutines
+// must have finished writing by the time f returns.
+func Collect[W, A any](m Monoid[W], f func(*Appender[W]) A) Writer[W, A] {
+	return Writer[W, A]{
+		g: func(out W) (A, W) {
+			app := Concurrent(m)
+			a := f(app)
+			return a, m.Append(out, app.Output())
+		},
+		monoid: m,
+	}
+}
@@ -0,0 +1,97 @@
+package writer_test
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/tomasbasham/gofp/writer"
+)
+
+func TestConcurrent(t *testing.T) {
+	t.Run("orders output by sequence number", func(t *testing.T) {
+		a := writer.Concurrent(SliceMonoid[string]{})
+		a.Tell(2, []string{"c"})
+		a.Tell(0, []string{"a"})
+		a.Tell(1, []string{"b1"})
+		a.Tell(1, []string{"b2"})
+
+		want := []string{"a", "b1", "b2", "c"}
+		if got := a.Output(); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("is empty before any output is written", func(t *testing.T) {
+		a := writer.Concurrent(StringMonoid{})
+		if got := a.Output(); got != "" {
+			t.Errorf("expected empty output, got %q", got)
+		}
+	})
+
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		a := writer.Concurrent(SliceMonoid[string]{})
+		var wg sync.WaitGroup
+		for i := range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				a.Tell(i, []string{fmt.Sprint(i, "a")})
+				a.Tell(i, []string{fmt.Sprint(i, "b")})
+			}()
+		}
+		wg.Wait()
+
+		got := a.Output()
+		if len(got) != 100 {
+			t.Fatalf("expected 100 entries, got %d", len(got))
+		}
+		for i := range 50 {
+			if got[2*i] != fmt.Sprint(i, "a") || got[2*i+1] != fmt.Sprint(i, "b") {
+				t.Fatalf("unexpected order %v", got)
+			}
+		}
+	})
+}
+
+func TestCollect(t *testing.T) {
+	runs := 0
+	fanOut := writer.Collect(SliceMonoid[string]{}, func(a *writer.Appender[[]string]) int {
+		runs++
+		var wg sync.WaitGroup
+		results := make([]int, 3)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i] = i * i
+				a.Tell(i, []string{fmt.Sprintf("squared %d", i)})
+			}()
+		}
+		wg.Wait()
+		return results[0] + results[1] + results[2]
+	})
+
+	w := writer.FlatMap(writer.Tell[[]string, int]([]string{"start"}, SliceMonoid[string]{}), func(int) writer.Writer[[]string, int] {
+		return fanOut
+	})
+
+	if runs != 0 {
+		t.Fatalf("expected lazy evaluation, ran %d times", runs)
+	}
+
+	value, output := w.Run()
+	if value != 5 {
+		t.Errorf("expected 5, got %d", value)
+	}
+	want := []string{"start", "squared 0", "squared 1", "squared 2"}
+	if !reflect.DeepEqual(output, want) {
+		t.Errorf("expected %v, got %v", want, output)
+	}
+
+	// Each run collects into a new appender.
+	if _, output := w.Run(); !reflect.DeepEqual(output, want) {
+		t.Errorf("expected %v on second run, got %v", want, output)
+	}
+}
@@ -2,6 +2,7 @@ package writer_test
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/tomasbasham/gofp/writer"
 )
@@ -105,3 +106,36 @@ func ExampleZip() {
 	// Output:
 	// 8 [first second]
 }
+
+func ExampleParSequence() {
+	ws := []writer.Writer[[]string, int]{
+		writer.TellWithValue[[]string](1, []string{"compiled a.go"}, SliceMonoid[string]{}),
+		writer.TellWithValue[[]string](2, []string{"compiled b.go"}, SliceMonoid[string]{}),
+	}
+	values, output := writer.ParSequence[[]string](ws, SliceMonoid[string]{}).Run()
+	fmt.Println(values, output)
+	// Output:
+	// [1 2] [compiled a.go compiled b.go]
+}
+
+func ExampleCollect() {
+	urls := []string{"a.example", "b.example", "c.example"}
+
+	fetched := writer.Collect(SliceMonoid[string]{}, func(log *writer.Appender[[]string]) int {
+		var wg sync.WaitGroup
+		for i, url := range urls {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				log.Tell(i, []string{"fetched " + url})
+			}()
+		}
+		wg.Wait()
+		return len(urls)
+	})
+
+	n, output := fetched.Run()
+	fmt.Println(n, output)
+	// Output:
+	// 3 [fetched a.example fetched b.example fetched c.example]
+}
@@ -0,0 +1,18 @@
+package writer
+
+// Return lifts a value into a [Writer] computation with an empty output. It is
+// equivalent to [Pure].
+func Return[W, A any](a A, m Monoid[W]) Writer[W, A] {
+	return Pure(a, m)
+}
+
+// Bind is equivalent to [FlatMap].
+func Bind[W, A, B any](w Writer[W, A], f func(A) Writer[W, B]) Writer[W, B] {
+	return FlatMap(w, f)
+}
+
+// Join flattens a [Writer] computation whose value is itself a [Writer]
+// computation. The outputs of both are combined according to the [Monoid].
+func Join[W, A any](w Writer[W, Writer[W, A]]) Writer[W, A] {
+	return FlatMap(w, func(inner Writer[W, A]) Writer[W, A] { return inner })
+}
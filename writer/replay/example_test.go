@@ -0,0 +1,28 @@
+package replay_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tomasbasham/gofp/monoid"
+	"github.com/tomasbasham/gofp/writer"
+	"github.com/tomasbasham/gofp/writer/replay"
+)
+
+func ExampleVerify() {
+	normalise := func(s string) writer.Writer[[]string, string] {
+		return writer.TellWithValue(strings.ToLower(s), []string{"lowercased " + s}, monoid.Slice[string]{})
+	}
+	recorded := replay.Record(normalise("Hello")).Unwrap()
+
+	// A refactoring that changes the log is detected.
+	refactored := func(s string) writer.Writer[[]string, string] {
+		return writer.TellWithValue(strings.ToLower(s), []string{"lower " + s}, monoid.Slice[string]{})
+	}
+
+	fmt.Println(replay.Verify(recorded, normalise("Hello")))
+	fmt.Println(replay.Verify(recorded, refactored("Hello")))
+	// Output:
+	// Ok({})
+	// Err(log entry 0 differs: expected "lowercased Hello", got "lower Hello")
+}
@@ -0,0 +1,146 @@
+// Package replay detects changes in the behaviour of [writer.Writer]
+// computations by comparing their output with a previous recording.
+//
+// [Record] runs a computation and serialises its value and accumulated log as
+// JSON. The recording is stored, typically alongside the tests, and [Verify]
+// later runs a computation, usually a refactored version of the original, and
+// checks that it produces exactly the same value and log. Since the log of a
+// pipeline describes every step it took, a matching recording is strong
+// evidence that a refactoring has not changed its behaviour.
+//
+// Computations must be deterministic for their recordings to be verified, so
+// values that change between runs, such as timestamps, should be supplied by
+// the environment rather than read during the run.
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+// recording is the serialised form of a run of a computation.
+type recording struct {
+	Value json.RawMessage `json:"value"`
+	Log   json.RawMessage `json:"log"`
+}
+
+// MismatchError describes the first difference between a recording and the
+// output of the computation verified against it.
+type MismatchError struct {
+	// Field is "value" if the values differ, or "log" if the logs differ.
+	Field string
+
+	// Index is the index of the first differing entry when the logs are both
+	// lists, or -1 otherwise.
+	Index int
+
+	// Want and Got are the recorded and produced JSON, of the differing entry
+	// if Index is not -1, or of the whole field otherwise. A missing entry is
+	// empty.
+	Want, Got string
+}
+
+// Error implements the error interface.
+func (e *MismatchError) Error() string {
+	field := e.Field
+	if e.Index >= 0 {
+		field = fmt.Sprintf("log entry %d", e.Index)
+	}
+	switch {
+	case e.Want == "":
+		return fmt.Sprintf("%s not recorded: got %s", field, e.Got)
+	case e.Got == "":
+		return fmt.Sprintf("%s missing: expected %s", field, e.Want)
+	}
+	return fmt.Sprintf("%s differs: expected %s, got %s", field, e.Want, e.Got)
+}
+
+// Record runs the [writer.Writer] computation and returns its value and log
+// serialised as indented JSON, so that recordings stored in version control
+// produce readable diffs. The result is an Err if either cannot be encoded.
+func Record[W, A any](w writer.Writer[W, A]) gofp.Result[[]byte] {
+	return gofp.ResultFlatMap(run(w), func(r recording) gofp.Result[[]byte] {
+		return gofp.FromReturn(json.MarshalIndent(r, "", "  "))
+	})
+}
+
+// Verify runs the [writer.Writer] computation and checks that its value and
+// log are the same as those in the recording, which must have been produced by
+// [Record]. JSON is compared ignoring insignificant whitespace. The result is
+// an Err holding a [*MismatchError] describing the first difference if they
+// are not the same, or any error encountered decoding the recording.
+func Verify[W, A any](recorded []byte, w writer.Writer[W, A]) gofp.Result[gofp.Unit] {
+	var want recording
+	if err := json.Unmarshal(recorded, &want); err != nil {
+		return gofp.Err[gofp.Unit](fmt.Errorf("decoding recording: %w", err))
+	}
+
+	return gofp.ResultFlatMap(run(w), func(got recording) gofp.Result[gofp.Unit] {
+		if err := compare("value", want.Value, got.Value); err != nil {
+			return gofp.Err[gofp.Unit](err)
+		}
+		if err := compareLog(want.Log, got.Log); err != nil {
+			return gofp.Err[gofp.Unit](err)
+		}
+		return gofp.Ok(gofp.UnitValue)
+	})
+}
+
+func run[W, A any](w writer.Writer[W, A]) gofp.Result[recording] {
+	a, log := w.Run()
+	value, err := json.Marshal(a)
+	if err != nil {
+		return gofp.Err[recording](fmt.Errorf("encoding value: %w", err))
+	}
+	logJSON, err := json.Marshal(log)
+	if err != nil {
+		return gofp.Err[recording](fmt.Errorf("encoding log: %w", err))
+	}
+	return gofp.Ok(recording{Value: value, Log: logJSON})
+}
+
+func compare(field string, want, got json.RawMessage) *MismatchError {
+	w, g := compact(want), compact(got)
+	if w == g {
+		return nil
+	}
+	return &MismatchError{Field: field, Index: -1, Want: w, Got: g}
+}
+
+// compareLog compares two logs, reporting the first differing entry if both
+// are lists.
+func compareLog(want, got json.RawMessage) error {
+	var wantEntries, gotEntries []json.RawMessage
+	if json.Unmarshal(want, &wantEntries) != nil || json.Unmarshal(got, &gotEntries) != nil {
+		if err := compare("log", want, got); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	for i := range max(len(wantEntries), len(gotEntries)) {
+		var w, g string
+		if i < len(wantEntries) {
+			w = compact(wantEntries[i])
+		}
+		if i < len(gotEntries) {
+			g = compact(gotEntries[i])
+		}
+		if w != g {
+			return &MismatchError{Field: "log", Index: i, Want: w, Got: g}
+		}
+	}
+	return nil
+}
+
+func compact(data json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return string(data)
+	}
+	return buf.String()
+}
@@ -0,0 +1,99 @@
+package replay_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/tomasbasham/gofp/assert"
+	"github.com/tomasbasham/gofp/monoid"
+	"github.com/tomasbasham/gofp/writer"
+	"github.com/tomasbasham/gofp/writer/replay"
+)
+
+// total sums the given prices, logging each one.
+func total(prices ...int) writer.Writer[[]string, int] {
+	return writer.New(func() (int, []string) {
+		var sum int
+		var log []string
+		for _, p := range prices {
+			sum += p
+			log = append(log, fmt.Sprintf("add %d", p))
+		}
+		return sum, log
+	}, monoid.Slice[string]{})
+}
+
+func TestRecord(t *testing.T) {
+	got := assert.Ok(t, replay.Record(total(1, 2)))
+	want := `{
+  "value": 3,
+  "log": [
+    "add 1",
+    "add 2"
+  ]
+}`
+	if string(got) != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	recorded := assert.Ok(t, replay.Record(total(1, 2, 3)))
+
+	t.Run("accepts identical output", func(t *testing.T) {
+		assert.Ok(t, replay.Verify(recorded, total(1, 2, 3)))
+	})
+
+	t.Run("ignores whitespace", func(t *testing.T) {
+		compact := []byte(`{"value":6,"log":["add 1","add 2","add 3"]}`)
+		assert.Ok(t, replay.Verify(compact, total(1, 2, 3)))
+	})
+
+	tests := map[string]struct {
+		w    writer.Writer[[]string, int]
+		want replay.MismatchError
+		msg  string
+	}{
+		"different value": {
+			w:    writer.Map(total(1, 2, 3), func(n int) int { return n * 2 }),
+			want: replay.MismatchError{Field: "value", Index: -1, Want: "6", Got: "12"},
+			msg:  "value differs: expected 6, got 12",
+		},
+		"different entry": {
+			w:    total(1, 3, 2),
+			want: replay.MismatchError{Field: "log", Index: 1, Want: `"add 2"`, Got: `"add 3"`},
+			msg:  `log entry 1 differs: expected "add 2", got "add 3"`,
+		},
+		"unexpected entry": {
+			w:    total(1, 2, 3, 0),
+			want: replay.MismatchError{Field: "log", Index: 3, Got: `"add 0"`},
+			msg:  `log entry 3 not recorded: got "add 0"`,
+		},
+		"missing entry": {
+			w:    writer.Map(total(1, 2), func(int) int { return 6 }),
+			want: replay.MismatchError{Field: "log", Index: 2, Want: `"add 3"`},
+			msg:  `log entry 2 missing: expected "add 3"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := assert.Err(t, replay.Verify(recorded, tt.w))
+			var mismatch *replay.MismatchError
+			if !errors.As(err, &mismatch) {
+				t.Fatalf("expected *MismatchError, got %v", err)
+			}
+			if *mismatch != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, *mismatch)
+			}
+			if err.Error() != tt.msg {
+				t.Errorf("expected message %q, got %q", tt.msg, err.Error())
+			}
+		})
+	}
+
+	t.Run("returns Err for a malformed recording", func(t *testing.T) {
+		assert.Err(t, replay.Verify([]byte("{"), total()))
+	})
+}
@@ -0,0 +1,69 @@
+package writer
+
+import "time"
+
+// TimedEntry is a log entry recording when it was written and, for entries
+// produced by [Timing], how long the timed computation took.
+type TimedEntry struct {
+	Time     time.Time
+	Message  string
+	Duration time.Duration
+}
+
+// TimedMonoid is a [Monoid] for slices of [TimedEntry] values. Entries are
+// appended in place, so a log grows without being copied at every step, and
+// it implements [monoid.Sized] so that [Writer.RunWithCapacity] may
+// preallocate it.
+//
+// Appending in place requires the first argument of [TimedMonoid.Append] to
+// be owned by the caller. [Writer] computations always pass their own output
+// first, so logs shared between computations, such as those cached by
+// [Memoize], are never written to. A monoid that reverses the arguments, such
+// as [monoid.Dual], would pass a shared log first and overwrite its entries,
+// so [monoid.Slice], which copies on Append, should be used there instead.
+type TimedMonoid struct{}
+
+// Empty returns an empty slice of entries.
+func (TimedMonoid) Empty() []TimedEntry {
+	return []TimedEntry{}
+}
+
+// EmptySized returns an empty slice with capacity for hint entries.
+func (TimedMonoid) EmptySized(hint int) []TimedEntry {
+	return make([]TimedEntry, 0, hint)
+}
+
+// Append appends the entries of b to a, reusing the storage of a where it has
+// capacity, so a must not be shared, nor used after the call. b is only read.
+func (TimedMonoid) Append(a, b []TimedEntry) []TimedEntry {
+	return append(a, b...)
+}
+
+// TellTimed creates a [Writer] computation that produces a single
+// [TimedEntry] with the given message. The entry is timestamped when the
+// computation is run, not when it is created. The result will be the zero
+// value for type A.
+func TellTimed[A any](msg string) Writer[[]TimedEntry, A] {
+	return Writer[[]TimedEntry, A]{
+		g: func(out []TimedEntry) (A, []TimedEntry) {
+			var zero A
+			return zero, TimedMonoid{}.Append(out, []TimedEntry{{Time: time.Now(), Message: msg}})
+		},
+		monoid: TimedMonoid{},
+	}
+}
+
+// Timing wraps a [Writer] computation so that, after it runs, a [TimedEntry]
+// with the given name and the elapsed duration of the computation is appended
+// to its output.
+func Timing[A any](name string, w Writer[[]TimedEntry, A]) Writer[[]TimedEntry, A] {
+	return Writer[[]TimedEntry, A]{
+		g: func(out []TimedEntry) (A, []TimedEntry) {
+			start := time.Now()
+			a, out := w.g(out)
+			entry := TimedEntry{Time: start, Message: name, Duration: time.Since(start)}
+			return a, TimedMonoid{}.Append(out, []TimedEntry{entry})
+		},
+		monoid: TimedMonoid{},
+	}
+}
@@ -0,0 +1,59 @@
+package writer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp/monoid"
+	"github.com/tomasbasham/gofp/writer"
+)
+
+var _ monoid.Sized[[]writer.TimedEntry] = writer.TimedMonoid{}
+
+func TestTellTimed(t *testing.T) {
+	w := writer.TellTimed[int]("started")
+
+	before := time.Now()
+	_, log := w.Run()
+	if len(log) != 1 || log[0].Message != "started" {
+		t.Fatalf("expected a single entry, got %v", log)
+	}
+	if log[0].Time.Before(before) {
+		t.Error("expected entry to be timestamped when run")
+	}
+}
+
+func TestTiming(t *testing.T) {
+	slow := writer.FlatMap(writer.TellTimed[int]("compiling"), func(int) writer.Writer[[]writer.TimedEntry, string] {
+		time.Sleep(5 * time.Millisecond)
+		return writer.TellWithValue[[]writer.TimedEntry]("main.o", []writer.TimedEntry{}, writer.TimedMonoid{})
+	})
+
+	value, log := writer.Timing("compile", slow).Run()
+	if value != "main.o" {
+		t.Errorf("expected main.o, got %v", value)
+	}
+	if len(log) != 2 {
+		t.Fatalf("expected 2 entries, got %v", log)
+	}
+	if log[1].Message != "compile" || log[1].Duration < 5*time.Millisecond {
+		t.Errorf("expected timing entry of at least 5ms, got %+v", log[1])
+	}
+	if log[0].Time.Before(log[1].Time) {
+		t.Error("expected timing entry to record the start time")
+	}
+}
+
+func TestTimedMonoid_RunWithCapacity(t *testing.T) {
+	w := writer.FlatMap(writer.TellTimed[int]("first"), func(int) writer.Writer[[]writer.TimedEntry, int] {
+		return writer.TellTimed[int]("second")
+	})
+
+	_, log := w.RunWithCapacity(4)
+	if len(log) != 2 || log[0].Message != "first" || log[1].Message != "second" {
+		t.Fatalf("expected two entries in order, got %v", log)
+	}
+	if cap(log) != 4 {
+		t.Errorf("expected entries to be appended in place, got capacity %d", cap(log))
+	}
+}
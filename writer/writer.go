@@ -6,14 +6,19 @@
 // outputs are combined.
 package writer
 
+import (
+	"sync"
+
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/monoid"
+)
+
 // Monoid represents a type that can be combined with other values of the same
-// type. It requires an empty value and a way to combine two values.
+// type. It is an alias of [monoid.Monoid], so instances from the monoid package
+// may be used directly.
 //
 // Type parameter A represents the value type.
-type Monoid[A any] interface {
-	Empty() A
-	Append(A, A) A
-}
+type Monoid[A any] = monoid.Monoid[A]
 
 // Writer is a monad that models computations that produce output. It
 // accumulates output alongside computing a value.
@@ -22,7 +27,10 @@ type Monoid[A any] interface {
 // Monoid interface.
 // Type parameter A represents the value type.
 type Writer[W, A any] struct {
-	g func() (A, W)
+	// g runs the computation, appending its output to the given output. Passing
+	// the output through the computation allows it to be accumulated in a
+	// single value, such as a preallocated slice.
+	g func(W) (A, W)
 
 	// Monoid is a type that can be combined with other values of the same type.
 	monoid Monoid[W]
@@ -44,14 +52,37 @@ func (w Writer[W, A]) FlatMap(f func(A) Writer[W, A]) Writer[W, A] {
 // Run executes the [Writer] computation and returns both the value and the
 // accumulated output.
 func (w Writer[W, A]) Run() (A, W) {
-	return w.g()
+	return w.g(w.monoid.Empty())
+}
+
+// RunWithCapacity executes the [Writer] computation like [Writer.Run], but if
+// its [Monoid] implements [monoid.Sized] the output is accumulated starting
+// from a value with capacity for n elements. For monoids that append in place,
+// this avoids repeatedly growing the output of long computations.
+func (w Writer[W, A]) RunWithCapacity(n int) (A, W) {
+	if m, ok := w.monoid.(monoid.Sized[W]); ok {
+		return w.g(m.EmptySized(n))
+	}
+	return w.Run()
+}
+
+// New creates a [Writer] from a function that returns a value along with its
+// output. The function is called each time the computation is run.
+func New[W, A any](f func() (A, W), m Monoid[W]) Writer[W, A] {
+	return Writer[W, A]{
+		g: func(out W) (A, W) {
+			a, w := f()
+			return a, m.Append(out, w)
+		},
+		monoid: m,
+	}
 }
 
 // Pure lifts a value into a [Writer] computation with an empty output.
 func Pure[W, A any](a A, m Monoid[W]) Writer[W, A] {
 	return Writer[W, A]{
-		g: func() (A, W) {
-			return a, m.Empty()
+		g: func(out W) (A, W) {
+			return a, out
 		},
 		monoid: m,
 	}
@@ -61,9 +92,9 @@ func Pure[W, A any](a A, m Monoid[W]) Writer[W, A] {
 // computing a meaningful value. The result will be the zero value for type A.
 func Tell[W, A any](w W, m Monoid[W]) Writer[W, A] {
 	return Writer[W, A]{
-		g: func() (A, W) {
+		g: func(out W) (A, W) {
 			var zero A
-			return zero, w
+			return zero, m.Append(out, w)
 		},
 		monoid: m,
 	}
@@ -74,8 +105,8 @@ func Tell[W, A any](w W, m Monoid[W]) Writer[W, A] {
 // value.
 func TellWithValue[W, A any](a A, w W, m Monoid[W]) Writer[W, A] {
 	return Writer[W, A]{
-		g: func() (A, W) {
-			return a, w
+		g: func(out W) (A, W) {
+			return a, m.Append(out, w)
 		},
 		monoid: m,
 	}
@@ -91,12 +122,12 @@ type listen[A, W any] struct {
 // output.
 func Listen[W, A any](w Writer[W, A]) Writer[W, listen[A, W]] {
 	return Writer[W, listen[A, W]]{
-		g: func() (listen[A, W], W) {
+		g: func(out W) (listen[A, W], W) {
 			a, log := w.Run()
 			return listen[A, W]{
 				Value: a,
 				Log:   log,
-			}, log
+			}, w.monoid.Append(out, log)
 		},
 		monoid: w.monoid,
 	}
@@ -107,9 +138,9 @@ func Listen[W, A any](w Writer[W, A]) Writer[W, listen[A, W]] {
 // the value type.
 func Map[W, A, B any](w Writer[W, A], f func(A) B) Writer[W, B] {
 	return Writer[W, B]{
-		g: func() (B, W) {
-			a, log := w.g()
-			return f(a), log
+		g: func(out W) (B, W) {
+			a, out := w.g(out)
+			return f(a), out
 		},
 		monoid: w.monoid,
 	}
@@ -122,10 +153,10 @@ func Map[W, A, B any](w Writer[W, A], f func(A) B) Writer[W, B] {
 // of a [Writer] computation.
 func Apply[W, A, B any](w Writer[W, A], f Writer[W, func(A) B]) Writer[W, B] {
 	return Writer[W, B]{
-		g: func() (B, W) {
-			a, logA := w.Run()  // orignally w.g()
-			fn, logF := f.Run() // originally f.g()
-			return fn(a), w.monoid.Append(logA, logF)
+		g: func(out W) (B, W) {
+			a, out := w.g(out)
+			fn, out := f.g(out)
+			return fn(a), out
 		},
 		monoid: w.monoid,
 	}
@@ -137,11 +168,9 @@ func Apply[W, A, B any](w Writer[W, A], f Writer[W, func(A) B]) Writer[W, B] {
 // value type.
 func FlatMap[W, A, B any](w Writer[W, A], f func(A) Writer[W, B]) Writer[W, B] {
 	return Writer[W, B]{
-		g: func() (B, W) {
-			a, w1 := w.g()
-			wb := f(a)
-			b, w2 := wb.g()
-			return b, w.monoid.Append(w1, w2)
+		g: func(out W) (B, W) {
+			a, out := w.g(out)
+			return f(a).g(out)
 		},
 		monoid: w.monoid,
 	}
@@ -157,3 +186,135 @@ func Zip[W, A, B, U any](wa Writer[W, A], wb Writer[W, B], f func(A, B) U) Write
 		})
 	})
 }
+
+// ParZip combines two [Writer] computations into one using a combining
+// function. Unlike [Zip], both computations are run concurrently. Their outputs
+// are combined in argument order according to the [Monoid], so the combined
+// output is the same as if they had been run sequentially. A panic in either
+// computation is raised again on the goroutine running the combination.
+func ParZip[W, A, B, U any](wa Writer[W, A], wb Writer[W, B], f func(A, B) U) Writer[W, U] {
+	return Writer[W, U]{
+		g: func(out W) (U, W) {
+			var (
+				b        B
+				logB     W
+				panicked any
+				wg       sync.WaitGroup
+			)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer capture(&panicked)
+				b, logB = wb.Run()
+			}()
+			a, out := wa.g(out)
+			wg.Wait()
+			if panicked != nil {
+				panic(panicked)
+			}
+			return f(a, b), wa.monoid.Append(out, logB)
+		},
+		monoid: wa.monoid,
+	}
+}
+
+// ParSequence transforms a slice of [Writer] computations into a single
+// [Writer] computation that returns a slice of values. All computations are run
+// concurrently, and their values and outputs are combined in slice order, so
+// the result is the same as if they had been run sequentially. A panic in any
+// computation is raised again on the goroutine running the sequence, once
+// every computation has completed. If several panic, the first in slice order
+// is raised.
+func ParSequence[W, A any](writers []Writer[W, A], m Monoid[W]) Writer[W, []A] {
+	return Writer[W, []A]{
+		g: func(out W) ([]A, W) {
+			values := make([]A, len(writers))
+			logs := make([]W, len(writers))
+			panics := make([]any, len(writers))
+
+			var wg sync.WaitGroup
+			wg.Add(len(writers))
+			for i, w := range writers {
+				go func(i int, w Writer[W, A]) {
+					defer wg.Done()
+					defer capture(&panics[i])
+					values[i], logs[i] = w.Run()
+				}(i, w)
+			}
+			wg.Wait()
+			for _, p := range panics {
+				if p != nil {
+					panic(p)
+				}
+			}
+
+			for _, l := range logs {
+				out = m.Append(out, l)
+			}
+			return values, out
+		},
+		monoid: m,
+	}
+}
+
+// capture recovers a panic on the current goroutine, storing its value in p so
+// that it may be raised again on another. It must be called directly by defer.
+func capture(p *any) {
+	*p = recover()
+}
+
+// Instrument wraps a [Writer] computation so that each time it is run the given
+// hook is called with a [gofp.StageInfo] describing the wall time and heap
+// allocations of the computation.
+func Instrument[W, A any](w Writer[W, A], hook func(gofp.StageInfo)) Writer[W, A] {
+	return Writer[W, A]{
+		g: func(out W) (A, W) {
+			var a A
+			hook(gofp.Measure(func() {
+				a, out = w.g(out)
+			}))
+			return a, out
+		},
+		monoid: w.monoid,
+	}
+}
+
+// Memoize wraps a [Writer] computation so that it runs at most once. The
+// value and output of the first run are cached, and every later run of the
+// memoized computation, including runs of computations composed from it,
+// reuses them. This is useful when a computation is both observed, such as
+// with [Listen], and extended, since otherwise each would run it again,
+// repeating its cost and any side effects.
+func Memoize[W, A any](w Writer[W, A]) Writer[W, A] {
+	var (
+		once sync.Once
+		a    A
+		log  W
+	)
+	return Writer[W, A]{
+		g: func(out W) (A, W) {
+			once.Do(func() {
+				a, log = w.Run()
+			})
+			return a, w.monoid.Append(out, log)
+		},
+		monoid: w.monoid,
+	}
+}
+
+// Tee derives a secondary output from the output of a [Writer] computation,
+// producing a computation whose output holds both. The secondary outputs of
+// composed computations are combined using m, so the combined output of a
+// pipeline is a [gofp.Pair] of, for example, human-readable logs and structured
+// metrics. For the secondary output to be consistent, f should distribute over
+// the monoid of the original output.
+func Tee[W, V, A any](w Writer[W, A], m Monoid[V], f func(W) V) Writer[gofp.Pair[W, V], A] {
+	p := monoid.NewProduct(w.monoid, m)
+	return Writer[gofp.Pair[W, V], A]{
+		g: func(out gofp.Pair[W, V]) (A, gofp.Pair[W, V]) {
+			a, log := w.Run()
+			return a, p.Append(out, gofp.NewPair(log, f(log)))
+		},
+		monoid: p,
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/tomasbasham/gofp"
+	"github.com/tomasbasham/gofp/monoid"
 	"github.com/tomasbasham/gofp/writer"
 )
 
@@ -31,6 +33,28 @@ func (m SliceMonoid[T]) Append(a, b []T) []T {
 	return append(a, b...)
 }
 
+func TestNew(t *testing.T) {
+	calls := 0
+	w := writer.New(func() (int, string) {
+		calls++
+		return 42, "computed"
+	}, StringMonoid{})
+
+	value, output := writer.FlatMap(w, func(x int) writer.Writer[string, int] {
+		return writer.TellWithValue[string](x+1, " and incremented", StringMonoid{})
+	}).Run()
+
+	if value != 43 {
+		t.Errorf("expected 43, got %v", value)
+	}
+	if output != "computed and incremented" {
+		t.Errorf("expected output 'computed and incremented', got %v", output)
+	}
+	if calls != 1 {
+		t.Errorf("expected function to be called once, got %d", calls)
+	}
+}
+
 func TestPure(t *testing.T) {
 	t.Run("creates writer with value and empty output", func(t *testing.T) {
 		w := writer.Pure[string](42, StringMonoid{})
@@ -294,6 +318,138 @@ func TestFlatMap(t *testing.T) {
 			t.Errorf("expected %v, got %v", expectedOutput, output)
 		}
 	})
+
+	t.Run("raises a panic on the caller", func(t *testing.T) {
+		w := writer.TellWithValue[[]string](5, []string{"log"}, SliceMonoid[string]{})
+		boom := writer.New(func() (int, []string) {
+			panic("boom")
+		}, SliceMonoid[string]{})
+
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Errorf("expected the panic to propagate, got %v", p)
+			}
+		}()
+		writer.ParZip(w, boom, func(a, b int) int { return a + b }).Run()
+		t.Error("expected ParZip to panic")
+	})
+}
+
+func TestInstrument(t *testing.T) {
+	var calls int
+	w := writer.Instrument(writer.TellWithValue[string](5, "logged", StringMonoid{}), func(gofp.StageInfo) {
+		calls++
+	})
+
+	value, output := writer.FlatMap(w, func(x int) writer.Writer[string, int] {
+		return writer.TellWithValue[string](x*2, " twice", StringMonoid{})
+	}).Run()
+
+	if value != 10 {
+		t.Errorf("expected 10, got %v", value)
+	}
+	if output != "logged twice" {
+		t.Errorf("expected output 'logged twice', got %v", output)
+	}
+	if calls != 1 {
+		t.Errorf("expected hook to be called once, got %d", calls)
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	runs := 0
+	w := writer.Memoize(writer.New(func() (int, []string) {
+		runs++
+		return runs, []string{"ran"}
+	}, SliceMonoid[string]{}))
+
+	_, listened := writer.Listen(w).Run()
+	value, output := writer.FlatMap(w, func(a int) writer.Writer[[]string, int] {
+		return writer.TellWithValue(a, []string{"done"}, SliceMonoid[string]{})
+	}).Run()
+
+	if runs != 1 {
+		t.Errorf("expected computation to run once, ran %d times", runs)
+	}
+	if value != 1 {
+		t.Errorf("expected 1, got %v", value)
+	}
+	if !slices.Equal(listened, []string{"ran"}) {
+		t.Errorf("expected [ran], got %v", listened)
+	}
+	if !slices.Equal(output, []string{"ran", "done"}) {
+		t.Errorf("expected [ran done], got %v", output)
+	}
+}
+
+func TestTee(t *testing.T) {
+	step := func(msg string, n int) writer.Writer[gofp.Pair[[]string, int], int] {
+		w := writer.TellWithValue[[]string](n, []string{msg}, SliceMonoid[string]{})
+		return writer.Tee(w, monoid.Sum[int]{}, func(log []string) int {
+			return len(log)
+		})
+	}
+
+	w := writer.FlatMap(step("first", 1), func(a int) writer.Writer[gofp.Pair[[]string, int], int] {
+		return writer.Map(step("second", 2), func(b int) int {
+			return a + b
+		})
+	})
+
+	value, output := w.Run()
+	if value != 3 {
+		t.Errorf("expected 3, got %v", value)
+	}
+	if !slices.Equal(output.First, []string{"first", "second"}) {
+		t.Errorf("expected [first second], got %v", output.First)
+	}
+	if output.Second != 2 {
+		t.Errorf("expected 2 entries counted, got %v", output.Second)
+	}
+}
+
+// sizedMonoid is a [monoid.Sized] that appends slices in place.
+type sizedMonoid struct{}
+
+func (sizedMonoid) Empty() []string {
+	return []string{}
+}
+
+func (sizedMonoid) EmptySized(hint int) []string {
+	return make([]string, 0, hint)
+}
+
+func (sizedMonoid) Append(a, b []string) []string {
+	return append(a, b...)
+}
+
+func TestRunWithCapacity(t *testing.T) {
+	t.Run("preallocates output for sized monoids", func(t *testing.T) {
+		w := writer.Tell[[]string, int]([]string{"first"}, sizedMonoid{}).
+			FlatMap(func(int) writer.Writer[[]string, int] {
+				return writer.TellWithValue(2, []string{"second", "third"}, sizedMonoid{})
+			})
+
+		value, output := w.RunWithCapacity(8)
+		if value != 2 {
+			t.Errorf("expected 2, got %v", value)
+		}
+		if !slices.Equal(output, []string{"first", "second", "third"}) {
+			t.Errorf("expected [first second third], got %v", output)
+		}
+		if cap(output) != 8 {
+			t.Errorf("expected output to keep its capacity of 8, got %d", cap(output))
+		}
+	})
+
+	t.Run("falls back to Run for other monoids", func(t *testing.T) {
+		w := writer.TellWithValue(1, "log", StringMonoid{})
+
+		value, output := w.RunWithCapacity(8)
+		if value != 1 || output != "log" {
+			t.Errorf("expected (1, log), got (%v, %v)", value, output)
+		}
+	})
 }
 
 func TestZip(t *testing.T) {
@@ -360,6 +516,79 @@ func TestZip(t *testing.T) {
 	})
 }
 
+func TestParZip(t *testing.T) {
+	t.Run("combines output in argument order", func(t *testing.T) {
+		w1 := writer.TellWithValue[[]string](5, []string{"first log"}, SliceMonoid[string]{})
+		w2 := writer.TellWithValue[[]string](10, []string{"second log"}, SliceMonoid[string]{})
+
+		sum := writer.ParZip(w1, w2, func(a, b int) int {
+			return a + b
+		})
+
+		value, output := sum.Run()
+		if value != 15 {
+			t.Errorf("expected 15, got %v", value)
+		}
+
+		expectedOutput := []string{"first log", "second log"}
+		if !reflect.DeepEqual(output, expectedOutput) {
+			t.Errorf("expected %v, got %v", expectedOutput, output)
+		}
+	})
+}
+
+func TestParSequence(t *testing.T) {
+	t.Run("combines values and output in slice order", func(t *testing.T) {
+		writers := make([]writer.Writer[[]string, int], 10)
+		expectedValues := make([]int, 10)
+		expectedOutput := make([]string, 10)
+		for i := range writers {
+			log := fmt.Sprintf("log %d", i)
+			writers[i] = writer.TellWithValue[[]string](i, []string{log}, SliceMonoid[string]{})
+			expectedValues[i] = i
+			expectedOutput[i] = log
+		}
+
+		values, output := writer.ParSequence[[]string](writers, SliceMonoid[string]{}).Run()
+		if !reflect.DeepEqual(values, expectedValues) {
+			t.Errorf("expected %v, got %v", expectedValues, values)
+		}
+		if !reflect.DeepEqual(output, expectedOutput) {
+			t.Errorf("expected %v, got %v", expectedOutput, output)
+		}
+	})
+
+	t.Run("raises the first panic in slice order", func(t *testing.T) {
+		writers := make([]writer.Writer[[]string, int], 10)
+		for i := range writers {
+			writers[i] = writer.New(func() (int, []string) {
+				if i%3 == 2 {
+					panic(i)
+				}
+				return i, nil
+			}, SliceMonoid[string]{})
+		}
+
+		defer func() {
+			if p := recover(); p != 2 {
+				t.Errorf("expected a panic of 2, got %v", p)
+			}
+		}()
+		writer.ParSequence(writers, SliceMonoid[string]{}).Run()
+		t.Error("expected ParSequence to panic")
+	})
+
+	t.Run("returns empty output for no writers", func(t *testing.T) {
+		values, output := writer.ParSequence[string, int](nil, StringMonoid{}).Run()
+		if len(values) != 0 {
+			t.Errorf("expected no values, got %v", values)
+		}
+		if output != "" {
+			t.Errorf("expected empty output, got %q", output)
+		}
+	})
+}
+
 func TestComposition(t *testing.T) {
 	t.Run("chains multiple operations", func(t *testing.T) {
 		// Start with a pure value
@@ -398,3 +627,20 @@ func TestComposition(t *testing.T) {
 		}
 	})
 }
+
+func TestBindReturnJoin(t *testing.T) {
+	m := StringMonoid{}
+	bound := writer.Bind(writer.Return(3, m), func(x int) writer.Writer[string, int] {
+		return writer.TellWithValue(x*2, "doubled", m)
+	})
+	value, log := bound.Run()
+	if value != 6 || log != "doubled" {
+		t.Errorf("expected (6, doubled), got (%v, %v)", value, log)
+	}
+
+	nested := writer.TellWithValue(writer.TellWithValue(1, "inner", m), "outer;", m)
+	value, log = writer.Join(nested).Run()
+	if value != 1 || log != "outer;inner" {
+		t.Errorf("expected (1, outer;inner), got (%v, %v)", value, log)
+	}
+}
@@ -0,0 +1,144 @@
+// Package writertest provides utilities for testing [writer.Writer]
+// computations against golden files.
+//
+// [GoldenLog] runs a computation and compares its accumulated output with a
+// file stored in the testdata directory. Output containing values that change
+// between runs, such as timestamps and generated identifiers, can be made
+// stable by passing normalisers that rewrite the output before it is compared.
+//
+// Golden files are created or updated by running the tests with the
+// -writertest.update flag.
+package writertest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/gofp/writer"
+)
+
+// Update controls whether [GoldenLog] writes the golden file rather than
+// comparing against it. It is set by the -writertest.update flag.
+var Update = flag.Bool("writertest.update", false, "update writertest golden files")
+
+var (
+	timestampPattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[ ]?[+-]\d{2}:?\d{2})?( [A-Z]{2,5})?( m=[+-]\d+\.\d+)?`)
+	uuidPattern      = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	durationPattern  = regexp.MustCompile(`\b\d+(\.\d+)?(ns|µs|us|ms|s|m|h)\b`)
+)
+
+// Option configures [GoldenLog].
+type Option func(*config)
+
+type config struct {
+	dir         string
+	normalisers []func(string) string
+}
+
+// WithDir sets the directory in which golden files are stored. It defaults to
+// "testdata".
+func WithDir(dir string) Option {
+	return func(c *config) {
+		c.dir = dir
+	}
+}
+
+// WithNormaliser adds a function that rewrites the formatted output before it
+// is compared. Normalisers are applied in the order they are given.
+func WithNormaliser(f func(string) string) Option {
+	return func(c *config) {
+		c.normalisers = append(c.normalisers, f)
+	}
+}
+
+// Replace adds a normaliser that replaces every match of the regular expression
+// with repl, as in [regexp.Regexp.ReplaceAllString].
+func Replace(re *regexp.Regexp, repl string) Option {
+	return WithNormaliser(func(s string) string {
+		return re.ReplaceAllString(s, repl)
+	})
+}
+
+// StripTimestamps adds a normaliser that replaces RFC 3339 timestamps and
+// formatted [time.Time] values with "<timestamp>".
+func StripTimestamps() Option {
+	return Replace(timestampPattern, "<timestamp>")
+}
+
+// StripUUIDs adds a normaliser that replaces UUIDs with "<uuid>".
+func StripUUIDs() Option {
+	return Replace(uuidPattern, "<uuid>")
+}
+
+// StripDurations adds a normaliser that replaces formatted [time.Duration]
+// values with "<duration>".
+func StripDurations() Option {
+	return Replace(durationPattern, "<duration>")
+}
+
+// GoldenLog runs the [writer.Writer] computation and compares its accumulated
+// output with the golden file named after the test. If the output is a slice,
+// each element is written on its own line. When the -writertest.update flag is
+// set the golden file is written instead.
+func GoldenLog[W, A any](t testing.TB, w writer.Writer[W, A], opts ...Option) {
+	t.Helper()
+
+	cfg := config{dir: "testdata"}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	_, log := w.Run()
+	got := formatLog(log)
+	for _, f := range cfg.normalisers {
+		got = f(got)
+	}
+
+	path := filepath.Join(cfg.dir, goldenName(t.Name()))
+	if *Update {
+		if err := os.MkdirAll(cfg.dir, 0o755); err != nil {
+			t.Fatalf("creating golden directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("writing golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading golden file (run with -writertest.update to create it): %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("log does not match %s:\n--- expected\n%s\n--- got\n%s", path, want, got)
+	}
+}
+
+func formatLog(log any) string {
+	if s, ok := log.(string); ok {
+		return s
+	}
+
+	v := reflect.ValueOf(log)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return fmt.Sprintf("%+v\n", log)
+	}
+
+	var b strings.Builder
+	for i := range v.Len() {
+		fmt.Fprintf(&b, "%+v\n", v.Index(i))
+	}
+	return b.String()
+}
+
+// goldenName converts a test name, which may contain slashes and spaces from
+// subtests, into a file name.
+func goldenName(name string) string {
+	return strings.NewReplacer("/", "_", " ", "_").Replace(name) + ".golden"
+}
@@ -0,0 +1,139 @@
+package writertest_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/gofp/writer"
+	"github.com/tomasbasham/gofp/writer/writertest"
+)
+
+// SliceMonoid implements the Monoid interface for slices.
+type SliceMonoid[T any] struct{}
+
+func (SliceMonoid[T]) Empty() []T {
+	return []T{}
+}
+
+func (SliceMonoid[T]) Append(a, b []T) []T {
+	return append(a, b...)
+}
+
+// recorder captures failures reported by the assertions under test.
+type recorder struct {
+	testing.TB
+	name     string
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Name() string {
+	return r.name
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+// run calls f in its own goroutine so that a call to Fatalf on the recorder
+// stops f without stopping the test.
+func (r *recorder) run(f func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	<-done
+}
+
+func setUpdate(t *testing.T, update bool) {
+	prev := *writertest.Update
+	*writertest.Update = update
+	t.Cleanup(func() { *writertest.Update = prev })
+}
+
+func build() writer.Writer[[]string, int] {
+	return writer.FlatMap(writer.Tell[[]string, int]([]string{"compiled main.go"}, SliceMonoid[string]{}), func(int) writer.Writer[[]string, int] {
+		return writer.TellWithValue[[]string](0, []string{"tests passed"}, SliceMonoid[string]{})
+	})
+}
+
+func TestGoldenLog(t *testing.T) {
+	writertest.GoldenLog(t, build())
+}
+
+func TestGoldenLog_Mismatch(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "build.golden"), []byte("compiled main.go\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	setUpdate(t, false)
+	r := &recorder{TB: t, name: "build"}
+	r.run(func() {
+		writertest.GoldenLog(r, build(), writertest.WithDir(dir))
+	})
+	if len(r.failures) != 1 {
+		t.Errorf("expected a single failure, got %v", r.failures)
+	}
+}
+
+func TestGoldenLog_MissingFile(t *testing.T) {
+	setUpdate(t, false)
+	r := &recorder{TB: t, name: "missing"}
+	r.run(func() {
+		writertest.GoldenLog(r, build(), writertest.WithDir(t.TempDir()))
+	})
+	if len(r.failures) != 1 {
+		t.Errorf("expected a single failure, got %v", r.failures)
+	}
+}
+
+func TestGoldenLog_Update(t *testing.T) {
+	setUpdate(t, true)
+
+	dir := filepath.Join(t.TempDir(), "golden")
+	r := &recorder{TB: t, name: "update/sub test"}
+	r.run(func() {
+		writertest.GoldenLog(r, build(), writertest.WithDir(dir))
+	})
+	if len(r.failures) != 0 {
+		t.Fatalf("expected no failures, got %v", r.failures)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "update_sub_test.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "compiled main.go\ntests passed\n" {
+		t.Errorf("unexpected golden file contents %q", got)
+	}
+}
+
+func TestGoldenLog_Normalisers(t *testing.T) {
+	id := "3f2b8c1e-5d4a-4b6f-9e7c-1a2b3c4d5e6f"
+	w := writer.FlatMap(writer.Timing("compile", writer.TellTimed[int]("deploying "+id)), func(int) writer.Writer[[]writer.TimedEntry, int] {
+		return writer.TellWithValue[[]writer.TimedEntry](0, []writer.TimedEntry{{
+			Time:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Message: "build 42 finished",
+		}}, writer.TimedMonoid{})
+	})
+
+	writertest.GoldenLog(t, w,
+		writertest.StripTimestamps(),
+		writertest.StripUUIDs(),
+		writertest.StripDurations(),
+		writertest.Replace(regexp.MustCompile(`build \d+`), "build <n>"),
+	)
+}
@@ -0,0 +1,38 @@
+package zipper_test
+
+import (
+	"fmt"
+
+	"github.com/tomasbasham/gofp/state"
+	"github.com/tomasbasham/gofp/zipper"
+)
+
+func ExampleList() {
+	type Entry struct {
+		Description string
+		Amount      int
+	}
+
+	ledger := zipper.FromSlice([]Entry{
+		{"opening balance", 100},
+		{"groceries", -30},
+		{"salary", 2000},
+	}).Unwrap()
+
+	// Correct the second entry without touching the others.
+	correct := state.Modify(func(l zipper.List[Entry]) zipper.List[Entry] {
+		return l.Right().Unwrap().Modify(func(e Entry) Entry {
+			e.Amount = -35
+			return e
+		})
+	})
+
+	_, ledger = correct.Run(ledger)
+	for _, e := range ledger.Rebuild() {
+		fmt.Printf("%s: %d\n", e.Description, e.Amount)
+	}
+	// Output:
+	// opening balance: 100
+	// groceries: -35
+	// salary: 2000
+}
@@ -0,0 +1,240 @@
+// Package zipper implements zippers for making localised edits to immutable
+// lists and trees.
+//
+// A zipper splits a structure into a focused element and the context needed to
+// rebuild the structure around it. Moving the focus and modifying the focused
+// element only touch the parts of the structure along the path to the focus,
+// and the original structure is never mutated. This makes zippers well suited
+// to state that is threaded through a State computation, where one element
+// must be edited without copying the whole structure.
+package zipper
+
+import (
+	"slices"
+
+	"github.com/tomasbasham/gofp"
+)
+
+// Tree is an immutable rose tree, where every node holds a value and any number
+// of children.
+//
+// Type parameter T represents the value type.
+type Tree[T any] struct {
+	Value    T
+	Children []Tree[T]
+}
+
+// NewTree returns a [Tree] with the given value and children.
+func NewTree[T any](value T, children ...Tree[T]) Tree[T] {
+	return Tree[T]{Value: value, Children: children}
+}
+
+type crumb[T any] struct {
+	value T
+	left  []Tree[T]
+	right []Tree[T]
+}
+
+// Zipper is a cursor focused on a single node of a [Tree].
+//
+// Type parameter T represents the value type.
+type Zipper[T any] struct {
+	focus Tree[T]
+	path  []crumb[T]
+}
+
+// New returns a [Zipper] focused on the root of the given [Tree].
+func New[T any](t Tree[T]) Zipper[T] {
+	return Zipper[T]{focus: t}
+}
+
+// Focus returns the value of the focused node.
+func (z Zipper[T]) Focus() T {
+	return z.focus.Value
+}
+
+// Tree returns the subtree rooted at the focused node.
+func (z Zipper[T]) Tree() Tree[T] {
+	return z.focus
+}
+
+// Modify returns a [Zipper] whose focused node holds the result of applying f
+// to its value.
+func (z Zipper[T]) Modify(f func(T) T) Zipper[T] {
+	z.focus = Tree[T]{Value: f(z.focus.Value), Children: z.focus.Children}
+	return z
+}
+
+// Set returns a [Zipper] whose focused node holds the given value.
+func (z Zipper[T]) Set(v T) Zipper[T] {
+	return z.Modify(func(T) T { return v })
+}
+
+// Down moves the focus to the first child of the focused node. It returns None
+// if the node has no children.
+func (z Zipper[T]) Down() gofp.Option[Zipper[T]] {
+	children := z.focus.Children
+	if len(children) == 0 {
+		return gofp.None[Zipper[T]]()
+	}
+	return gofp.Some(Zipper[T]{
+		focus: children[0],
+		path:  push(z.path, crumb[T]{value: z.focus.Value, right: children[1:]}),
+	})
+}
+
+// Up moves the focus to the parent of the focused node. It returns None if the
+// focus is the root.
+func (z Zipper[T]) Up() gofp.Option[Zipper[T]] {
+	if len(z.path) == 0 {
+		return gofp.None[Zipper[T]]()
+	}
+	c := z.path[len(z.path)-1]
+	children := slices.Concat(c.left, []Tree[T]{z.focus}, c.right)
+	return gofp.Some(Zipper[T]{
+		focus: Tree[T]{Value: c.value, Children: children},
+		path:  z.path[:len(z.path)-1],
+	})
+}
+
+// Left moves the focus to the previous sibling of the focused node. It returns
+// None if there is no previous sibling.
+func (z Zipper[T]) Left() gofp.Option[Zipper[T]] {
+	if len(z.path) == 0 {
+		return gofp.None[Zipper[T]]()
+	}
+	c := z.path[len(z.path)-1]
+	if len(c.left) == 0 {
+		return gofp.None[Zipper[T]]()
+	}
+	n := len(c.left) - 1
+	return gofp.Some(Zipper[T]{
+		focus: c.left[n],
+		path: replace(z.path, crumb[T]{
+			value: c.value,
+			left:  c.left[:n:n],
+			right: slices.Concat([]Tree[T]{z.focus}, c.right),
+		}),
+	})
+}
+
+// Right moves the focus to the next sibling of the focused node. It returns
+// None if there is no next sibling.
+func (z Zipper[T]) Right() gofp.Option[Zipper[T]] {
+	if len(z.path) == 0 {
+		return gofp.None[Zipper[T]]()
+	}
+	c := z.path[len(z.path)-1]
+	if len(c.right) == 0 {
+		return gofp.None[Zipper[T]]()
+	}
+	return gofp.Some(Zipper[T]{
+		focus: c.right[0],
+		path: replace(z.path, crumb[T]{
+			value: c.value,
+			left:  append(slices.Clip(c.left), z.focus),
+			right: c.right[1:],
+		}),
+	})
+}
+
+// Root moves the focus to the root of the tree.
+func (z Zipper[T]) Root() Zipper[T] {
+	for {
+		up, ok := z.Up().TryUnwrap()
+		if !ok {
+			return z
+		}
+		z = up
+	}
+}
+
+// Rebuild returns the whole [Tree], including any modifications made through
+// the [Zipper].
+func (z Zipper[T]) Rebuild() Tree[T] {
+	return z.Root().focus
+}
+
+// push and replace always copy the path so that zippers derived from the same
+// parent never share a backing array.
+func push[T any](path []crumb[T], c crumb[T]) []crumb[T] {
+	return append(slices.Clip(path), c)
+}
+
+func replace[T any](path []crumb[T], c crumb[T]) []crumb[T] {
+	return push(path[:len(path)-1], c)
+}
+
+// List is a cursor focused on a single element of a slice.
+//
+// Type parameter T represents the element type.
+type List[T any] struct {
+	left  []T
+	focus T
+	right []T
+}
+
+// FromSlice returns a [List] focused on the first element of the slice. It
+// returns None if the slice is empty.
+func FromSlice[T any](xs []T) gofp.Option[List[T]] {
+	if len(xs) == 0 {
+		return gofp.None[List[T]]()
+	}
+	return gofp.Some(List[T]{focus: xs[0], right: xs[1:]})
+}
+
+// Focus returns the focused element.
+func (l List[T]) Focus() T {
+	return l.focus
+}
+
+// Index returns the index of the focused element.
+func (l List[T]) Index() int {
+	return len(l.left)
+}
+
+// Modify returns a [List] whose focused element is the result of applying f to
+// it.
+func (l List[T]) Modify(f func(T) T) List[T] {
+	l.focus = f(l.focus)
+	return l
+}
+
+// Set returns a [List] whose focused element is the given value.
+func (l List[T]) Set(v T) List[T] {
+	l.focus = v
+	return l
+}
+
+// Left moves the focus to the previous element. It returns None if the focus
+// is the first element.
+func (l List[T]) Left() gofp.Option[List[T]] {
+	if len(l.left) == 0 {
+		return gofp.None[List[T]]()
+	}
+	n := len(l.left) - 1
+	return gofp.Some(List[T]{
+		left:  l.left[:n:n],
+		focus: l.left[n],
+		right: slices.Concat([]T{l.focus}, l.right),
+	})
+}
+
+// Right moves the focus to the next element. It returns None if the focus is
+// the last element.
+func (l List[T]) Right() gofp.Option[List[T]] {
+	if len(l.right) == 0 {
+		return gofp.None[List[T]]()
+	}
+	return gofp.Some(List[T]{
+		left:  append(slices.Clip(l.left), l.focus),
+		focus: l.right[0],
+		right: l.right[1:],
+	})
+}
+
+// Rebuild returns a new slice holding every element, including any
+// modifications made through the [List].
+func (l List[T]) Rebuild() []T {
+	return slices.Concat(l.left, []T{l.focus}, l.right)
+}
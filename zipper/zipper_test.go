@@ -0,0 +1,121 @@
+package zipper_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/tomasbasham/gofp/zipper"
+)
+
+func tree() zipper.Tree[string] {
+	return zipper.NewTree("root",
+		zipper.NewTree("a",
+			zipper.NewTree("a1"),
+			zipper.NewTree("a2"),
+		),
+		zipper.NewTree("b"),
+		zipper.NewTree("c"),
+	)
+}
+
+func TestZipper(t *testing.T) {
+	t.Run("navigates the tree", func(t *testing.T) {
+		z := zipper.New(tree())
+		a := z.Down().Unwrap()
+		if a.Focus() != "a" {
+			t.Errorf("expected a, got %v", a.Focus())
+		}
+
+		a2 := a.Down().Unwrap().Right().Unwrap()
+		if a2.Focus() != "a2" {
+			t.Errorf("expected a2, got %v", a2.Focus())
+		}
+		if got := a2.Left().Unwrap().Focus(); got != "a1" {
+			t.Errorf("expected a1, got %v", got)
+		}
+		if got := a2.Up().Unwrap().Right().Unwrap().Focus(); got != "b" {
+			t.Errorf("expected b, got %v", got)
+		}
+	})
+
+	t.Run("returns None at the boundaries", func(t *testing.T) {
+		z := zipper.New(tree())
+		if z.Up().IsSome() || z.Left().IsSome() || z.Right().IsSome() {
+			t.Error("expected root to have no parent or siblings")
+		}
+
+		c := z.Down().Unwrap().Right().Unwrap().Right().Unwrap()
+		if c.Right().IsSome() || c.Down().IsSome() {
+			t.Error("expected last leaf to have no next sibling or children")
+		}
+		if z.Down().Unwrap().Left().IsSome() {
+			t.Error("expected first child to have no previous sibling")
+		}
+	})
+
+	t.Run("rebuilds the tree with modifications", func(t *testing.T) {
+		original := tree()
+		z := zipper.New(original).Down().Unwrap().Down().Unwrap().Right().Unwrap()
+		got := z.Set("A2").Rebuild()
+
+		want := zipper.NewTree("root",
+			zipper.NewTree("a",
+				zipper.NewTree("a1"),
+				zipper.NewTree("A2"),
+			),
+			zipper.NewTree("b"),
+			zipper.NewTree("c"),
+		)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if !reflect.DeepEqual(original, tree()) {
+			t.Error("expected the original tree to be unchanged")
+		}
+	})
+
+	t.Run("branches do not interfere", func(t *testing.T) {
+		a := zipper.New(tree()).Down().Unwrap()
+		b := a.Right().Unwrap().Modify(func(s string) string { return s + "!" })
+		c := a.Right().Unwrap().Right().Unwrap().Modify(func(s string) string { return s + "?" })
+
+		gotB := b.Rebuild().Children
+		gotC := c.Rebuild().Children
+		if gotB[1].Value != "b!" || gotB[2].Value != "c" {
+			t.Errorf("unexpected children %v", gotB)
+		}
+		if gotC[1].Value != "b" || gotC[2].Value != "c?" {
+			t.Errorf("unexpected children %v", gotC)
+		}
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("returns None for an empty slice", func(t *testing.T) {
+		if zipper.FromSlice[int](nil).IsSome() {
+			t.Error("expected None")
+		}
+	})
+
+	t.Run("navigates and edits", func(t *testing.T) {
+		xs := []int{1, 2, 3}
+		l := zipper.FromSlice(xs).Unwrap()
+		if l.Left().IsSome() {
+			t.Error("expected no element before the first")
+		}
+
+		l = l.Right().Unwrap().Right().Unwrap()
+		if l.Focus() != 3 || l.Index() != 2 || l.Right().IsSome() {
+			t.Errorf("expected focus on the last element, got %v at %d", l.Focus(), l.Index())
+		}
+
+		l = l.Set(30).Left().Unwrap().Modify(func(n int) int { return n * 10 })
+		if got := l.Rebuild(); !slices.Equal(got, []int{1, 20, 30}) {
+			t.Errorf("expected [1 20 30], got %v", got)
+		}
+		if !slices.Equal(xs, []int{1, 2, 3}) {
+			t.Errorf("expected the original slice to be unchanged, got %v", xs)
+		}
+	})
+}